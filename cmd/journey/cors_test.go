@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAllowedOrigins(t *testing.T) {
+	got := parseAllowedOrigins(" https://app.example.com, ,http://localhost:3000 ")
+	want := []string{"https://app.example.com", "http://localhost:3000"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := parseAllowedOrigins(""); len(got) != 0 {
+		t.Errorf("empty list parsed as %q", got)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	var served bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	serve := func(allowedOrigins []string, method, origin string, headers ...string) *httptest.ResponseRecorder {
+		served = false
+		r := httptest.NewRequest(method, "/trips", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		for i := 0; i+1 < len(headers); i += 2 {
+			r.Header.Set(headers[i], headers[i+1])
+		}
+		w := httptest.NewRecorder()
+		cors(allowedOrigins, 90*time.Second)(next).ServeHTTP(w, r)
+		return w
+	}
+	allowed := []string{"https://app.example.com"}
+
+	t.Run("preflight", func(t *testing.T) {
+		w := serve(allowed, http.MethodOptions, "https://app.example.com",
+			"Access-Control-Request-Method", "PATCH",
+			"Access-Control-Request-Headers", "Content-Type, X-Requester-Email")
+		if served || w.Code != http.StatusNoContent {
+			t.Fatalf("status = %d, served = %v, want %d answered by the middleware", w.Code, served, http.StatusNoContent)
+		}
+		for header, want := range map[string]string{
+			"Access-Control-Allow-Origin":  "https://app.example.com",
+			"Access-Control-Allow-Headers": "Content-Type, X-Requester-Email",
+			"Access-Control-Max-Age":       "90",
+		} {
+			if got := w.Header().Get(header); got != want {
+				t.Errorf("%s = %q, want %q", header, got, want)
+			}
+		}
+		if methods := w.Header().Get("Access-Control-Allow-Methods"); !strings.Contains(methods, "PATCH") {
+			t.Errorf("Access-Control-Allow-Methods = %q, want it to allow PATCH", methods)
+		}
+	})
+
+	t.Run("simple request", func(t *testing.T) {
+		w := serve(allowed, http.MethodGet, "https://app.example.com")
+		if !served || w.Header().Get("Access-Control-Allow-Origin") != "https://app.example.com" {
+			t.Errorf("served = %v, Access-Control-Allow-Origin = %q", served, w.Header().Get("Access-Control-Allow-Origin"))
+		}
+		if w.Header().Get("Access-Control-Max-Age") != "" {
+			t.Error("a simple request got a preflight max-age")
+		}
+	})
+
+	t.Run("any origin", func(t *testing.T) {
+		w := serve([]string{"*"}, http.MethodGet, "https://elsewhere.example.com")
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != "https://elsewhere.example.com" {
+			t.Errorf("Access-Control-Allow-Origin = %q, want the request origin", got)
+		}
+	})
+
+	for name, allowedOrigins := range map[string][]string{"other origin": allowed, "no allowed origins": nil} {
+		t.Run(name, func(t *testing.T) {
+			w := serve(allowedOrigins, http.MethodOptions, "https://evil.example.com", "Access-Control-Request-Method", "DELETE")
+			if !served || w.Header().Get("Access-Control-Allow-Origin") != "" {
+				t.Errorf("served = %v, Access-Control-Allow-Origin = %q, want the request passed on untouched", served, w.Header().Get("Access-Control-Allow-Origin"))
+			}
+		})
+	}
+}
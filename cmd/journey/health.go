@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+// health answers readiness probes, reporting the app unavailable when ping
+// cannot reach the database within timeout.
+func health(ping func(context.Context) error, timeout time.Duration, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		status, body := http.StatusOK, "ok"
+		if err := ping(ctx); err != nil {
+			logger.Warn("health check failed to ping the database", zap.Error(err))
+			status, body = http.StatusServiceUnavailable, "unavailable"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": body})
+	}
+}
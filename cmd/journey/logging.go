@@ -0,0 +1,76 @@
+package main
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/phenpessoa/gutils/netutils/httputils"
+	"go.uber.org/zap"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// parseRouteSampleRates parses per-route log sampling overrides written as a
+// comma separated list of "METHOD /route/{pattern}=n" entries, logging 1 in n
+// requests to the route, for example "GET /trips/{tripId}=10".
+func parseRouteSampleRates(s string) (map[string]int, error) {
+	return parseRouteSettings(s, "route log sample rate", func(value string) (int, error) {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return 0, errors.New("bad rate")
+		}
+		return n, nil
+	})
+}
+
+// requestLogger logs 1 in every sampleRate requests to each route, or the rate
+// in overrides for the matched route. Requests answered with an error status
+// are always logged.
+func requestLogger(logger *zap.Logger, routes chi.Routes, sampleRate int, overrides map[string]int) func(http.Handler) http.Handler {
+	var counters sync.Map // route -> *atomic.Uint64
+
+	// sampled reports whether this request is the one in rate to route that
+	// gets logged.
+	sampled := func(route string, rate int) bool {
+		if rate <= 1 {
+			return true
+		}
+		counter, _ := counters.LoadOrStore(route, new(atomic.Uint64))
+		return (counter.(*atomic.Uint64).Add(1)-1)%uint64(rate) == 0
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+
+			next.ServeHTTP(ww, r)
+
+			if ww.Status() < http.StatusBadRequest {
+				// Unmatched requests share the "" route.
+				route, _ := matchRoute(routes, r)
+				rate := sampleRate
+				if n, ok := overrides[route]; ok {
+					rate = n
+				}
+				if !sampled(route, rate) {
+					return
+				}
+			}
+
+			logger.Info(
+				"request",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", ww.Status()),
+				zap.Duration("duration", time.Since(start)),
+				zap.String("ip", httputils.ReadUserIP(r)),
+				zap.String("proto", r.Proto),
+				zap.String("req_id", middleware.GetReqID(r.Context())),
+			)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseRouteSampleRates(t *testing.T) {
+	got, err := parseRouteSampleRates("get /trips/{tripId}=10, GET /healthz=100")
+	if err != nil {
+		t.Fatalf("parseRouteSampleRates: %v", err)
+	}
+	if len(got) != 2 || got["GET /trips/{tripId}"] != 10 || got["GET /healthz"] != 100 {
+		t.Errorf("got %v", got)
+	}
+
+	for _, s := range []string{"GET /trips=0", "GET /trips=half", "GET /trips"} {
+		if _, err := parseRouteSampleRates(s); err == nil {
+			t.Errorf("parseRouteSampleRates(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestRequestLoggerSampling(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	r := chi.NewRouter()
+	r.Use(requestLogger(zap.New(core), r, 4, map[string]int{"GET /trips/{tripId}": 10}))
+	r.Get("/trips/{tripId}", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("fail") {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+	r.Get("/trips", func(w http.ResponseWriter, r *http.Request) {})
+
+	serve := func(target string, n int) int {
+		before := logs.Len()
+		for i := 0; i < n; i++ {
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, target, nil))
+		}
+		return logs.Len() - before
+	}
+
+	if logged := serve("/trips/42", 1000); logged != 100 {
+		t.Errorf("logged %d of 1000 requests to a route sampled 1 in 10, want 100", logged)
+	}
+	if logged := serve("/trips", 1000); logged != 250 {
+		t.Errorf("logged %d of 1000 requests sampled 1 in 4 by default, want 250", logged)
+	}
+	if logged := serve("/trips/42?fail", 50); logged != 50 {
+		t.Errorf("logged %d of 50 failed requests, want all of them", logged)
+	}
+}
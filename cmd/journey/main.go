@@ -6,16 +6,21 @@ import (
 	"fmt"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/phenpessoa/gutils/netutils/httputils"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"journey/internal/api"
 	"journey/internal/api/spec"
+	"journey/internal/archiver"
+	"journey/internal/geocode"
 	"journey/internal/mailer/mailpit"
+	"journey/internal/reminder"
+	"journey/internal/webhook"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
@@ -62,13 +67,89 @@ func run(ctx context.Context) error {
 	}
 	defer pool.Close()
 
-	if err := pool.Ping(ctx); err != nil {
+	connectTimeout := 30 * time.Second
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_DATABASE_CONNECT_TIMEOUT_SECONDS")); err == nil {
+		connectTimeout = time.Duration(v) * time.Second
+	}
+
+	if err := pingWithRetry(ctx, pool.Ping, connectTimeout, logger); err != nil {
+		return err
+	}
+
+	healthPingTimeout := 2 * time.Second
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_HEALTH_PING_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		healthPingTimeout = time.Duration(v) * time.Second
+	}
+
+	requestTimeout := 5 * time.Second
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_REQUEST_TIMEOUT_SECONDS")); err == nil {
+		requestTimeout = time.Duration(v) * time.Second
+	}
+
+	routeTimeouts, err := parseRouteTimeouts(os.Getenv("JOURNEY_ROUTE_TIMEOUTS"))
+	if err != nil {
 		return err
 	}
 
-	si := api.NewApi(pool, logger, mailpit.NewMailpit(pool))
+	// The server must not cut off a response before the slowest route's own
+	// timeout has had the chance to answer.
+	writeTimeout := requestTimeout
+	for _, t := range routeTimeouts {
+		writeTimeout = max(writeTimeout, t)
+	}
+
+	logSampleRate := 1
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_LOG_SAMPLE_RATE")); err == nil && v > 0 {
+		logSampleRate = v
+	}
+
+	routeLogSampleRates, err := parseRouteSampleRates(os.Getenv("JOURNEY_ROUTE_LOG_SAMPLE_RATES"))
+	if err != nil {
+		return err
+	}
+
+	requestIDHeader := os.Getenv("JOURNEY_REQUEST_ID_HEADER")
+	if requestIDHeader == "" {
+		requestIDHeader = middleware.RequestIDHeader
+	}
+
+	corsMaxAge := 10 * time.Minute
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_CORS_MAX_AGE_SECONDS")); err == nil {
+		corsMaxAge = time.Duration(v) * time.Second
+	}
+
+	smtpConfig, err := mailpit.SMTPConfigFromEnv()
+	if err != nil {
+		return err
+	}
+
+	mailer := mailpit.NewMailpit(pool, logger.Named("mailer"), smtpConfig)
+	webhookMaxAttempts := 5
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_WEBHOOK_MAX_ATTEMPTS")); err == nil {
+		webhookMaxAttempts = v
+	}
+
+	webhooks := webhook.NewDispatcher(pool, logger.Named("webhook"), 10*time.Second, webhookMaxAttempts)
+	// Trips are archived this long after they end; 0 never archives them.
+	archiveAfter := 30 * 24 * time.Hour
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_TRIP_ARCHIVE_AFTER_DAYS")); err == nil {
+		archiveAfter = time.Duration(v) * 24 * time.Hour
+	}
+
+	geocoder, err := newGeocoder()
+	if err != nil {
+		return err
+	}
+
+	si := api.NewApi(pool, logger, mailer, webhooks, geocoder)
+	render.Respond = api.Respond
+
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer, middleware.Heartbeat("/healthcheck"), httputils.ChiLogger(logger))
+	r.Use(requestID(requestIDHeader), requestScopedLogger(logger), middleware.Recoverer, middleware.Heartbeat("/healthcheck"))
+	r.Use(requestLogger(logger, r, logSampleRate, routeLogSampleRates))
+	r.Use(cors(parseAllowedOrigins(os.Getenv("JOURNEY_CORS_ALLOWED_ORIGINS")), corsMaxAge))
+	r.Use(routeTimeout(r, requestTimeout, routeTimeouts))
+	r.Get("/health", health(pool.Ping, healthPingTimeout, logger))
 	r.Mount("/", spec.Handler(si))
 
 	srv := &http.Server{
@@ -76,7 +157,7 @@ func run(ctx context.Context) error {
 		Handler:      r,
 		IdleTimeout:  time.Minute,
 		ReadTimeout:  5 * time.Second,
-		WriteTimeout: 5 * time.Second,
+		WriteTimeout: writeTimeout + time.Second,
 	}
 
 	defer func() {
@@ -88,6 +169,18 @@ func run(ctx context.Context) error {
 		}
 	}()
 
+	go reminder.NewScheduler(pool, logger.Named("reminder"), mailer, time.Minute).Run(ctx)
+	// Emails queued while EMAIL_PAUSED was set go out once it is unset.
+	go func() {
+		if err := mailer.FlushOutbox(ctx); err != nil {
+			logger.Error("failed to flush the email outbox", zap.Error(err))
+		}
+	}()
+	if archiveAfter > 0 {
+		go archiver.NewArchiver(pool, logger.Named("archiver"), time.Hour, archiveAfter).Run(ctx)
+	}
+	go webhooks.Run(ctx)
+
 	errChan := make(chan error, 1)
 
 	go func() {
@@ -108,3 +201,47 @@ func run(ctx context.Context) error {
 
 	return nil
 }
+
+// pingWithRetry calls ping until the database answers, doubling the wait
+// between attempts, and gives up once timeout has elapsed. This lets the app
+// survive a database that is still starting during a deploy.
+func pingWithRetry(ctx context.Context, ping func(context.Context) error, timeout time.Duration, logger *zap.Logger) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	const maxBackoff = 5 * time.Second
+	backoff := 250 * time.Millisecond
+	for {
+		err := ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		logger.Warn("database not ready, retrying", zap.Error(err), zap.Duration("backoff", backoff))
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database not ready after %s: %w", timeout, err)
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, maxBackoff)
+	}
+}
+
+// newGeocoder picks the geocoder trip destinations are enriched with, from
+// JOURNEY_GEOCODER. Geocoding is off unless it is set to "nominatim", which
+// calls the server at JOURNEY_NOMINATIM_URL.
+func newGeocoder() (geocode.Geocoder, error) {
+	switch v := os.Getenv("JOURNEY_GEOCODER"); v {
+	case "", "none":
+		return geocode.Noop{}, nil
+	case "nominatim":
+		baseURL := os.Getenv("JOURNEY_NOMINATIM_URL")
+		if baseURL == "" {
+			baseURL = "https://nominatim.openstreetmap.org"
+		}
+		return geocode.NewNominatim(baseURL, "journey"), nil
+	default:
+		return nil, fmt.Errorf("invalid JOURNEY_GEOCODER %q", v)
+	}
+}
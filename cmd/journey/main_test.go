@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestPingWithRetry(t *testing.T) {
+	attempts := 0
+	ping := func(context.Context) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	if err := pingWithRetry(context.Background(), ping, 5*time.Second, zap.NewNop()); err != nil {
+		t.Fatalf("pingWithRetry: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("pinged %d times, want 3", attempts)
+	}
+}
+
+func TestPingWithRetryTimeout(t *testing.T) {
+	refused := errors.New("connection refused")
+	ping := func(context.Context) error { return refused }
+
+	start := time.Now()
+	err := pingWithRetry(context.Background(), ping, 100*time.Millisecond, zap.NewNop())
+	if !errors.Is(err, refused) {
+		t.Fatalf("err = %v, want it to wrap %v", err, refused)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("gave up after %s, want about the 100ms timeout", elapsed)
+	}
+}
+
+func TestNewGeocoder(t *testing.T) {
+	tests := []struct {
+		env     string
+		want    string
+		wantErr bool
+	}{
+		{"", "geocode.Noop", false},
+		{"none", "geocode.Noop", false},
+		{"nominatim", "geocode.Nominatim", false},
+		{"google", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("JOURNEY_GEOCODER", tt.env)
+			geocoder, err := newGeocoder()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if got := fmt.Sprintf("%T", geocoder); !tt.wantErr && got != tt.want {
+				t.Errorf("geocoder = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"journey/internal/api"
+	"net/http"
+)
+
+// requestID tags every request with an id, taken from the header of the
+// incoming request when the caller already set one and generated otherwise.
+// The id is echoed back in the same header and stored where
+// middleware.GetReqID finds it.
+func requestID(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(header)
+			if id == "" {
+				id = uuid.NewString()
+			}
+
+			w.Header().Set(header, id)
+			ctx := context.WithValue(r.Context(), middleware.RequestIDKey, id)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// requestScopedLogger stores in the request context a logger tagging every
+// entry with the id set by requestID, for handlers to get with api.LoggerFrom.
+func requestScopedLogger(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			l := logger.With(zap.String("request_id", middleware.GetReqID(r.Context())))
+			next.ServeHTTP(w, r.WithContext(api.WithLogger(r.Context(), l)))
+		})
+	}
+}
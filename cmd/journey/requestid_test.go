@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+	"journey/internal/api"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := requestID("X-Correlation-Id")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = middleware.GetReqID(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	r.Header.Set("X-Correlation-Id", "abc-123")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if seen != "abc-123" || w.Header().Get("X-Correlation-Id") != "abc-123" {
+		t.Errorf("request id = %q, echoed %q, want the incoming abc-123", seen, w.Header().Get("X-Correlation-Id"))
+	}
+
+	// Only the configured header is honored.
+	r = httptest.NewRequest(http.MethodGet, "/trips", nil)
+	r.Header.Set("X-Request-Id", "abc-123")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	if _, err := uuid.Parse(seen); err != nil {
+		t.Errorf("generated request id %q is not a uuid", seen)
+	}
+	if echoed := w.Header().Get("X-Correlation-Id"); echoed != seen {
+		t.Errorf("echoed %q, want the generated %q", echoed, seen)
+	}
+}
+
+func TestRequestScopedLogger(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	h := requestID("X-Request-Id")(requestScopedLogger(zap.New(core))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		api.LoggerFrom(r.Context(), zap.NewNop()).Info("handled")
+	})))
+	r := httptest.NewRequest(http.MethodGet, "/trips", nil)
+	r.Header.Set("X-Request-Id", "abc-123")
+	h.ServeHTTP(httptest.NewRecorder(), r)
+
+	entries := logs.All()
+	if len(entries) != 1 || entries[0].ContextMap()["request_id"] != "abc-123" {
+		t.Errorf("logged %+v, want one entry tagged with request_id abc-123", entries)
+	}
+}
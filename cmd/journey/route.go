@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"strings"
+)
+
+// parseRouteSettings parses per-route overrides written as a comma separated
+// list of "METHOD /route/{pattern}=value" entries, keying each value parsed by
+// parse as "METHOD /route/{pattern}". what names the setting in errors.
+func parseRouteSettings[T any](s, what string, parse func(string) (T, error)) (map[string]T, error) {
+	settings := make(map[string]T)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s %q: missing value", what, entry)
+		}
+
+		method, pattern, ok := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s %q: expected METHOD /pattern", what, entry)
+		}
+
+		v, err := parse(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", what, entry, err)
+		}
+
+		settings[strings.ToUpper(method)+" "+strings.TrimSpace(pattern)] = v
+	}
+
+	return settings, nil
+}
+
+// matchRoute returns the "METHOD /route/{pattern}" key of the route serving r,
+// reporting false if no route does.
+func matchRoute(routes chi.Routes, r *http.Request) (string, bool) {
+	rctx := chi.NewRouteContext()
+	if !routes.Match(rctx, r.Method, r.URL.Path) {
+		return "", false
+	}
+	return r.Method + " " + rctx.RoutePattern(), true
+}
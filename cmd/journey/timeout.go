@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"net/http"
+	"time"
+)
+
+// parseRouteTimeouts parses per-route timeout overrides written as a comma
+// separated list of "METHOD /route/{pattern}=duration" entries, for example
+// "GET /trips/{tripId}/print=15s,POST /trips/{tripId}/snapshots=10s".
+func parseRouteTimeouts(s string) (map[string]time.Duration, error) {
+	return parseRouteSettings(s, "route timeout", func(value string) (time.Duration, error) {
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return 0, errors.New("bad duration")
+		}
+		return timeout, nil
+	})
+}
+
+// routeTimeout cancels the request context once the timeout of the matched
+// route elapses, answering 504 if the handler has not written yet. Routes with
+// no entry in overrides get the global timeout.
+func routeTimeout(routes chi.Routes, global time.Duration, overrides map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			timeout := global
+			if route, ok := matchRoute(routes, r); ok {
+				if t, ok := overrides[route]; ok {
+					timeout = t
+				}
+			}
+
+			middleware.Timeout(timeout)(next).ServeHTTP(w, r)
+		})
+	}
+}
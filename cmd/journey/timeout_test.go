@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/go-chi/chi/v5"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseRouteTimeouts(t *testing.T) {
+	got, err := parseRouteTimeouts(" get /trips/{tripId}/print=15s, POST /trips/{tripId}/snapshots = 10s ,")
+	if err != nil {
+		t.Fatalf("parseRouteTimeouts: %v", err)
+	}
+	want := map[string]time.Duration{
+		"GET /trips/{tripId}/print":      15 * time.Second,
+		"POST /trips/{tripId}/snapshots": 10 * time.Second,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for route, timeout := range want {
+		if got[route] != timeout {
+			t.Errorf("%s = %s, want %s", route, got[route], timeout)
+		}
+	}
+
+	for _, s := range []string{"GET /trips=soon", "GET /trips=-1s", "GET /trips", "/trips=1s"} {
+		if _, err := parseRouteTimeouts(s); err == nil {
+			t.Errorf("parseRouteTimeouts(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestRouteTimeout(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(routeTimeout(r, time.Second, map[string]time.Duration{"GET /trips/{tripId}/print": time.Minute}))
+
+	var left time.Duration
+	recordDeadline := func(w http.ResponseWriter, r *http.Request) {
+		deadline, ok := r.Context().Deadline()
+		if !ok {
+			t.Error("request has no deadline")
+		}
+		left = time.Until(deadline)
+	}
+	r.Get("/trips/{tripId}/print", recordDeadline)
+	r.Get("/trips/{tripId}", recordDeadline)
+
+	tests := []struct {
+		path string
+		want time.Duration
+	}{
+		{"/trips/42/print", time.Minute},
+		{"/trips/42", time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if left > tt.want || left < tt.want-time.Second/2 {
+				t.Errorf("deadline in %s, want %s", left, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"github.com/discord-gophers/goapi-gen/types"
@@ -11,52 +13,284 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
+	"io"
 	"journey/internal/api/spec"
+	"journey/internal/geocode"
+	"journey/internal/ical"
+	"journey/internal/mailer/message"
 	"journey/internal/pgstore"
+	"journey/internal/webhook"
+	"math"
+	"mime"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
 type store interface {
-	ConfirmTrip(context.Context, uuid.UUID) error
-	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
+	ConfirmTripOnce(context.Context, *pgxpool.Pool, uuid.UUID) error
+	FinalizeTrip(context.Context, *pgxpool.Pool, uuid.UUID) error
+	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest, time.Time) (uuid.UUID, error)
+	DeleteTrip(context.Context, *pgxpool.Pool, uuid.UUID) error
+	EraseOwner(context.Context, *pgxpool.Pool, string) error
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	SearchTrips(context.Context, pgstore.SearchTripsParams) ([]pgstore.Trip, error)
+	CountSearchTrips(context.Context, pgstore.CountSearchTripsParams) (int64, error)
+	GetTripsByParticipantEmail(context.Context, pgstore.GetTripsByParticipantEmailParams) ([]pgstore.Trip, error)
+	CountTripsByParticipantEmail(context.Context, pgstore.CountTripsByParticipantEmailParams) (int64, error)
+	EstimateTripsCount(context.Context, bool) (int64, error)
+	GetOwnerTrips(context.Context, string) ([]pgstore.Trip, error)
+	GetOwnerActiveTrips(context.Context, string) ([]pgstore.Trip, error)
+	GetOwnerConfirmationCounts(context.Context, string) (pgstore.GetOwnerConfirmationCountsRow, error)
+	GetOwnerDestinations(context.Context, string) ([]string, error)
+	GetTripsCreatedPerDay(context.Context, pgstore.GetTripsCreatedPerDayParams) ([]pgstore.GetTripsCreatedPerDayRow, error)
 	UpdateTrip(context.Context, pgstore.UpdateTripParams) error
+	UpdateTripPlace(context.Context, pgstore.UpdateTripPlaceParams) error
+	IsOwner(context.Context, pgstore.IsOwnerParams) (bool, error)
+	IsOrganizer(context.Context, pgstore.IsOrganizerParams) (bool, error)
 
 	ConfirmParticipant(context.Context, uuid.UUID) error
-	InviteParticipantToTrip(context.Context, pgstore.InviteParticipantToTripParams) (uuid.UUID, error)
+	RenewParticipantInvite(context.Context, pgstore.RenewParticipantInviteParams) error
+	DeleteParticipant(context.Context, pgstore.DeleteParticipantParams) (int64, error)
+	ResetParticipantConfirmations(context.Context, pgstore.ResetParticipantConfirmationsParams) error
+	RestoreRemovedParticipant(context.Context, pgstore.RestoreParticipantParams) (int64, error)
+	SetParticipantOrganizer(context.Context, pgstore.SetParticipantOrganizerParams) error
+	SetParticipantGroup(context.Context, pgstore.SetParticipantGroupParams) error
+	InviteParticipant(context.Context, pgstore.InviteParticipantToTripParams) (uuid.UUID, error)
+	InviteParticipants(context.Context, *pgxpool.Pool, []pgstore.InviteParticipantToTripParams) ([]uuid.UUID, error)
 	GetParticipants(context.Context, uuid.UUID) ([]pgstore.Participant, error)
+	GetTripParticipantsNotEmailed(context.Context, uuid.UUID) ([]pgstore.Participant, error)
+	CountTripParticipants(context.Context, uuid.UUID) (int64, error)
+	CreateParticipantUnavailability(context.Context, pgstore.CreateParticipantUnavailabilityParams) (uuid.UUID, error)
+	GetTripConflicts(context.Context, uuid.UUID) ([]pgstore.GetTripConflictsRow, error)
 
+	CreateWebhookSubscription(context.Context, pgstore.CreateWebhookSubscriptionParams) (uuid.UUID, error)
+	GetTripWebhookSubscriptions(context.Context, uuid.UUID) ([]pgstore.WebhookSubscription, error)
+	UpdateWebhookSubscription(context.Context, pgstore.UpdateWebhookSubscriptionParams) (int64, error)
+	DeleteWebhookSubscription(context.Context, pgstore.DeleteWebhookSubscriptionParams) (int64, error)
+
+	CreatePackingItem(context.Context, pgstore.CreatePackingItemParams) (uuid.UUID, error)
+	GetTripPackingItems(context.Context, uuid.UUID) ([]pgstore.PackingItem, error)
+	UpdatePackingItem(context.Context, pgstore.UpdatePackingItemParams) (int64, error)
+	TogglePackingItem(context.Context, pgstore.TogglePackingItemParams) (int64, error)
+	DeletePackingItem(context.Context, pgstore.DeletePackingItemParams) (int64, error)
+
+	ActivityExists(context.Context, pgstore.ActivityExistsParams) (bool, error)
 	CreateActivity(context.Context, pgstore.CreateActivityParams) (uuid.UUID, error)
+	CreateActivities(context.Context, *pgxpool.Pool, []pgstore.CreateActivityParams) ([]uuid.UUID, error)
+	CreateActivityWithLink(context.Context, *pgxpool.Pool, pgstore.CreateActivityParams, pgstore.CreateTripLinkParams) (uuid.UUID, uuid.UUID, error)
 	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	GetTripActivitiesBetween(context.Context, pgstore.GetTripActivitiesBetweenParams) ([]pgstore.Activity, error)
+	GetTripActivitiesCreatedSince(context.Context, pgstore.GetTripActivitiesCreatedSinceParams) ([]pgstore.Activity, error)
+	GetTripActivitiesPaginated(context.Context, pgstore.GetTripActivitiesPaginatedParams) ([]pgstore.Activity, error)
+	GetTripActivityParticipants(context.Context, uuid.UUID) ([]pgstore.GetTripActivityParticipantsRow, error)
+	GetTripBusiestDay(context.Context, uuid.UUID) (pgstore.GetTripBusiestDayRow, error)
+	GetLatestTripEmail(context.Context, pgstore.GetLatestTripEmailParams) (pgstore.EmailLog, error)
+	UpdateLink(context.Context, pgstore.UpdateLinkParams) (int64, error)
+	DeleteLink(context.Context, pgstore.DeleteLinkParams) (int64, error)
+	GetEagerParticipants(context.Context) ([]pgstore.GetEagerParticipantsRow, error)
+	AssignActivityParticipant(context.Context, pgstore.AssignActivityParticipantParams) error
+	UnassignActivityParticipant(context.Context, pgstore.UnassignActivityParticipantParams) (int64, error)
+	CountTripActivitiesByLinks(context.Context, pgstore.CountTripActivitiesByLinksParams) (int64, error)
+	GetOwnerActivitiesBetween(context.Context, pgstore.GetOwnerActivitiesBetweenParams) ([]pgstore.GetOwnerActivitiesBetweenRow, error)
+	CountTripActivities(context.Context, uuid.UUID) (int64, error)
+	GetActivity(context.Context, uuid.UUID) (pgstore.Activity, error)
+	UpdateActivity(context.Context, pgstore.UpdateActivityParams) (int64, error)
+	DeleteActivity(context.Context, pgstore.DeleteActivityParams) (int64, error)
+	CreateActivityAttachment(context.Context, pgstore.CreateActivityAttachmentParams) (uuid.UUID, error)
+	GetActivityAttachment(context.Context, pgstore.GetActivityAttachmentParams) (pgstore.ActivityAttachment, error)
 
 	CreateTripLink(context.Context, pgstore.CreateTripLinkParams) (uuid.UUID, error)
 	GetTripLinks(context.Context, uuid.UUID) ([]pgstore.Link, error)
+	GetTripLinksCreatedBetween(context.Context, pgstore.GetTripLinksCreatedBetweenParams) ([]pgstore.Link, error)
+	CountTripLinks(context.Context, uuid.UUID) (int64, error)
 
 	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	GetParticipantWithTrip(context.Context, uuid.UUID) (pgstore.GetParticipantWithTripRow, error)
+
+	CreateTripSnapshot(context.Context, *pgxpool.Pool, uuid.UUID) (uuid.UUID, error)
+	GetTripSnapshot(context.Context, uuid.UUID) (pgstore.TripSnapshot, error)
+	GetTripSnapshots(context.Context, uuid.UUID) ([]pgstore.GetTripSnapshotsRow, error)
+	RestoreTripSnapshot(context.Context, *pgxpool.Pool, pgstore.TripSnapshot) error
 }
 
 type mailer interface {
 	SendConfirmTripEmailToTripOwner(uuid.UUID) error
+	SendTripFinalizedEmails(uuid.UUID) error
+	SendInviteEmailsToParticipants(uuid.UUID) error
+	ResendInviteEmailsToParticipants(uuid.UUID) error
+	SendInviteEmailToParticipant(uuid.UUID) error
+}
+
+type geocoder interface {
+	Geocode(ctx context.Context, destination string) (geocode.Place, bool, error)
+}
+
+type webhooks interface {
+	Dispatch(tripID uuid.UUID, event string, data any)
+	Replay(ctx context.Context, deliveryID uuid.UUID) (webhook.Attempt, error)
+}
+
+// totalCountHeader carries the number of items of a list route on HEAD requests.
+const totalCountHeader = "X-Total-Count"
+
+// Page sizes of the paginated list endpoints.
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+var (
+	errInvalidSort   = errors.New("invalid sort")
+	errInvalidLimit  = errors.New("invalid limit")
+	errInvalidOffset = errors.New("invalid offset")
+)
+
+// pagination is the page of a paginated list endpoint a request asks for.
+type pagination struct {
+	limit  int32
+	offset int32
+	// sort is a key of the endpoint sorts, prefixed with "-" when descending.
+	sort string
+}
+
+// parsePagination validates the sort, limit and offset params of a paginated
+// list endpoint, defaulting them when missing.
+func parsePagination[T any](sort *string, limit, offset *int, sorts listSort[T], defaultSort string) (pagination, error) {
+	p := pagination{sort: defaultSort}
+	if sort != nil {
+		p.sort = *sort
+	}
+	if sorts.compare(p.sort) == nil {
+		return pagination{}, errInvalidSort
+	}
+
+	l := defaultPageLimit
+	if limit != nil {
+		l = *limit
+	}
+	if l < 1 || l > maxPageLimit {
+		return pagination{}, errInvalidLimit
+	}
+	p.limit = int32(l)
+
+	var o int
+	if offset != nil {
+		o = *offset
+	}
+	if o < 0 || o > math.MaxInt32 {
+		return pagination{}, errInvalidOffset
+	}
+	p.offset = int32(o)
+
+	return p, nil
+}
+
+// errNotTripParticipant is returned when a participant referenced by a request
+// does not belong to the trip being changed.
+var errNotTripParticipant = errors.New("participant does not belong to the trip")
+
+// maxICSUploadSize caps the .ics file accepted by PostTripsTripIDActivitiesImportIcs.
+const maxICSUploadSize = 1 << 20
+
+// maxCSVUploadSize caps the .csv file accepted by PostTripsTripIDParticipantsImportCsv.
+const maxCSVUploadSize = 1 << 20
+
+// maxAttachmentUploadSize caps the file accepted by PostTripsTripIDActivitiesActivityIDAttachments.
+const maxAttachmentUploadSize = 5 << 20
+
+// attachmentContentTypes are the sniffed content types an activity attachment
+// may have.
+var attachmentContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
 }
 
 type API struct {
-	store     store
-	logger    *zap.Logger
-	validator *validator.Validate
-	pool      *pgxpool.Pool
-	mailer    mailer
+	store      store
+	logger     *zap.Logger
+	validator  *validator.Validate
+	pool       *pgxpool.Pool
+	mailer     mailer
+	webhooks   webhooks
+	geocoder   geocoder
+	inviteTTL  time.Duration
+	adminToken string
+	baseURL    string
+	maxLinks   int64
+	// restoreWindow is how long a removed participant can still be restored.
+	restoreWindow time.Duration
+	// minLeadTime is how long before it starts a trip must be created.
+	minLeadTime time.Duration
+
+	// Default sorts of the list endpoints, used when no sort param is given.
+	tripsSort        string
+	activitiesSort   string
+	participantsSort string
+	linksSort        string
 }
 
-func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer) API {
+func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer, webhooks webhooks, geocoder geocoder) API {
 	apiValidator := validator.New(validator.WithRequiredStructEnabled())
+	apiValidator.RegisterTagNameFunc(jsonFieldName)
 	return API{
-		store:     pgstore.New(pool),
-		logger:    logger,
-		validator: apiValidator,
-		pool:      pool,
-		mailer:    mailer,
+		store:      pgstore.New(pool),
+		logger:     logger,
+		validator:  apiValidator,
+		pool:       pool,
+		mailer:     mailer,
+		webhooks:   webhooks,
+		geocoder:   geocoder,
+		inviteTTL:  time.Duration(envInt("JOURNEY_INVITE_EXPIRATION_DAYS", 7)) * 24 * time.Hour,
+		adminToken: os.Getenv("JOURNEY_ADMIN_TOKEN"),
+		baseURL:    os.Getenv("JOURNEY_API_BASE_URL"),
+		maxLinks:   int64(envInt("JOURNEY_MAX_LINKS_PER_TRIP", 50)),
+
+		restoreWindow: time.Duration(envInt("JOURNEY_PARTICIPANT_RESTORE_WINDOW_HOURS", 72)) * time.Hour,
+		minLeadTime:   time.Duration(envInt("JOURNEY_TRIP_MIN_LEAD_HOURS", 0)) * time.Hour,
+
+		tripsSort:        envSort("JOURNEY_DEFAULT_SORT_TRIPS", tripSorts, "starts_at"),
+		activitiesSort:   envSort("JOURNEY_DEFAULT_SORT_ACTIVITIES", activitySorts, "occurs_at"),
+		participantsSort: envSort("JOURNEY_DEFAULT_SORT_PARTICIPANTS", participantSorts, "created_at"),
+		linksSort:        envSort("JOURNEY_DEFAULT_SORT_LINKS", linkSorts, "created_at"),
+	}
+}
+
+// tripParticipantID resolves participantID, if any, to a participant of the
+// trip, as stored in nullable participant columns.
+func (api API) tripParticipantID(ctx context.Context, tripID uuid.UUID, participantID *string) (pgtype.UUID, error) {
+	if participantID == nil {
+		return pgtype.UUID{}, nil
+	}
+
+	participantUUID, err := uuid.Parse(*participantID)
+	if err != nil {
+		return pgtype.UUID{}, errNotTripParticipant
 	}
+
+	participant, err := api.store.GetParticipant(ctx, participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgtype.UUID{}, errNotTripParticipant
+		}
+		return pgtype.UUID{}, err
+	}
+	if participant.TripID != tripID {
+		return pgtype.UUID{}, errNotTripParticipant
+	}
+
+	return pgtype.UUID{Bytes: participant.ID, Valid: true}, nil
+}
+
+// inviteExpiresAt is the expiration of an invite sent now.
+func (api API) inviteExpiresAt() time.Time {
+	return time.Now().UTC().Add(api.inviteTTL)
 }
 
 // PatchParticipantsParticipantIDConfirm Confirms a participant on a trip.
@@ -67,423 +301,3805 @@ func (api API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *h
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "uuid inválido"})
 	}
 
-	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	row, err := api.store.GetParticipantWithTrip(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	participant, trip := row.Participant, row.Trip
+
+	if participant.IsConfirmed {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participante já confirmado"})
+	}
+
+	if participant.InviteExpiresAt.Time.Before(time.Now().UTC()) {
+		return spec.PatchParticipantsParticipantIDConfirmJSON410Response(spec.Error{Message: "convite expirado"})
+	}
+
+	if err := api.store.ConfirmParticipant(r.Context(), participantUUID); err != nil {
+		api.log(r.Context()).Error("failed to confirm participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	api.webhooks.Dispatch(trip.ID, webhook.EventParticipantConfirmed, webhook.ParticipantConfirmed{
+		ParticipantID: participantID,
+		Email:         participant.Email,
+	})
+
+	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+}
+
+// GetParticipantsConfirmValidate Check a participant confirmation token without confirming.
+// (GET /participants/confirm/validate)
+func (api API) GetParticipantsConfirmValidate(w http.ResponseWriter, r *http.Request, params spec.GetParticipantsConfirmValidateParams) *spec.Response {
+	invalid := func(reason spec.ValidateConfirmationTokenResponseReason) *spec.Response {
+		return spec.GetParticipantsConfirmValidateJSON200Response(spec.ValidateConfirmationTokenResponse{
+			Valid:  false,
+			Reason: &reason,
+		})
+	}
+
+	participantUUID, err := uuid.Parse(params.Token)
+	if err != nil {
+		return invalid(spec.ValidateConfirmationTokenResponseReasonNotFound)
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return invalid(spec.ValidateConfirmationTokenResponseReasonNotFound)
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", params.Token))
+		return spec.GetParticipantsConfirmValidateJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	// Same checks as PatchParticipantsParticipantIDConfirm, in the same order.
+	if participant.IsConfirmed {
+		return invalid(spec.ValidateConfirmationTokenResponseReasonAlreadyConfirmed)
+	}
+	if participant.InviteExpiresAt.Time.Before(time.Now().UTC()) {
+		return invalid(spec.ValidateConfirmationTokenResponseReasonExpired)
+	}
+
+	return spec.GetParticipantsConfirmValidateJSON200Response(spec.ValidateConfirmationTokenResponse{
+		Valid:     true,
+		ExpiresAt: &participant.InviteExpiresAt.Time,
+	})
+}
+
+// PostParticipantsParticipantIDReissueInvite Reissue a participant invite, extending its expiration.
+// (POST /participants/{participantId}/reissue-invite)
+func (api API) PostParticipantsParticipantIDReissueInvite(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), participant.TripID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostParticipantsParticipantIDReissueInviteJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	if participant.IsConfirmed {
+		return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "participante já confirmado"})
+	}
+
+	err = api.store.RenewParticipantInvite(r.Context(), pgstore.RenewParticipantInviteParams{
+		InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: api.inviteExpiresAt()},
+		ID:              participantUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to reissue invite", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDReissueInviteJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	return spec.PostParticipantsParticipantIDReissueInviteJSON204Response(nil)
+}
+
+// PatchParticipantsParticipantIDOrganizer Promote or demote a participant as a trip organizer.
+// (PATCH /participants/{participantId}/organizer)
+func (api API) PatchParticipantsParticipantIDOrganizer(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.SetParticipantOrganizerRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	isOwner, err := api.store.IsOwner(r.Context(), pgstore.IsOwnerParams{
+		TripID: participant.TripID,
+		Email:  requesterEmail(r),
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip owner", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !isOwner {
+		return spec.PatchParticipantsParticipantIDOrganizerJSON403Response(spec.Error{Message: "apenas o dono pode definir organizadores"})
+	}
+
+	err = api.store.SetParticipantOrganizer(r.Context(), pgstore.SetParticipantOrganizerParams{
+		IsOrganizer: body.IsOrganizer,
+		ID:          participantUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to set participant organizer", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDOrganizerJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	return spec.PatchParticipantsParticipantIDOrganizerJSON204Response(nil)
+}
+
+// PostParticipantsParticipantIDUnavailabilities Mark a period when a participant is unavailable.
+// (POST /participants/{participantId}/unavailabilities)
+func (api API) PostParticipantsParticipantIDUnavailabilities(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.CreateUnavailabilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(validationError(err))
+	}
+
+	if body.EndsAt.Before(body.StartsAt) {
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON422Response(spec.Error{Message: "ends_at must not be before starts_at"})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	// Participants mark their own unavailability; the owner and organizers
+	// may mark it for anyone.
+	canEdit, err := api.canEditTrip(r.Context(), participant.TripID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit && !strings.EqualFold(requesterEmail(r), participant.Email) {
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON403Response(spec.Error{Message: "apenas o próprio participante, o dono ou organizadores podem marcar indisponibilidades"})
+	}
+
+	unavailabilityID, err := api.store.CreateParticipantUnavailability(r.Context(), pgstore.CreateParticipantUnavailabilityParams{
+		ParticipantID: participantUUID,
+		StartsAt:      pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		EndsAt:        pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create unavailability", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDUnavailabilitiesJSON400Response(spec.Error{Message: "failed to create unavailability, try again"})
+	}
+
+	return spec.PostParticipantsParticipantIDUnavailabilitiesJSON201Response(spec.CreateUnavailabilityResponse{
+		UnavailabilityID: unavailabilityID.String(),
+	})
+}
+
+// PatchParticipantsParticipantIDGroup Put a participant in a group, or take them out of it.
+// (PATCH /participants/{participantId}/group)
+func (api API) PatchParticipantsParticipantIDGroup(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "uuid inválido"})
+	}
+
+	var body spec.SetParticipantGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(validationError(err))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), participant.TripID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PatchParticipantsParticipantIDGroupJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	var groupName pgtype.Text
+	if body.GroupName != nil && strings.TrimSpace(*body.GroupName) != "" {
+		groupName = pgtype.Text{Valid: true, String: strings.TrimSpace(*body.GroupName)}
+	}
+
+	err = api.store.SetParticipantGroup(r.Context(), pgstore.SetParticipantGroupParams{
+		GroupName: groupName,
+		ID:        participantUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to set participant group", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDGroupJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	return spec.PatchParticipantsParticipantIDGroupJSON204Response(nil)
+}
+
+// GetTrips List trips.
+// (GET /trips)
+func (api API) GetTrips(w http.ResponseWriter, r *http.Request, params spec.GetTripsParams) *spec.Response {
+	page, err := parsePagination(params.Sort, params.Limit, params.Offset, tripSorts, api.tripsSort)
+	if err != nil {
+		return spec.GetTripsJSON400Response(spec.Error{Message: err.Error()})
+	}
+
+	// The generated binding leaves a zero time, rather than nil, for a time
+	// that is not in the query.
+	if params.StartsAfter != nil && params.StartsAfter.IsZero() {
+		params.StartsAfter = nil
+	}
+	if params.EndsBefore != nil && params.EndsBefore.IsZero() {
+		params.EndsBefore = nil
+	}
+
+	if params.StartsAfter != nil && params.EndsBefore != nil && !params.StartsAfter.Before(*params.EndsBefore) {
+		return spec.GetTripsJSON400Response(spec.Error{Message: "invalid input: starts_after must be before ends_before"})
+	}
+
+	// Archived trips are only listed when asked for, and then on their own.
+	filters := pgstore.CountSearchTripsParams{
+		AllConfirmed: params.AllConfirmed != nil && *params.AllConfirmed,
+		Archived:     params.Archived != nil && *params.Archived,
+	}
+	if params.Destination != nil && *params.Destination != "" {
+		filters.Destination = pgtype.Text{Valid: true, String: likeEscaper.Replace(*params.Destination)}
+	}
+	if params.StartsAfter != nil {
+		filters.StartsAfter = pgtype.Timestamp{Valid: true, Time: *params.StartsAfter}
+	}
+	if params.EndsBefore != nil {
+		filters.EndsBefore = pgtype.Timestamp{Valid: true, Time: *params.EndsBefore}
+	}
+	if params.IsConfirmed != nil {
+		filters.IsConfirmed = pgtype.Bool{Valid: true, Bool: *params.IsConfirmed}
+	}
+
+	exact := params.Exact == nil || *params.Exact
+	total, isEstimate, err := api.countTrips(r.Context(), filters, exact)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count trips", zap.Error(err))
+		return spec.GetTripsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	// The page is sorted by the database, using the same keys as tripSorts.
+	trips, err := api.store.SearchTrips(r.Context(), pgstore.SearchTripsParams{
+		AllConfirmed: filters.AllConfirmed,
+		Destination:  filters.Destination,
+		StartsAfter:  filters.StartsAfter,
+		EndsBefore:   filters.EndsBefore,
+		IsConfirmed:  filters.IsConfirmed,
+		Archived:     filters.Archived,
+		Sort:         page.sort,
+		Limit:        page.limit,
+		Offset:       page.offset,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get trips", zap.Error(err))
+		return spec.GetTripsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	output := spec.GetTripsResponse{
+		Trips:      make([]spec.GetTripDetailsResponseTripObj, len(trips)),
+		Total:      int(total),
+		IsEstimate: isEstimate,
+	}
+	for i, trip := range trips {
+		output.Trips[i] = tripResponse(trip)
+	}
+
+	return spec.GetTripsJSON200Response(output)
+}
+
+// countTrips counts the trips matching filters. Unless exact is set, a search
+// filtered by nothing but whether trips are archived is answered with the
+// planner's estimate of how many active or archived trips there are, which is
+// reported back as such. The exact count is used when the table has not been
+// analyzed yet and has no estimate.
+func (api API) countTrips(ctx context.Context, filters pgstore.CountSearchTripsParams, exact bool) (int64, bool, error) {
+	if !exact && filters == (pgstore.CountSearchTripsParams{Archived: filters.Archived}) {
+		estimate, err := api.store.EstimateTripsCount(ctx, filters.Archived)
+		if err != nil {
+			return 0, false, err
+		}
+		if estimate >= 0 {
+			return estimate, true, nil
+		}
+	}
+
+	total, err := api.store.CountSearchTrips(ctx, filters)
+	return total, false, err
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern, so user input only
+// ever matches literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GetParticipants List the trips an email is a participant of.
+// (GET /participants)
+func (api API) GetParticipants(w http.ResponseWriter, r *http.Request, params spec.GetParticipantsParams) *spec.Response {
+	email := string(params.Email)
+	if err := api.validator.Var(email, "required,email"); err != nil {
+		return spec.GetParticipantsJSON400Response(spec.Error{Message: "invalid email"})
+	}
+
+	page, err := parsePagination(params.Sort, params.Limit, params.Offset, tripSorts, api.tripsSort)
+	if err != nil {
+		return spec.GetParticipantsJSON400Response(spec.Error{Message: err.Error()})
+	}
+
+	archived := params.Archived != nil && *params.Archived
+	total, err := api.store.CountTripsByParticipantEmail(r.Context(), pgstore.CountTripsByParticipantEmailParams{
+		Email:    email,
+		Archived: archived,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to count participant trips", zap.Error(err))
+		return spec.GetParticipantsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	// The page is sorted by the database, using the same keys as tripSorts.
+	trips, err := api.store.GetTripsByParticipantEmail(r.Context(), pgstore.GetTripsByParticipantEmailParams{
+		Email:    email,
+		Archived: archived,
+		Sort:     page.sort,
+		Limit:    page.limit,
+		Offset:   page.offset,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participant trips", zap.Error(err))
+		return spec.GetParticipantsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	output := spec.GetParticipantTripsResponse{
+		Trips: make([]spec.GetTripDetailsResponseTripObj, len(trips)),
+		Total: int(total),
+	}
+	for i, trip := range trips {
+		output.Trips[i] = tripResponse(trip)
+	}
+
+	return spec.GetParticipantsJSON200Response(output)
+}
+
+// GetTripsByMonth List an owner's trips grouped by the month they start.
+// (GET /trips/by-month)
+func (api API) GetTripsByMonth(w http.ResponseWriter, r *http.Request, params spec.GetTripsByMonthParams) *spec.Response {
+	ownerEmail := string(params.OwnerEmail)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.GetTripsByMonthJSON400Response(spec.Error{Message: "invalid owner_email"})
+	}
+
+	trips, err := api.store.GetOwnerActiveTrips(r.Context(), ownerEmail)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get owner trips", zap.Error(err))
+		return spec.GetTripsByMonthJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	// trips come ordered by starts_at, so each month is a run of trips.
+	output := spec.GetTripsByMonthResponse{Months: make([]spec.GetTripsByMonthResponseArray, 0)}
+	for _, trip := range trips {
+		month := trip.StartsAt.Time.Format("2006-01")
+		if n := len(output.Months); n == 0 || output.Months[n-1].Month != month {
+			output.Months = append(output.Months, spec.GetTripsByMonthResponseArray{Month: month})
+		}
+		last := &output.Months[len(output.Months)-1]
+		last.Trips = append(last.Trips, tripResponse(trip))
+	}
+
+	return spec.GetTripsByMonthJSON200Response(output)
+}
+
+// tripResponse is how a trip is listed in responses.
+func tripResponse(trip pgstore.Trip) spec.GetTripDetailsResponseTripObj {
+	res := spec.GetTripDetailsResponseTripObj{
+		Destination: trip.Destination,
+		EndsAt:      trip.EndsAt.Time,
+		ID:          trip.ID.String(),
+		IsConfirmed: trip.IsConfirmed,
+		OwnerEmail:  types.Email(trip.OwnerEmail),
+		OwnerName:   trip.OwnerName,
+		StartsAt:    trip.StartsAt.Time,
+		Status:      spec.GetTripDetailsResponseTripObjStatusActive,
+	}
+	if trip.Status == "archived" {
+		res.Status = spec.GetTripDetailsResponseTripObjStatusArchived
+	}
+	if trip.PlaceName.Valid {
+		res.Place = &spec.TripPlace{
+			Name:      trip.PlaceName.String,
+			Latitude:  trip.Latitude.Float64,
+			Longitude: trip.Longitude.Float64,
+		}
+	}
+	return res
+}
+
+// locateTrip stores on the trip the place its destination is geocoded to,
+// when the geocoder finds one. It runs after the trip is created, so a slow or
+// failing geocoder never holds the creation back.
+func (api API) locateTrip(ctx context.Context, tripID uuid.UUID, destination string) {
+	ctx = context.WithoutCancel(ctx)
+	logger := api.log(ctx)
+
+	place, found, err := api.geocoder.Geocode(ctx, destination)
+	if err != nil {
+		logger.Warn("failed to geocode trip destination", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return
+	}
+	if !found {
+		return
+	}
+
+	err = api.store.UpdateTripPlace(ctx, pgstore.UpdateTripPlaceParams{
+		ID:        tripID,
+		PlaceName: pgtype.Text{Valid: true, String: place.Name},
+		Latitude:  pgtype.Float8{Valid: true, Float64: place.Latitude},
+		Longitude: pgtype.Float8{Valid: true, Float64: place.Longitude},
+	})
+	if err != nil {
+		logger.Error("failed to store trip place", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+// PostTrips Create a new trip
+// (POST /trips)
+func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.CreateTripRequest
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsJSON400Response(validationError(err))
+	}
+
+	// The body is well formed from here on, so breaking a business rule is a
+	// 422 rather than a 400.
+	if body.EndsAt.Before(body.StartsAt) {
+		return spec.PostTripsJSON422Response(spec.Error{Message: "ends_at must not be before starts_at"})
+	}
+
+	if api.minLeadTime > 0 && body.StartsAt.Before(time.Now().UTC().Add(api.minLeadTime)) {
+		return spec.PostTripsJSON422Response(spec.Error{
+			Message: "invalid input: trip must start at least " + strconv.Itoa(int(api.minLeadTime.Hours())) + " hours from now",
+		})
+	}
+
+	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body, api.inviteExpiresAt())
+	if err != nil {
+		if errors.Is(err, pgstore.ErrParticipantAlreadyInvited) {
+			return spec.PostTripsJSON409Response(spec.Error{Message: "emails_to_invite tem emails repetidos"})
+		}
+		api.log(r.Context()).Error("failed to create trip", zap.Error(err))
+		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+	}
+
+	go func() {
+		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on PostTrips",
+				zap.Error(err),
+				zap.String("trip_id", tripID.String()),
+			)
+		}
+	}()
+
+	go api.locateTrip(r.Context(), tripID, body.Destination)
+
+	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
+}
+
+// PostTripsImportValidate Validate a trip export bundle without importing it.
+// (POST /trips/import/validate)
+func (api API) PostTripsImportValidate(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var bundle pgstore.TripSnapshotData
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		return spec.PostTripsImportValidateJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	issues := api.validateTripBundle(bundle)
+
+	return spec.PostTripsImportValidateJSON200Response(spec.ValidateTripBundleResponse{
+		Valid: len(issues) == 0,
+		Counts: spec.ValidateTripBundleResponseCountsObj{
+			Participants: len(bundle.Participants),
+			Activities:   len(bundle.Activities),
+			Links:        len(bundle.Links),
+		},
+		Issues: issues,
+	})
+}
+
+// GetTripsTripID Get a trip details.
+// (GET /trips/{tripId})
+func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
+		Trip: tripResponse(trip),
+	})
+}
+
+// GetTripsTripIDCard Get a minimal trip card for embedding.
+// (GET /trips/{tripId}/card)
+func (api API) GetTripsTripIDCard(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDCardJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDCardJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDCardJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	status := spec.GetTripCardResponseStatusPending
+	if trip.IsConfirmed {
+		status = spec.GetTripCardResponseStatusConfirmed
+	}
+
+	return spec.GetTripsTripIDCardJSON200Response(spec.GetTripCardResponse{
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time,
+		EndsAt:      trip.EndsAt.Time,
+		Status:      status,
+	})
+}
+
+// GetTripsTripIDPrint Get a printable itinerary of a trip.
+// (GET /trips/{tripId}/print)
+func (api API) GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	links, err := api.store.GetTripLinks(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "failed to get links"})
+	}
+
+	var buf bytes.Buffer
+	if err := printTemplate.Execute(&buf, newPrintPage(trip, activities, links)); err != nil {
+		api.log(r.Context()).Error("failed to render itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{Message: "failed to render itinerary"})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+	return nil
+}
+
+// PutTripsTripID Update a trip.
+// (PUT /trips/{tripId})
+func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	var body spec.UpdateTripRequest
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDJSON400Response(validationError(err))
+	}
+
+	if body.EndsAt.Before(body.StartsAt) {
+		return spec.PutTripsTripIDJSON422Response(spec.Error{Message: "ends_at must not be before starts_at"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	err = api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
+		Destination: body.Destination,
+		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
+		ID:          tripUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	return spec.PutTripsTripIDJSON204Response(nil)
+}
+
+// GetTripsTripIDActivities Get a trip activities.
+// (GET /trips/{tripId}/activities)
+func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	page, err := parsePagination(params.Sort, params.Limit, params.Offset, activitySorts, api.activitiesSort)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: err.Error()})
+	}
+
+	var hasLinks pgtype.Bool
+	if params.HasLinks != nil {
+		hasLinks = pgtype.Bool{Valid: true, Bool: *params.HasLinks}
+	}
+
+	total, err := api.store.CountTripActivitiesByLinks(r.Context(), pgstore.CountTripActivitiesByLinksParams{
+		TripID:   tripUUID,
+		HasLinks: hasLinks,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to count activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	// The page is sorted by the database, using the same keys as activitySorts.
+	activitiesInDB, err := api.store.GetTripActivitiesPaginated(r.Context(), pgstore.GetTripActivitiesPaginatedParams{
+		TripID:   tripUUID,
+		HasLinks: hasLinks,
+		Sort:     page.sort,
+		Limit:    page.limit,
+		Offset:   page.offset,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	assigned, err := api.activityParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	// Dates are listed in the order their first activity was sorted in.
+	var activities []spec.GetTripActivitiesResponseOuterArray
+	dateIndex := make(map[time.Time]int)
+	for _, activity := range activitiesInDB {
+		date := activity.OccursAt.Time
+		i, ok := dateIndex[date]
+		if !ok {
+			i = len(activities)
+			dateIndex[date] = i
+			activities = append(activities, spec.GetTripActivitiesResponseOuterArray{Date: date})
+		}
+		activities[i].Activities = append(activities[i].Activities, activityResponse(activity, trip, assigned[activity.ID]))
+	}
+
+	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
+		Activities: activities,
+		Total:      int(total),
+	})
+}
+
+// GetTripsTripIDActivitiesRecent Get the activities recently added to a trip.
+// (GET /trips/{tripId}/activities/recent)
+func (api API) GetTripsTripIDActivitiesRecent(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesRecentParams) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesRecentJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	// The generated binding leaves a zero time, rather than failing, when
+	// since is not in the query.
+	if params.Since.IsZero() {
+		return spec.GetTripsTripIDActivitiesRecentJSON400Response(spec.Error{Message: "invalid input: since is required"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesRecentJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesRecentJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	// created_at is stored without a time zone, in UTC.
+	activitiesInDB, err := api.store.GetTripActivitiesCreatedSince(r.Context(), pgstore.GetTripActivitiesCreatedSinceParams{
+		TripID: tripUUID,
+		Since:  pgtype.Timestamp{Valid: true, Time: params.Since.UTC()},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get recent activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesRecentJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	assigned, err := api.activityParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesRecentJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	activities := make([]spec.GetTripActivitiesResponseInnerArray, len(activitiesInDB))
+	for i, activity := range activitiesInDB {
+		activities[i] = activityResponse(activity, trip, assigned[activity.ID])
+	}
+
+	return spec.GetTripsTripIDActivitiesRecentJSON200Response(spec.GetRecentActivitiesResponse{Activities: activities})
+}
+
+// activityResponse is how an activity of trip is listed in responses, along
+// with the participants assigned to it.
+func activityResponse(activity pgstore.Activity, trip pgstore.Trip, participants []spec.ActivityParticipant) spec.GetTripActivitiesResponseInnerArray {
+	if participants == nil {
+		participants = make([]spec.ActivityParticipant, 0)
+	}
+	output := spec.GetTripActivitiesResponseInnerArray{
+		ID:           activity.ID.String(),
+		OccursAt:     activity.OccursAt.Time,
+		Title:        activity.Title,
+		Participants: participants,
+		DayNumber:    tripDayNumber(trip, activity.OccursAt.Time),
+		TimeOfDay:    activity.OccursAt.Time.Format("15:04"),
+		CreatedAt:    activity.CreatedAt.Time,
+	}
+	if activity.RemindBeforeMinutes.Valid {
+		remindBeforeMinutes := int(activity.RemindBeforeMinutes.Int32)
+		output.RemindBeforeMinutes = &remindBeforeMinutes
+	}
+	if activity.DurationMinutes.Valid {
+		durationMinutes := int(activity.DurationMinutes.Int32)
+		output.DurationMinutes = &durationMinutes
+	}
+	return output
+}
+
+// tripDayNumber is the day of trip that at falls on, counting from 1 on the day
+// the trip starts. Trip timestamps are stored in the trip local time, so the
+// calendar days are compared as they are.
+func tripDayNumber(trip pgstore.Trip, at time.Time) int {
+	start := trip.StartsAt.Time
+	startDay := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(startDay).Hours()/24) + 1
+}
+
+// tripDays lists the calendar days of trip, from the day it starts to the day
+// it ends, both included.
+func tripDays(trip pgstore.Trip) []time.Time {
+	start, end := trip.StartsAt.Time, trip.EndsAt.Time
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	var days []time.Time
+	for ; !day.After(last); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days
+}
+
+// activityParticipants lists the participants assigned to each activity of the
+// trip, by activity id.
+func (api API) activityParticipants(ctx context.Context, tripID uuid.UUID) (map[uuid.UUID][]spec.ActivityParticipant, error) {
+	rows, err := api.store.GetTripActivityParticipants(ctx, tripID)
+	if err != nil {
+		return nil, err
+	}
+
+	assigned := make(map[uuid.UUID][]spec.ActivityParticipant)
+	for _, row := range rows {
+		participant := spec.ActivityParticipant{
+			ID:    row.ID.String(),
+			Email: types.Email(row.Email),
+		}
+		if row.Name.Valid {
+			participant.Name = &row.Name.String
+		}
+		assigned[row.ActivityID] = append(assigned[row.ActivityID], participant)
+	}
+	return assigned, nil
+}
+
+// GetTripsTripIDDaysDate Get the schedule of a trip day.
+// (GET /trips/{tripId}/days/{date})
+func (api API) GetTripsTripIDDaysDate(w http.ResponseWriter, r *http.Request, tripID string, date string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	// The date is bound as a plain string: the generated binder would parse a
+	// types.Date as a full RFC 3339 timestamp and reject every date.
+	dayStart, err := time.Parse(types.DateFormat, date)
+	if err != nil {
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "invalid date"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	if dayStart.Before(trip.StartsAt.Time.Truncate(24*time.Hour)) || dayStart.After(trip.EndsAt.Time) {
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "data fora do período da viagem"})
+	}
+	startsAt := pgtype.Timestamp{Valid: true, Time: dayStart}
+	endsAt := pgtype.Timestamp{Valid: true, Time: dayStart.AddDate(0, 0, 1)}
+
+	activitiesInDB, err := api.store.GetTripActivitiesBetween(r.Context(), pgstore.GetTripActivitiesBetweenParams{
+		TripID:   tripUUID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	linksInDB, err := api.store.GetTripLinksCreatedBetween(r.Context(), pgstore.GetTripLinksCreatedBetweenParams{
+		TripID:   tripUUID,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "failed to get links"})
+	}
+
+	assigned, err := api.activityParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDaysDateJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	output := spec.GetTripDayResponse{
+		Date:       types.Date{Time: dayStart},
+		Activities: make([]spec.GetTripActivitiesResponseInnerArray, 0, len(activitiesInDB)),
+		Links:      make([]spec.GetLinksResponseArray, 0, len(linksInDB)),
+	}
+	for _, activity := range activitiesInDB {
+		output.Activities = append(output.Activities, activityResponse(activity, trip, assigned[activity.ID]))
+	}
+	for _, link := range linksInDB {
+		output.Links = append(output.Links, spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		})
+	}
+
+	return spec.GetTripsTripIDDaysDateJSON200Response(output)
+}
+
+// HeadTripsTripIDActivities Count a trip activities.
+// (HEAD /trips/{tripId}/activities)
+func (api API) HeadTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.HeadTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.HeadTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	count, err := api.store.CountTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to count activities"})
+	}
+
+	w.Header().Set(totalCountHeader, strconv.FormatInt(count, 10))
+	return &spec.Response{Code: http.StatusOK}
+}
+
+// PostTripsTripIDActivities Create a trip activity.
+// (POST /trips/{tripId}/activities)
+func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateActivityRequest
+
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(validationError(err))
+	}
+
+	if body.Link != nil && !isHTTPURL(body.Link.URL) {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid URL"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	// The trip boundaries themselves are part of the trip.
+	if body.OccursAt.Before(trip.StartsAt.Time) || body.OccursAt.After(trip.EndsAt.Time) {
+		return spec.PostTripsTripIDActivitiesJSON422Response(spec.Error{Message: "activity must occur during the trip"})
+	}
+
+	activityParams := pgstore.CreateActivityParams{
+		TripID:   tripUUID,
+		Title:    body.Title,
+		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+	}
+	if body.RemindBeforeMinutes != nil {
+		activityParams.RemindBeforeMinutes = pgtype.Int4{Valid: true, Int32: int32(*body.RemindBeforeMinutes)}
+	}
+	if body.DurationMinutes != nil {
+		activityParams.DurationMinutes = pgtype.Int4{Valid: true, Int32: int32(*body.DurationMinutes)}
+	}
+
+	// Titles are compared trimmed and case-folded, so "Lunch" and "lunch "
+	// are the same activity.
+	exists, err := api.store.ActivityExists(r.Context(), pgstore.ActivityExistsParams{
+		TripID:   tripUUID,
+		Title:    activityParams.Title,
+		OccursAt: activityParams.OccursAt,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to check duplicated activity", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create trip activity, try again"})
+	}
+	if exists {
+		return spec.PostTripsTripIDActivitiesJSON409Response(spec.Error{Message: "já existe uma atividade com esse título nesse horário"})
+	}
+
+	if body.Link != nil {
+		full, err := api.linksFull(r.Context(), tripUUID)
+		if err != nil {
+			api.log(r.Context()).Error("failed to count links", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create trip activity, try again"})
+		}
+		if full {
+			return spec.PostTripsTripIDActivitiesJSON409Response(api.linksFullError())
+		}
+
+		activityID, linkID, err := api.store.CreateActivityWithLink(r.Context(), api.pool, activityParams, pgstore.CreateTripLinkParams{
+			TripID: tripUUID,
+			Title:  body.Link.Title,
+			Url:    body.Link.URL,
+		})
+		if err != nil {
+			api.log(r.Context()).Error("failed to create activity with link", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create trip activity, try again"})
+		}
+
+		api.webhooks.Dispatch(tripUUID, webhook.EventActivityCreated, webhook.ActivityCreated{
+			ActivityID: activityID.String(),
+			Title:      body.Title,
+			OccursAt:   body.OccursAt,
+		})
+
+		linkIDStr := linkID.String()
+		return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
+			ActivityID: activityID.String(),
+			LinkID:     &linkIDStr,
+		})
+	}
+
+	activityId, err := api.store.CreateActivity(r.Context(), activityParams)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create trip activity, try again"})
+	}
+
+	api.webhooks.Dispatch(tripUUID, webhook.EventActivityCreated, webhook.ActivityCreated{
+		ActivityID: activityId.String(),
+		Title:      body.Title,
+		OccursAt:   body.OccursAt,
+	})
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
+		ActivityID: activityId.String(),
+	})
+}
+
+// activityKey identifies an activity the way ActivityExists compares them,
+// with its title trimmed and case-folded, to catch duplicates among the
+// activities of a single request.
+func activityKey(title string, occursAt time.Time) string {
+	return strings.ToLower(strings.Trim(title, " ")) + "@" + occursAt.UTC().Format(time.RFC3339Nano)
+}
+
+// PostTripsTripIDActivitiesImportIcs Import trip activities from an .ics file.
+// (POST /trips/{tripId}/activities/import-ics)
+func (api API) PostTripsTripIDActivitiesImportIcs(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxICSUploadSize)
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "invalid file: " + err.Error()})
+	}
+	defer file.Close()
+
+	events, err := ical.Parse(file)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "invalid ics: " + err.Error()})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	var (
+		params   []pgstore.CreateActivityParams
+		rejected = make([]spec.ImportActivitiesResponseRejectedArray, 0)
+		seen     = make(map[string]bool)
+	)
+	for _, event := range events {
+		if event.Start.IsZero() {
+			rejected = append(rejected, spec.ImportActivitiesResponseRejectedArray{
+				Title:  event.Summary,
+				Reason: "missing DTSTART",
+			})
+			continue
+		}
+
+		occursAt := event.Start
+		switch {
+		case event.Summary == "":
+			rejected = append(rejected, spec.ImportActivitiesResponseRejectedArray{
+				OccursAt: &occursAt,
+				Reason:   "missing SUMMARY",
+			})
+		case occursAt.Before(trip.StartsAt.Time) || occursAt.After(trip.EndsAt.Time):
+			rejected = append(rejected, spec.ImportActivitiesResponseRejectedArray{
+				Title:    event.Summary,
+				OccursAt: &occursAt,
+				Reason:   "activity must occur during the trip",
+			})
+		case seen[activityKey(event.Summary, occursAt)]:
+			rejected = append(rejected, spec.ImportActivitiesResponseRejectedArray{
+				Title:    event.Summary,
+				OccursAt: &occursAt,
+				Reason:   "duplicated activity",
+			})
+		default:
+			exists, err := api.store.ActivityExists(r.Context(), pgstore.ActivityExistsParams{
+				TripID:   trip.ID,
+				Title:    event.Summary,
+				OccursAt: pgtype.Timestamp{Valid: true, Time: occursAt},
+			})
+			if err != nil {
+				api.log(r.Context()).Error("failed to check duplicated activity", zap.Error(err), zap.String("trip_id", tripID))
+				return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "failed to import activities, try again"})
+			}
+			if exists {
+				rejected = append(rejected, spec.ImportActivitiesResponseRejectedArray{
+					Title:    event.Summary,
+					OccursAt: &occursAt,
+					Reason:   "duplicated activity",
+				})
+				continue
+			}
+
+			seen[activityKey(event.Summary, occursAt)] = true
+			params = append(params, pgstore.CreateActivityParams{
+				TripID:   trip.ID,
+				Title:    event.Summary,
+				OccursAt: pgtype.Timestamp{Valid: true, Time: occursAt},
+			})
+		}
+	}
+
+	activityIDs, err := api.store.CreateActivities(r.Context(), api.pool, params)
+	if err != nil {
+		api.log(r.Context()).Error("failed to import activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesImportIcsJSON400Response(spec.Error{Message: "failed to import activities, try again"})
+	}
+
+	ids := make([]string, len(activityIDs))
+	for i, id := range activityIDs {
+		ids[i] = id.String()
+		api.webhooks.Dispatch(tripUUID, webhook.EventActivityCreated, webhook.ActivityCreated{
+			ActivityID: ids[i],
+			Title:      params[i].Title,
+			OccursAt:   params[i].OccursAt.Time,
+		})
+	}
+
+	return spec.PostTripsTripIDActivitiesImportIcsJSON201Response(spec.ImportActivitiesResponse{
+		ActivityIds: ids,
+		Rejected:    rejected,
+	})
+}
+
+// PostTripsTripIDActivitiesBatch Create many trip activities at once.
+// (POST /trips/{tripId}/activities/batch)
+func (api API) PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	var body spec.PostTripsTripIDActivitiesBatchJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+	if len(body) == 0 {
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "no activities to create"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDActivitiesBatchJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	// A single invalid activity rejects the whole batch, naming its fields
+	// after its index so the caller knows which one to fix.
+	params := make([]pgstore.CreateActivityParams, len(body))
+	seen := make(map[string]bool, len(body))
+	for i, activity := range body {
+		if err := api.validator.Struct(activity); err != nil {
+			res := validationError(err)
+			for j := range res.Fields {
+				res.Fields[j].Field = "[" + strconv.Itoa(i) + "]." + res.Fields[j].Field
+			}
+			return spec.PostTripsTripIDActivitiesBatchJSON400Response(res)
+		}
+
+		// The trip boundaries themselves are part of the trip.
+		if activity.OccursAt.Before(trip.StartsAt.Time) || activity.OccursAt.After(trip.EndsAt.Time) {
+			return spec.PostTripsTripIDActivitiesBatchJSON422Response(spec.Error{
+				Message: "invalid input",
+				Fields: []spec.FieldError{{
+					Field:   "[" + strconv.Itoa(i) + "].occurs_at",
+					Message: "activity must occur during the trip",
+				}},
+			})
+		}
+
+		params[i] = pgstore.CreateActivityParams{
+			TripID:   tripUUID,
+			Title:    activity.Title,
+			OccursAt: pgtype.Timestamp{Valid: true, Time: activity.OccursAt},
+		}
+
+		duplicated := seen[activityKey(activity.Title, activity.OccursAt)]
+		if !duplicated {
+			duplicated, err = api.store.ActivityExists(r.Context(), pgstore.ActivityExistsParams{
+				TripID:   tripUUID,
+				Title:    params[i].Title,
+				OccursAt: params[i].OccursAt,
+			})
+			if err != nil {
+				api.log(r.Context()).Error("failed to check duplicated activity", zap.Error(err), zap.String("trip_id", tripID))
+				return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "failed to create trip activities, try again"})
+			}
+		}
+		if duplicated {
+			return spec.PostTripsTripIDActivitiesBatchJSON409Response(spec.Error{
+				Message: "invalid input",
+				Fields: []spec.FieldError{{
+					Field:   "[" + strconv.Itoa(i) + "].title",
+					Message: "já existe uma atividade com esse título nesse horário",
+				}},
+			})
+		}
+		seen[activityKey(activity.Title, activity.OccursAt)] = true
+	}
+
+	activityIDs, err := api.store.CreateActivities(r.Context(), api.pool, params)
+	if err != nil {
+		api.log(r.Context()).Error("failed to create activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesBatchJSON400Response(spec.Error{Message: "failed to create trip activities, try again"})
+	}
+
+	ids := make([]string, len(activityIDs))
+	for i, id := range activityIDs {
+		ids[i] = id.String()
+		api.webhooks.Dispatch(tripUUID, webhook.EventActivityCreated, webhook.ActivityCreated{
+			ActivityID: ids[i],
+			Title:      params[i].Title,
+			OccursAt:   params[i].OccursAt.Time,
+		})
+	}
+
+	return spec.PostTripsTripIDActivitiesBatchJSON201Response(spec.CreateActivitiesBatchResponse{ActivityIds: ids})
+}
+
+// GetTripsTripIDConfirm Confirm a trip and send e-mail invitations.
+// (GET /trips/{tripId}/confirm)
+func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	err = api.store.ConfirmTripOnce(r.Context(), api.pool, tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		if errors.Is(err, pgstore.ErrTripAlreadyConfirmed) {
+			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "trip already confirmed"})
+		}
+		api.log(r.Context()).Error("failed to confirm trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "failed to confirm trip, try again"})
+	}
+
+	api.webhooks.Dispatch(tripUUID, webhook.EventTripConfirmed, nil)
+
+	go func() {
+		if err := api.mailer.SendInviteEmailsToParticipants(tripUUID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on GetTripsTripIDConfirm",
+				zap.Error(err),
+				zap.String("trip_id", tripUUID.String()),
+			)
+		}
+	}()
+
+	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+}
+
+// PostTripsTripIDInvites Invite someone to the trip.
+// (POST /trips/{tripId}/invites)
+func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.InviteParticipantRequest
+
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(validationError(err))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantID, err := api.store.InviteParticipant(r.Context(), pgstore.InviteParticipantToTripParams{
+		TripID:          trip.ID,
+		Email:           string(body.Email),
+		InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: api.inviteExpiresAt()},
+		Name:            participantName(body.Name),
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrParticipantAlreadyInvited) {
+			return spec.PostTripsTripIDInvitesJSON409Response(spec.Error{Message: "participante já convidado"})
+		}
+		api.log(r.Context()).Error("failed to invite participant", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "failed to invite user to trip, try again"})
+	}
+
+	go func() {
+		if err := api.mailer.SendInviteEmailToParticipant(participantID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on PostTripsTripIDInvites",
+				zap.Error(err),
+				zap.String("participant_id", participantID.String()),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDInvitesJSON201Response(nil)
+}
+
+// GetTripsTripIDLinks Get a trip links.
+// (GET /trips/{tripId}/links)
+func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDLinksParams) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	linksInDB, err := api.store.GetTripLinks(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "nenhum link encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to get links"})
+	}
+
+	if !linkSorts.apply(linksInDB, params.Sort, api.linksSort) {
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid sort"})
+	}
+
+	var links []spec.GetLinksResponseArray
+	for _, link := range linksInDB {
+		links = append(links, spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		})
+	}
+
+	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
+		Links: links,
+	})
+}
+
+// GetTripsTripIDLinksByDomain Get a trip links grouped by domain.
+// (GET /trips/{tripId}/links/by-domain)
+func (api API) GetTripsTripIDLinksByDomain(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDLinksByDomainJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDLinksByDomainJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLinksByDomainJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	linksInDB, err := api.store.GetTripLinks(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLinksByDomainJSON400Response(spec.Error{Message: "failed to get links"})
+	}
+
+	sort.SliceStable(linksInDB, func(i, j int) bool { return linksInDB[i].Title < linksInDB[j].Title })
+
+	domains := make([]spec.LinkDomain, 0)
+	domainIndex := make(map[string]int)
+	for _, link := range linksInDB {
+		domain := linkDomain(link.Url)
+		i, ok := domainIndex[domain]
+		if !ok {
+			i = len(domains)
+			domainIndex[domain] = i
+			domains = append(domains, spec.LinkDomain{Domain: domain})
+		}
+		domains[i].Links = append(domains[i].Links, spec.GetLinksResponseArray{
+			ID:    link.ID.String(),
+			Title: link.Title,
+			URL:   link.Url,
+		})
+	}
+	sort.Slice(domains, func(i, j int) bool { return domains[i].Domain < domains[j].Domain })
+
+	return spec.GetTripsTripIDLinksByDomainJSON200Response(spec.GetLinksByDomainResponse{Domains: domains})
+}
+
+// linkDomain is the host rawURL points to, lowercased and without its port, or
+// empty when rawURL does not parse or has no host.
+func linkDomain(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Hostname())
+}
+
+// HeadTripsTripIDLinks Count a trip links.
+// (HEAD /trips/{tripId}/links)
+func (api API) HeadTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.HeadTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.HeadTripsTripIDLinksJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	count, err := api.store.CountTripLinks(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to count links"})
+	}
+
+	w.Header().Set(totalCountHeader, strconv.FormatInt(count, 10))
+	return &spec.Response{Code: http.StatusOK}
+}
+
+// PostTripsTripIDLinks Create a trip link.
+// (POST /trips/{tripId}/links)
+func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.CreateLinkRequest
+
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(validationError(err))
+	}
+
+	if !isHTTPURL(body.URL) {
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid URL"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	full, err := api.linksFull(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to create link"})
+	}
+	if full {
+		return spec.PostTripsTripIDLinksJSON409Response(api.linksFullError())
+	}
+
+	linkID, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
+		TripID: tripUUID,
+		Title:  body.Title,
+		Url:    body.URL,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create link", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to create link"})
+	}
+
+	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
+		LinkID: linkID.String(),
+	})
+}
+
+// isHTTPURL reports whether s is an absolute http or https URL. Links are
+// rendered by the frontend, so other schemes such as javascript: are refused.
+func isHTTPURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// linksFull reports whether the trip already holds api.maxLinks links, so no
+// other link may be added to it.
+func (api API) linksFull(ctx context.Context, tripID uuid.UUID) (bool, error) {
+	count, err := api.store.CountTripLinks(ctx, tripID)
+	if err != nil {
+		return false, err
+	}
+	return count >= api.maxLinks, nil
+}
+
+// linksFullError is the 409 body answered when linksFull refuses a link.
+func (api API) linksFullError() spec.Error {
+	return spec.Error{Message: "a viagem já atingiu o limite de " + strconv.FormatInt(api.maxLinks, 10) + " links"}
+}
+
+// GetTripsTripIDParticipants Get a trip participants.
+// (GET /trips/{tripId}/participants)
+func (api API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParticipantsParams) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantsInDB, err := api.store.GetParticipants(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "nenhum participante encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "failed to get participants"})
+	}
+
+	if !participantSorts.apply(participantsInDB, params.Sort, api.participantsSort) {
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid sort"})
+	}
+
+	var participants []spec.GetTripParticipantsResponseArray
+	for _, participant := range participantsInDB {
+		participants = append(participants, participantResponse(participant))
+	}
+
+	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
+		Participants: participants,
+	})
+}
+
+// participantResponse is how a participant is listed in responses.
+func participantResponse(participant pgstore.Participant) spec.GetTripParticipantsResponseArray {
+	output := spec.GetTripParticipantsResponseArray{
+		Email:       types.Email(participant.Email),
+		ID:          participant.ID.String(),
+		IsConfirmed: participant.IsConfirmed,
+		IsOrganizer: participant.IsOrganizer,
+	}
+	if participant.Name.Valid {
+		output.Name = &participant.Name.String
+	}
+	return output
+}
+
+// participantName is the name to store for a participant given the optional
+// name sent in a request, left null when missing or blank.
+func participantName(name *string) pgtype.Text {
+	if name == nil || strings.TrimSpace(*name) == "" {
+		return pgtype.Text{}
+	}
+	return pgtype.Text{Valid: true, String: strings.TrimSpace(*name)}
+}
+
+// GetTripsTripIDParticipantsGrouped Get a trip participants grouped by their group.
+// (GET /trips/{tripId}/participants/grouped)
+func (api API) GetTripsTripIDParticipantsGrouped(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsGroupedJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDParticipantsGroupedJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsGroupedJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantsInDB, err := api.store.GetParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsGroupedJSON400Response(spec.Error{Message: "failed to get participants"})
+	}
+
+	groupsByName := make(map[string]*spec.GetTripParticipantGroupsResponseArray)
+	var ungrouped *spec.GetTripParticipantGroupsResponseArray
+	for _, participant := range participantsInDB {
+		group := ungrouped
+		if participant.GroupName.Valid {
+			group = groupsByName[participant.GroupName.String]
+		}
+		if group == nil {
+			group = &spec.GetTripParticipantGroupsResponseArray{}
+			if participant.GroupName.Valid {
+				name := participant.GroupName.String
+				group.GroupName = &name
+				groupsByName[name] = group
+			} else {
+				ungrouped = group
+			}
+		}
+		group.Participants = append(group.Participants, participantResponse(participant))
+	}
+
+	groups := make([]spec.GetTripParticipantGroupsResponseArray, 0, len(groupsByName)+1)
+	for _, group := range groupsByName {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return *groups[i].GroupName < *groups[j].GroupName })
+	if ungrouped != nil {
+		groups = append(groups, *ungrouped)
+	}
+
+	return spec.GetTripsTripIDParticipantsGroupedJSON200Response(spec.GetTripParticipantGroupsResponse{
+		Groups: groups,
+	})
+}
+
+// PostTripsTripIDParticipantsImportCsv Invite participants from a .csv file.
+// (POST /trips/{tripId}/participants/import-csv)
+func (api API) PostTripsTripIDParticipantsImportCsv(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxCSVUploadSize)
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "invalid file: " + err.Error()})
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "invalid csv: " + err.Error()})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantsInDB, err := api.store.GetParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "failed to get participants"})
+	}
+
+	invited := make(map[string]bool, len(participantsInDB))
+	for _, participant := range participantsInDB {
+		invited[strings.ToLower(participant.Email)] = true
+	}
+
+	var (
+		rows    = make([]spec.ImportParticipantsResponseArray, 0, len(records))
+		params  []pgstore.InviteParticipantToTripParams
+		created []int
+	)
+	for i, record := range records {
+		email := strings.TrimSpace(record[0])
+		if i == 0 && strings.EqualFold(email, "email") {
+			continue
+		}
+
+		var name string
+		if len(record) > 1 {
+			name = strings.TrimSpace(record[1])
+		}
+
+		row := spec.ImportParticipantsResponseArray{Row: i + 1, Email: email}
+		switch {
+		case len(record) > 2:
+			row.Status, row.Reason = spec.ImportParticipantsResponseArrayStatusError, importReason("expected email and name columns only")
+		case api.validator.Var(email, "required,email") != nil:
+			row.Status, row.Reason = spec.ImportParticipantsResponseArrayStatusError, importReason("invalid email")
+		case len(name) > 255:
+			row.Status, row.Reason = spec.ImportParticipantsResponseArrayStatusError, importReason("name must have at most 255 characters")
+		case invited[strings.ToLower(email)]:
+			row.Status, row.Reason = spec.ImportParticipantsResponseArrayStatusSkipped, importReason("already invited")
+		default:
+			invited[strings.ToLower(email)] = true
+			param := pgstore.InviteParticipantToTripParams{
+				TripID:          tripUUID,
+				Email:           email,
+				InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: api.inviteExpiresAt()},
+			}
+			if name != "" {
+				param.Name = pgtype.Text{Valid: true, String: name}
+			}
+			params = append(params, param)
+			created = append(created, len(rows))
+		}
+		rows = append(rows, row)
+	}
+
+	participantIDs, err := api.store.InviteParticipants(r.Context(), api.pool, params)
+	if errors.Is(err, pgstore.ErrParticipantAlreadyInvited) {
+		// Someone was invited since the participants were read. Skip them and
+		// import the rest.
+		params, created, err = api.skipInvited(r.Context(), tripUUID, rows, params, created)
+		if err == nil {
+			participantIDs, err = api.store.InviteParticipants(r.Context(), api.pool, params)
+		}
+	}
+	if err != nil {
+		if errors.Is(err, pgstore.ErrParticipantAlreadyInvited) {
+			return spec.PostTripsTripIDParticipantsImportCsvJSON409Response(spec.Error{Message: "participantes foram convidados durante a importação, tente novamente"})
+		}
+		api.log(r.Context()).Error("failed to import participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsImportCsvJSON400Response(spec.Error{Message: "failed to import participants, try again"})
+	}
+
+	for i, rowIndex := range created {
+		participantID := participantIDs[i].String()
+		rows[rowIndex].Status = spec.ImportParticipantsResponseArrayStatusCreated
+		rows[rowIndex].ParticipantID = &participantID
+	}
+
+	return spec.PostTripsTripIDParticipantsImportCsvJSON201Response(spec.ImportParticipantsResponse{
+		Rows: rows,
+	})
+}
+
+// skipInvited marks as skipped the rows to be created whose email is already
+// invited to the trip, returning the params and rows still to be created.
+func (api API) skipInvited(ctx context.Context, tripID uuid.UUID, rows []spec.ImportParticipantsResponseArray, params []pgstore.InviteParticipantToTripParams, created []int) ([]pgstore.InviteParticipantToTripParams, []int, error) {
+	participants, err := api.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invited := make(map[string]bool, len(participants))
+	for _, participant := range participants {
+		invited[strings.ToLower(participant.Email)] = true
+	}
+
+	var (
+		pendingParams []pgstore.InviteParticipantToTripParams
+		pendingRows   []int
+	)
+	for i, param := range params {
+		if invited[strings.ToLower(param.Email)] {
+			rows[created[i]].Status = spec.ImportParticipantsResponseArrayStatusSkipped
+			rows[created[i]].Reason = importReason("already invited")
+			continue
+		}
+		pendingParams = append(pendingParams, param)
+		pendingRows = append(pendingRows, created[i])
+	}
+	return pendingParams, pendingRows, nil
+}
+
+// importReason is the reason reported for a row that was not imported.
+func importReason(reason string) *string {
+	return &reason
+}
+
+// HeadTripsTripIDParticipants Count a trip participants.
+// (HEAD /trips/{tripId}/participants)
+func (api API) HeadTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.HeadTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.HeadTripsTripIDParticipantsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	count, err := api.store.CountTripParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to count participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.HeadTripsTripIDParticipantsJSON400Response(spec.Error{Message: "failed to count participants"})
+	}
+
+	w.Header().Set(totalCountHeader, strconv.FormatInt(count, 10))
+	return &spec.Response{Code: http.StatusOK}
+}
+
+// PostTripsTripIDSnapshots Snapshot the current state of a trip.
+// (POST /trips/{tripId}/snapshots)
+func (api API) PostTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDSnapshotsJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar snapshots"})
+	}
+
+	snapshotID, err := api.store.CreateTripSnapshot(r.Context(), api.pool, tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to create snapshot", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "failed to create snapshot, try again"})
+	}
+
+	return spec.PostTripsTripIDSnapshotsJSON201Response(spec.CreateTripSnapshotResponse{
+		SnapshotID: snapshotID.String(),
+	})
+}
+
+// GetTripsTripIDSnapshots Get a trip snapshots.
+// (GET /trips/{tripId}/snapshots)
+func (api API) GetTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	snapshotsInDB, err := api.store.GetTripSnapshots(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get snapshots", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSnapshotsJSON400Response(spec.Error{Message: "failed to get snapshots"})
+	}
+
+	snapshots := make([]spec.GetTripSnapshotsResponseArray, 0, len(snapshotsInDB))
+	for _, snapshot := range snapshotsInDB {
+		snapshots = append(snapshots, spec.GetTripSnapshotsResponseArray{
+			ID:        snapshot.ID.String(),
+			CreatedAt: snapshot.CreatedAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDSnapshotsJSON200Response(spec.GetTripSnapshotsResponse{
+		Snapshots: snapshots,
+	})
+}
+
+// PostTripsTripIDSnapshotsSnapshotIDRestore Restore a trip to the state of a snapshot.
+// (POST /trips/{tripId}/snapshots/{snapshotId}/restore)
+func (api API) PostTripsTripIDSnapshotsSnapshotIDRestore(w http.ResponseWriter, r *http.Request, tripID string, snapshotID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	snapshotUUID, err := uuid.Parse(snapshotID)
+	if err != nil {
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "invalid snapshotID"})
+	}
+
+	snapshot, err := api.store.GetTripSnapshot(r.Context(), snapshotUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "snapshot não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get snapshot", zap.Error(err), zap.String("snapshot_id", snapshotID))
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "invalid snapshotID"})
+	}
+
+	if snapshot.TripID != tripUUID {
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "snapshot não encontrado"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar snapshots"})
+	}
+
+	if err := api.store.RestoreTripSnapshot(r.Context(), api.pool, snapshot); err != nil {
+		api.log(r.Context()).Error("failed to restore snapshot", zap.Error(err), zap.String("snapshot_id", snapshotID))
+		return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(spec.Error{Message: "failed to restore snapshot, try again"})
+	}
+
+	return spec.PostTripsTripIDSnapshotsSnapshotIDRestoreJSON204Response(nil)
+}
+
+// GetTripsTripIDConflicts Get the activities that happen while a participant is unavailable.
+// (GET /trips/{tripId}/conflicts)
+func (api API) GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	conflictsInDB, err := api.store.GetTripConflicts(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get conflicts", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{Message: "failed to get conflicts"})
+	}
+
+	conflicts := make([]spec.GetTripConflictsResponseArray, 0, len(conflictsInDB))
+	for _, conflict := range conflictsInDB {
+		conflicts = append(conflicts, spec.GetTripConflictsResponseArray{
+			ActivityID:    conflict.ActivityID.String(),
+			Email:         types.Email(conflict.Email),
+			OccursAt:      conflict.OccursAt.Time,
+			ParticipantID: conflict.ParticipantID.String(),
+			Title:         conflict.Title,
+		})
+	}
+
+	return spec.GetTripsTripIDConflictsJSON200Response(spec.GetTripConflictsResponse{
+		Conflicts: conflicts,
+	})
+}
+
+// GetTripsTripIDPackingItems Get a trip packing list.
+// (GET /trips/{tripId}/packing-items)
+func (api API) GetTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	itemsInDB, err := api.store.GetTripPackingItems(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get packing items", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "failed to get packing items"})
+	}
+
+	items := make([]spec.GetTripPackingItemsResponseArray, 0, len(itemsInDB))
+	for _, item := range itemsInDB {
+		var assignedTo *string
+		if item.AssignedTo.Valid {
+			id := uuid.UUID(item.AssignedTo.Bytes).String()
+			assignedTo = &id
+		}
+		items = append(items, spec.GetTripPackingItemsResponseArray{
+			AssignedTo: assignedTo,
+			ID:         item.ID.String(),
+			IsPacked:   item.IsPacked,
+			Text:       item.Text,
+		})
+	}
+
+	return spec.GetTripsTripIDPackingItemsJSON200Response(spec.GetTripPackingItemsResponse{
+		PackingItems: items,
+	})
+}
+
+// PostTripsTripIDPackingItems Add an item to a trip packing list.
+// (POST /trips/{tripId}/packing-items)
+func (api API) PostTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	var body spec.CreatePackingItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDPackingItemsJSON400Response(validationError(err))
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	assignedTo, err := api.tripParticipantID(r.Context(), tripUUID, body.AssignedTo)
+	if err != nil {
+		if errors.Is(err, errNotTripParticipant) {
+			return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	itemID, err := api.store.CreatePackingItem(r.Context(), pgstore.CreatePackingItemParams{
+		TripID:     tripUUID,
+		Text:       body.Text,
+		AssignedTo: assignedTo,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create packing item", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDPackingItemsJSON400Response(spec.Error{Message: "failed to create packing item, try again"})
+	}
+
+	return spec.PostTripsTripIDPackingItemsJSON201Response(spec.CreatePackingItemResponse{
+		PackingItemID: itemID.String(),
+	})
+}
+
+// PutTripsTripIDPackingItemsItemID Update a packing item.
+// (PUT /trips/{tripId}/packing-items/{itemId})
+func (api API) PutTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "invalid itemID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	var body spec.UpdatePackingItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(validationError(err))
+	}
+
+	assignedTo, err := api.tripParticipantID(r.Context(), tripUUID, body.AssignedTo)
+	if err != nil {
+		if errors.Is(err, errNotTripParticipant) {
+			return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	updated, err := api.store.UpdatePackingItem(r.Context(), pgstore.UpdatePackingItemParams{
+		Text:       body.Text,
+		AssignedTo: assignedTo,
+		ID:         itemUUID,
+		TripID:     tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to update packing item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "failed to update packing item, try again"})
+	}
+	if updated == 0 {
+		return spec.PutTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "item não encontrado"})
+	}
+
+	return spec.PutTripsTripIDPackingItemsItemIDJSON204Response(nil)
+}
+
+// PatchTripsTripIDPackingItemsItemIDToggle Toggle whether a packing item is packed.
+// (PATCH /trips/{tripId}/packing-items/{itemId}/toggle)
+func (api API) PatchTripsTripIDPackingItemsItemIDToggle(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(spec.Error{Message: "invalid itemID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	toggled, err := api.store.TogglePackingItem(r.Context(), pgstore.TogglePackingItemParams{
+		ID:     itemUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to toggle packing item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(spec.Error{Message: "failed to toggle packing item, try again"})
+	}
+	if toggled == 0 {
+		return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(spec.Error{Message: "item não encontrado"})
+	}
+
+	return spec.PatchTripsTripIDPackingItemsItemIDToggleJSON204Response(nil)
+}
+
+// DeleteTripsTripIDPackingItemsItemID Remove a packing item.
+// (DELETE /trips/{tripId}/packing-items/{itemId})
+func (api API) DeleteTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "invalid itemID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	deleted, err := api.store.DeletePackingItem(r.Context(), pgstore.DeletePackingItemParams{
+		ID:     itemUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete packing item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "failed to delete packing item, try again"})
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDPackingItemsItemIDJSON400Response(spec.Error{Message: "item não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDPackingItemsItemIDJSON204Response(nil)
+}
+
+// GetAdminStatsTripsPerDay Count the trips created per day.
+// (GET /admin/stats/trips-per-day)
+func (api API) GetAdminStatsTripsPerDay(w http.ResponseWriter, r *http.Request, params spec.GetAdminStatsTripsPerDayParams) *spec.Response {
+	if !api.isAdmin(r) {
+		return spec.GetAdminStatsTripsPerDayJSON403Response(spec.Error{Message: "acesso restrito a administradores"})
+	}
+
+	// The generated binding leaves a zero date, rather than failing, when
+	// from or to is not in the query.
+	if params.From.IsZero() || params.To.IsZero() {
+		return spec.GetAdminStatsTripsPerDayJSON400Response(spec.Error{Message: "invalid input: from and to are required"})
+	}
+	if params.To.Before(params.From.Time) {
+		return spec.GetAdminStatsTripsPerDayJSON400Response(spec.Error{Message: "invalid input: to is before from"})
+	}
+
+	days, err := api.store.GetTripsCreatedPerDay(r.Context(), pgstore.GetTripsCreatedPerDayParams{
+		CreatedFrom: pgtype.Timestamp{Valid: true, Time: params.From.Time},
+		CreatedTo:   pgtype.Timestamp{Valid: true, Time: params.To.AddDate(0, 0, 1)},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to count trips per day", zap.Error(err))
+		return spec.GetAdminStatsTripsPerDayJSON400Response(spec.Error{Message: "failed to get stats, try again"})
+	}
+
+	output := spec.GetTripsPerDayResponse{Days: make([]spec.GetTripsPerDayResponseArray, 0, len(days))}
+	for _, day := range days {
+		output.Days = append(output.Days, spec.GetTripsPerDayResponseArray{
+			Day:   types.Date{Time: day.Day.Time},
+			Trips: int(day.Trips),
+		})
+	}
+
+	return spec.GetAdminStatsTripsPerDayJSON200Response(output)
+}
+
+// GetActivitiesOnDate List an owner's activities on a date across all their trips.
+// (GET /activities/on/{date})
+func (api API) GetActivitiesOnDate(w http.ResponseWriter, r *http.Request, date string, params spec.GetActivitiesOnDateParams) *spec.Response {
+	ownerEmail := string(params.OwnerEmail)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.GetActivitiesOnDateJSON400Response(spec.Error{Message: "invalid owner_email"})
+	}
+
+	// Bound as a plain string for the same reason as in GetTripsTripIDDaysDate.
+	day, err := time.Parse(types.DateFormat, date)
+	if err != nil {
+		return spec.GetActivitiesOnDateJSON400Response(spec.Error{Message: "invalid date"})
+	}
+
+	activities, err := api.store.GetOwnerActivitiesBetween(r.Context(), pgstore.GetOwnerActivitiesBetweenParams{
+		OwnerEmail: ownerEmail,
+		StartsAt:   pgtype.Timestamp{Valid: true, Time: day},
+		EndsAt:     pgtype.Timestamp{Valid: true, Time: day.AddDate(0, 0, 1)},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to get owner activities", zap.Error(err), zap.String("date", date))
+		return spec.GetActivitiesOnDateJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	output := spec.GetActivitiesOnDateResponse{
+		Activities: make([]spec.GetActivitiesOnDateResponseArray, 0, len(activities)),
+	}
+	for _, activity := range activities {
+		output.Activities = append(output.Activities, spec.GetActivitiesOnDateResponseArray{
+			ID:          activity.ID.String(),
+			TripID:      activity.TripID.String(),
+			Destination: activity.Destination,
+			Title:       activity.Title,
+			OccursAt:    activity.OccursAt.Time,
+		})
+	}
+
+	return spec.GetActivitiesOnDateJSON200Response(output)
+}
+
+// GetTripsTripIDParticipantsNotEmailed Get the trip participants whose invite was never emailed.
+// (GET /trips/{tripId}/participants/not-emailed)
+func (api API) GetTripsTripIDParticipantsNotEmailed(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsNotEmailedJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDParticipantsNotEmailedJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsNotEmailedJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantsInDB, err := api.store.GetTripParticipantsNotEmailed(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants not emailed", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsNotEmailedJSON400Response(spec.Error{Message: "failed to get participants"})
+	}
+
+	output := spec.GetTripParticipantsResponse{
+		Participants: make([]spec.GetTripParticipantsResponseArray, 0, len(participantsInDB)),
+	}
+	for _, participant := range participantsInDB {
+		output.Participants = append(output.Participants, participantResponse(participant))
+	}
+
+	return spec.GetTripsTripIDParticipantsNotEmailedJSON200Response(output)
+}
+
+// PostTripsTripIDActivitiesActivityIDAttachments Attach a file to a trip activity.
+// (POST /trips/{tripId}/activities/{activityId}/attachments)
+func (api API) PostTripsTripIDActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "invalid activityID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), activityUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "failed to get activity"})
+	}
+	if err != nil || activity.TripID != tripUUID {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentUploadSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "invalid file: " + err.Error()})
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "invalid file: " + err.Error()})
+	}
+	if len(content) == 0 {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "arquivo vazio"})
+	}
+
+	// The declared content type is not trusted; it is sniffed from the content.
+	contentType := http.DetectContentType(content)
+	if !attachmentContentTypes[contentType] {
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "tipo de arquivo não permitido: " + contentType})
+	}
+
+	attachmentID, err := api.store.CreateActivityAttachment(r.Context(), pgstore.CreateActivityAttachmentParams{
+		ActivityID:  activityUUID,
+		FileName:    filepath.Base(header.Filename),
+		ContentType: contentType,
+		Size:        int32(len(content)),
+		Content:     content,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create activity attachment", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(spec.Error{Message: "failed to save attachment, try again"})
+	}
+
+	return spec.PostTripsTripIDActivitiesActivityIDAttachmentsJSON201Response(spec.CreateActivityAttachmentResponse{
+		AttachmentID: attachmentID.String(),
+	})
+}
+
+// GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID Download a trip activity attachment.
+// (GET /trips/{tripId}/activities/{activityId}/attachments/{attachmentId})
+func (api API) GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, attachmentID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(spec.Error{Message: "invalid activityID"})
+	}
+
+	attachmentUUID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(spec.Error{Message: "invalid attachmentID"})
+	}
+
+	attachment, err := api.store.GetActivityAttachment(r.Context(), pgstore.GetActivityAttachmentParams{
+		ID:         attachmentUUID,
+		ActivityID: activityUUID,
+		TripID:     tripUUID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(spec.Error{Message: "anexo não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get activity attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+		return spec.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(spec.Error{Message: "failed to get attachment"})
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(attachment.Content)))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(attachment.Content)
+	return nil
+}
+
+// GetOwnersEmailDestinations List the distinct destinations of an owner's trips.
+// (GET /owners/{email}/destinations)
+func (api API) GetOwnersEmailDestinations(w http.ResponseWriter, r *http.Request, email types.Email) *spec.Response {
+	ownerEmail := string(email)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.GetOwnersEmailDestinationsJSON400Response(spec.Error{Message: "invalid email"})
+	}
+
+	destinations, err := api.store.GetOwnerDestinations(r.Context(), ownerEmail)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get owner destinations", zap.Error(err))
+		return spec.GetOwnersEmailDestinationsJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if destinations == nil {
+		destinations = make([]string, 0)
+	}
+
+	return spec.GetOwnersEmailDestinationsJSON200Response(spec.GetOwnerDestinationsResponse{Destinations: destinations})
+}
+
+// GetOwnersEmailConfirmationRate Get the share of invited participants who confirmed across an owner's trips.
+// (GET /owners/{email}/confirmation-rate)
+func (api API) GetOwnersEmailConfirmationRate(w http.ResponseWriter, r *http.Request, email types.Email) *spec.Response {
+	ownerEmail := string(email)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.GetOwnersEmailConfirmationRateJSON400Response(spec.Error{Message: "invalid email"})
+	}
+
+	counts, err := api.store.GetOwnerConfirmationCounts(r.Context(), ownerEmail)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get owner confirmation counts", zap.Error(err))
+		return spec.GetOwnersEmailConfirmationRateJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	output := spec.GetOwnerConfirmationRateResponse{
+		Invited:   int(counts.Invited),
+		Confirmed: int(counts.Confirmed),
+	}
+	if counts.Invited > 0 {
+		output.Rate = float32(counts.Confirmed) / float32(counts.Invited)
+	}
+
+	return spec.GetOwnersEmailConfirmationRateJSON200Response(output)
+}
+
+// webhookEvents lists the distinct events of a subscription request.
+func webhookEvents(events []spec.WebhookSubscriptionRequestEvents) []string {
+	values := make([]string, 0, len(events))
+	seen := make(map[string]bool, len(events))
+	for _, event := range events {
+		value := event.ToValue()
+		if !seen[value] {
+			seen[value] = true
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// PostTripsTripIDWebhooks Subscribe a URL to trip events.
+// (POST /trips/{tripId}/webhooks)
+func (api API) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	var body spec.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(validationError(err))
+	}
+	if err := webhook.CheckURL(body.URL); err != nil {
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "invalid URL"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDWebhooksJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar webhooks"})
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		api.log(r.Context()).Error("failed to generate webhook secret", zap.Error(err))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	webhookID, err := api.store.CreateWebhookSubscription(r.Context(), pgstore.CreateWebhookSubscriptionParams{
+		TripID: tripUUID,
+		Url:    body.URL,
+		Events: webhookEvents(body.Events),
+		Secret: secret,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to create webhook subscription", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDWebhooksJSON400Response(spec.Error{Message: "failed to create webhook, try again"})
+	}
+
+	return spec.PostTripsTripIDWebhooksJSON201Response(spec.CreateWebhookSubscriptionResponse{
+		WebhookID: webhookID.String(),
+		Secret:    secret,
+	})
+}
+
+// GetTripsTripIDWebhooks List the trip webhook subscriptions.
+// (GET /trips/{tripId}/webhooks)
+func (api API) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.GetTripsTripIDWebhooksJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar webhooks"})
+	}
+
+	subscriptions, err := api.store.GetTripWebhookSubscriptions(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get webhook subscriptions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWebhooksJSON400Response(spec.Error{Message: "failed to get webhooks"})
+	}
+
+	output := spec.GetTripWebhooksResponse{
+		Webhooks: make([]spec.GetTripWebhooksResponseArray, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		output.Webhooks = append(output.Webhooks, spec.GetTripWebhooksResponseArray{
+			ID:        subscription.ID.String(),
+			URL:       subscription.Url,
+			Events:    subscription.Events,
+			CreatedAt: subscription.CreatedAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDWebhooksJSON200Response(output)
+}
+
+// PutTripsTripIDWebhooksWebhookID Update a trip webhook subscription.
+// (PUT /trips/{tripId}/webhooks/{webhookId})
+func (api API) PutTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	webhookUUID, err := uuid.Parse(webhookID)
+	if err != nil {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid webhookID"})
+	}
+
+	var body spec.WebhookSubscriptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(validationError(err))
+	}
+	if err := webhook.CheckURL(body.URL); err != nil {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid URL"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar webhooks"})
+	}
+
+	updated, err := api.store.UpdateWebhookSubscription(r.Context(), pgstore.UpdateWebhookSubscriptionParams{
+		Url:    body.URL,
+		Events: webhookEvents(body.Events),
+		ID:     webhookUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to update webhook subscription", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "failed to update webhook, try again"})
+	}
+	if updated == 0 {
+		return spec.PutTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "webhook não encontrado"})
+	}
+
+	return spec.PutTripsTripIDWebhooksWebhookIDJSON204Response(nil)
+}
+
+// DeleteTripsTripIDWebhooksWebhookID Remove a trip webhook subscription.
+// (DELETE /trips/{tripId}/webhooks/{webhookId})
+func (api API) DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	webhookUUID, err := uuid.Parse(webhookID)
+	if err != nil {
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "invalid webhookID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem gerenciar webhooks"})
+	}
+
+	deleted, err := api.store.DeleteWebhookSubscription(r.Context(), pgstore.DeleteWebhookSubscriptionParams{
+		ID:     webhookUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete webhook subscription", zap.Error(err), zap.String("webhook_id", webhookID))
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "failed to delete webhook, try again"})
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDWebhooksWebhookIDJSON400Response(spec.Error{Message: "webhook não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDWebhooksWebhookIDJSON204Response(nil)
+}
+
+// PostAdminWebhookDeliveriesDeliveryIDReplay Attempt a failed webhook delivery again.
+// (POST /admin/webhook-deliveries/{deliveryId}/replay)
+func (api API) PostAdminWebhookDeliveriesDeliveryIDReplay(w http.ResponseWriter, r *http.Request, deliveryID string) *spec.Response {
+	if !api.isAdmin(r) {
+		return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON403Response(spec.Error{Message: "acesso restrito a administradores"})
+	}
+
+	deliveryUUID, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(spec.Error{Message: "invalid deliveryID"})
+	}
+
+	attempt, err := api.webhooks.Replay(r.Context(), deliveryUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(spec.Error{Message: "entrega não encontrada"})
+		}
+		if errors.Is(err, webhook.ErrAlreadyDelivered) {
+			return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(spec.Error{Message: "entrega já realizada"})
+		}
+		api.log(r.Context()).Error("failed to replay webhook delivery", zap.Error(err), zap.String("delivery_id", deliveryID))
+		return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	var status spec.ReplayWebhookDeliveryResponseStatus
+	if err := status.FromValue(attempt.Status); err != nil {
+		api.log(r.Context()).Error("unknown webhook delivery status", zap.Error(err), zap.String("delivery_id", deliveryID))
+		return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	output := spec.ReplayWebhookDeliveryResponse{
+		Status:   status,
+		Attempts: int(attempt.Attempts),
+	}
+	if attempt.StatusCode != 0 {
+		output.StatusCode = &attempt.StatusCode
+	}
+	if attempt.Error != "" {
+		output.Error = &attempt.Error
+	}
+
+	return spec.PostAdminWebhookDeliveriesDeliveryIDReplayJSON200Response(output)
+}
+
+// GetTripsTripIDParticipantsAnalytics Get how long each trip participant took to confirm.
+// (GET /trips/{tripId}/participants/analytics)
+func (api API) GetTripsTripIDParticipantsAnalytics(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsAnalyticsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDParticipantsAnalyticsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsAnalyticsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantsInDB, err := api.store.GetParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDParticipantsAnalyticsJSON400Response(spec.Error{Message: "failed to get participants"})
+	}
+
+	output := spec.GetTripParticipantsAnalyticsResponse{
+		Participants: make([]spec.GetTripParticipantsAnalyticsResponseArray, 0, len(participantsInDB)),
+	}
+	for _, participant := range participantsInDB {
+		item := spec.GetTripParticipantsAnalyticsResponseArray{
+			ID:          participant.ID.String(),
+			Email:       types.Email(participant.Email),
+			IsConfirmed: participant.IsConfirmed,
+			InvitedAt:   participant.CreatedAt.Time,
+		}
+		if participant.ConfirmedAt.Valid {
+			hours := float32(participant.ConfirmedAt.Time.Sub(participant.CreatedAt.Time).Hours())
+			item.ConfirmedAt = &participant.ConfirmedAt.Time
+			item.AcceptanceHours = &hours
+		}
+		output.Participants = append(output.Participants, item)
+	}
+
+	return spec.GetTripsTripIDParticipantsAnalyticsJSON200Response(output)
+}
+
+// DeleteTripsTripIDParticipantsParticipantID Remove a participant from a trip, restorable for a while.
+// (DELETE /trips/{tripId}/participants/{participantId})
+func (api API) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid participantID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem remover participantes"})
+	}
+
+	row, err := api.store.GetParticipantWithTrip(r.Context(), participantUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "participante não encontrado"})
+		}
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if row.Participant.TripID != tripUUID {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "participante não encontrado"})
+	}
+	if strings.EqualFold(row.Participant.Email, row.Trip.OwnerEmail) {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "cannot remove trip owner"})
+	}
+
+	deleted, err := api.store.DeleteParticipant(r.Context(), pgstore.DeleteParticipantParams{
+		ID:     participantUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "failed to delete participant, try again"})
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "participante não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDParticipantsParticipantIDJSON204Response(nil)
+}
+
+// PostTripsTripIDParticipantsParticipantIDRestore Restore a recently removed trip participant.
+// (POST /trips/{tripId}/participants/{participantId}/restore)
+func (api API) PostTripsTripIDParticipantsParticipantIDRestore(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(spec.Error{Message: "invalid participantID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem restaurar participantes"})
+	}
+
+	restored, err := api.store.RestoreRemovedParticipant(r.Context(), pgstore.RestoreParticipantParams{
+		ID:           participantUUID,
+		TripID:       tripUUID,
+		DeletedAfter: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(-api.restoreWindow)},
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrParticipantAlreadyInvited) {
+			return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON409Response(spec.Error{Message: "participante já convidado novamente"})
+		}
+		api.log(r.Context()).Error("failed to restore participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(spec.Error{Message: "failed to restore participant, try again"})
+	}
+	if restored == 0 {
+		return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(spec.Error{Message: "participante não encontrado ou removido há muito tempo"})
+	}
+
+	return spec.PostTripsTripIDParticipantsParticipantIDRestoreJSON204Response(nil)
+}
+
+// PostTripsTripIDRequestReconfirmation Ask every participant to confirm the trip again.
+// (POST /trips/{tripId}/request-reconfirmation)
+func (api API) PostTripsTripIDRequestReconfirmation(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDRequestReconfirmationJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDRequestReconfirmationJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDRequestReconfirmationJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem pedir nova confirmação"})
+	}
+
+	err = api.store.ResetParticipantConfirmations(r.Context(), pgstore.ResetParticipantConfirmationsParams{
+		TripID:          tripUUID,
+		InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: api.inviteExpiresAt()},
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to reset participant confirmations", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDRequestReconfirmationJSON400Response(spec.Error{Message: "failed to request reconfirmation, try again"})
+	}
+
+	go func() {
+		if err := api.mailer.ResendInviteEmailsToParticipants(tripUUID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on PostTripsTripIDRequestReconfirmation",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDRequestReconfirmationJSON204Response(nil)
+}
+
+// GetTripsTripIDGaps Get the free time between consecutive activities of each trip day.
+// (GET /trips/{tripId}/gaps)
+func (api API) GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDGapsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDGapsJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDGapsJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDGapsJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	return spec.GetTripsTripIDGapsJSON200Response(spec.GetTripGapsResponse{Days: activityGaps(activities)})
+}
+
+// activityGaps lists, for each day with activities, the free windows between an
+// activity ending and the next one starting that day. activities must be
+// ordered by occurs_at; one with no duration takes no time.
+func activityGaps(activities []pgstore.Activity) []spec.GetTripGapsResponseDay {
+	days := make([]spec.GetTripGapsResponseDay, 0)
+
+	var busyUntil time.Time
+	for _, activity := range activities {
+		startsAt := activity.OccursAt.Time
+		endsAt := startsAt.Add(time.Duration(activity.DurationMinutes.Int32) * time.Minute)
+		day := startsAt.Truncate(24 * time.Hour)
+
+		if n := len(days); n == 0 || !days[n-1].Date.Time.Equal(day) {
+			days = append(days, spec.GetTripGapsResponseDay{
+				Date: types.Date{Time: day},
+				Gaps: make([]spec.GetTripGapsResponseGap, 0),
+			})
+			busyUntil = endsAt
+			continue
+		}
+
+		last := &days[len(days)-1]
+		if startsAt.After(busyUntil) {
+			last.Gaps = append(last.Gaps, spec.GetTripGapsResponseGap{
+				StartsAt: busyUntil,
+				EndsAt:   startsAt,
+				Minutes:  int(startsAt.Sub(busyUntil).Minutes()),
+			})
+		}
+		if endsAt.After(busyUntil) {
+			busyUntil = endsAt
+		}
+	}
+
+	return days
+}
+
+// GetTripsTripIDConfirmationEmail Get the rendered trip confirmation email, for sending it elsewhere.
+// (GET /trips/{tripId}/confirmation-email)
+func (api API) GetTripsTripIDConfirmationEmail(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDConfirmationEmailJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmationEmailJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.GetTripsTripIDConfirmationEmailJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem ver o email de confirmação"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDConfirmationEmailJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmationEmailJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	content, err := message.ConfirmTrip(trip, message.ConfirmTripURL(api.baseURL, trip.ID))
+	if err != nil {
+		api.log(r.Context()).Error("failed to render confirmation email", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmationEmailJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	return spec.GetTripsTripIDConfirmationEmailJSON200Response(spec.GetTripConfirmationEmailResponse{
+		To:       types.Email(trip.OwnerEmail),
+		Subject:  content.Subject,
+		TextBody: content.Text,
+		HTMLBody: content.HTML,
+	})
+}
+
+// DeleteTripsTripID Delete a trip with its activities, links and participants.
+// (DELETE /trips/{tripId})
+func (api API) DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDJSON500Response(spec.Error{Message: "something went wrong, try again"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDJSON500Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.DeleteTripsTripIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem excluir a viagem"})
+	}
+
+	if err := api.store.DeleteTrip(r.Context(), api.pool, tripUUID); err != nil {
+		// A concurrent delete may have removed the trip since it was read.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.DeleteTripsTripIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to delete trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDJSON500Response(spec.Error{Message: "failed to delete trip, try again"})
+	}
+
+	return spec.DeleteTripsTripIDJSON204Response(nil)
+}
+
+// GetTripsTripIDActivitiesFullcalendar Get a trip activities as FullCalendar events.
+// (GET /trips/{tripId}/activities/fullcalendar)
+func (api API) GetTripsTripIDActivitiesFullcalendar(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesFullcalendarJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesFullcalendarJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesFullcalendarJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activities, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesFullcalendarJSON400Response(spec.Error{Message: "failed to get activities"})
+	}
+
+	events := make([]spec.FullCalendarEvent, len(activities))
+	for i, activity := range activities {
+		events[i] = spec.FullCalendarEvent{
+			ID:    activity.ID.String(),
+			Title: activity.Title,
+			Start: activity.OccursAt.Time,
+		}
+		if activity.DurationMinutes.Valid {
+			end := activity.OccursAt.Time.Add(time.Duration(activity.DurationMinutes.Int32) * time.Minute)
+			events[i].End = &end
+		}
+	}
+
+	return spec.GetTripsTripIDActivitiesFullcalendarJSON200Response(events)
+}
+
+// GetTripsTripIDActivitiesActivityID Get a trip activity.
+// (GET /trips/{tripId}/activities/{activityId})
+func (api API) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid activityID"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), activityUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "failed to get activity"})
+	}
+	if err != nil || activity.TripID != tripUUID {
+		return spec.GetTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	assigned, err := api.activityParticipants(r.Context(), tripUUID)
+	if err != nil {
+		api.log(r.Context()).Error("failed to get activity participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "failed to get activity"})
+	}
+
+	return spec.GetTripsTripIDActivitiesActivityIDJSON200Response(activityResponse(activity, trip, assigned[activity.ID]))
+}
+
+// PutTripsTripIDActivitiesActivityID Update a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId})
+func (api API) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid activityID"})
+	}
+
+	var body spec.UpdateActivityRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(validationError(err))
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PutTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	if body.OccursAt.Before(trip.StartsAt.Time) || body.OccursAt.After(trip.EndsAt.Time) {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON422Response(spec.Error{Message: "activity must occur during the trip"})
+	}
+
+	updated, err := api.store.UpdateActivity(r.Context(), pgstore.UpdateActivityParams{
+		Title:    body.Title,
+		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+		ID:       activityUUID,
+		TripID:   tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to update activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PutTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "failed to update activity, try again"})
+	}
+	if updated == 0 {
+		return spec.PutTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// PostTripsTripIDFinalize Confirm a trip and all of its participants at once.
+// (POST /trips/{tripId}/finalize)
+func (api API) PostTripsTripIDFinalize(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PostTripsTripIDFinalizeJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem finalizar a viagem"})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	if err := api.store.FinalizeTrip(r.Context(), api.pool, tripUUID); err != nil {
+		if errors.Is(err, pgstore.ErrTripAlreadyFinalized) {
+			return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "viagem já finalizada"})
+		}
+		api.log(r.Context()).Error("failed to finalize trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDFinalizeJSON400Response(spec.Error{Message: "failed to finalize trip, try again"})
+	}
+
+	if !trip.IsConfirmed {
+		api.webhooks.Dispatch(tripUUID, webhook.EventTripConfirmed, nil)
+	}
+
+	go func() {
+		if err := api.mailer.SendTripFinalizedEmails(tripUUID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on PostTripsTripIDFinalize",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDFinalizeJSON204Response(nil)
+}
+
+// DeleteTripsTripIDActivitiesActivityID Delete a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	activityUUID, err := uuid.Parse(activityID)
+	if err != nil {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "invalid activityID"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), activityUUID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		api.log(r.Context()).Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "failed to get activity"})
+	}
+	if err != nil || activity.TripID != tripUUID {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	deleted, err := api.store.DeleteActivity(r.Context(), pgstore.DeleteActivityParams{
+		ID:     activityUUID,
+		TripID: tripUUID,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{Message: "failed to delete activity, try again"})
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// GetTripsTripIDSnapshotsDiff Get what changed in a trip between two of its snapshots.
+// (GET /trips/{tripId}/snapshots/diff)
+func (api API) GetTripsTripIDSnapshotsDiff(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDSnapshotsDiffParams) *spec.Response {
+	tripUUID, err := uuid.Parse(tripID)
+	if err != nil {
+		return spec.GetTripsTripIDSnapshotsDiffJSON400Response(spec.Error{Message: "invalid tripID"})
+	}
+
+	fromUUID, err := uuid.Parse(params.From)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participante não encontrado"})
-		}
-		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again"})
+		return spec.GetTripsTripIDSnapshotsDiffJSON400Response(spec.Error{Message: "invalid from"})
 	}
 
-	if participant.IsConfirmed {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "participante já confirmado"})
+	toUUID, err := uuid.Parse(params.To)
+	if err != nil {
+		return spec.GetTripsTripIDSnapshotsDiffJSON400Response(spec.Error{Message: "invalid to"})
 	}
 
-	if err := api.store.ConfirmParticipant(r.Context(), participantUUID); err != nil {
-		api.logger.Error("failed to confirm participant", zap.Error(err), zap.String("participant_id", participantID))
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	var snapshots [2]pgstore.TripSnapshotData
+	for i, snapshotUUID := range []uuid.UUID{fromUUID, toUUID} {
+		snapshots[i], err = api.tripSnapshotData(r.Context(), tripUUID, snapshotUUID)
+		if err != nil {
+			if errors.Is(err, errSnapshotNotFound) {
+				return spec.GetTripsTripIDSnapshotsDiffJSON400Response(spec.Error{Message: "snapshot não encontrado"})
+			}
+			api.log(r.Context()).Error("failed to get snapshot", zap.Error(err), zap.String("snapshot_id", snapshotUUID.String()))
+			return spec.GetTripsTripIDSnapshotsDiffJSON400Response(spec.Error{Message: "failed to get snapshot"})
+		}
 	}
 
-	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
+	return spec.GetTripsTripIDSnapshotsDiffJSON200Response(diffSnapshots(snapshots[0], snapshots[1]))
 }
 
-// PostTrips Create a new trip
-// (POST /trips)
-func (api API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
-	var body spec.CreateTripRequest
-	err := json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+// GetOwnersEmailExport Export all of an owner's trips as a zip archive.
+// (GET /owners/{email}/export)
+func (api API) GetOwnersEmailExport(w http.ResponseWriter, r *http.Request, email types.Email) *spec.Response {
+	ownerEmail := string(email)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.GetOwnersEmailExportJSON400Response(spec.Error{Message: "invalid email"})
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	// Only the owner, or an admin, may take the owner's data away.
+	if !strings.EqualFold(requesterEmail(r), ownerEmail) && !api.isAdmin(r) {
+		return spec.GetOwnersEmailExportJSON403Response(spec.Error{Message: "apenas o dono ou administradores podem exportar os dados"})
 	}
 
-	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
+	trips, err := api.store.GetOwnerTrips(r.Context(), ownerEmail)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+		api.log(r.Context()).Error("failed to get owner trips", zap.Error(err))
+		return spec.GetOwnersEmailExportJSON400Response(spec.Error{Message: "something went wrong, try again"})
 	}
 
-	go func() {
-		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripID); err != nil {
-			api.logger.Error(
-				"failed to send email on PostTrips",
-				zap.Error(err),
-				zap.String("trip_id", tripID.String()),
-			)
-		}
-	}()
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "trips.zip"}))
+	w.WriteHeader(http.StatusOK)
 
-	return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: tripID.String()})
+	// The status is already sent, so a failure midway can only cut the
+	// archive short.
+	if err := api.writeOwnerExport(r.Context(), w, trips); err != nil {
+		api.log(r.Context()).Error("failed to export owner trips", zap.Error(err))
+	}
+	return nil
 }
 
-// GetTripsTripID Get a trip details.
-// (GET /trips/{tripId})
-func (api API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, err := uuid.Parse(tripID)
-	if err != nil {
-		spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+// DeleteOwnersEmail Erase all of an owner's data.
+// (DELETE /owners/{email})
+func (api API) DeleteOwnersEmail(w http.ResponseWriter, r *http.Request, email types.Email) *spec.Response {
+	if !api.isAdmin(r) {
+		return spec.DeleteOwnersEmailJSON403Response(spec.Error{Message: "acesso restrito a administradores"})
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+	ownerEmail := string(email)
+	if err := api.validator.Var(ownerEmail, "required,email"); err != nil {
+		return spec.DeleteOwnersEmailJSON400Response(spec.Error{Message: "invalid email"})
 	}
 
-	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{
-		Trip: spec.GetTripDetailsResponseTripObj{
-			Destination: trip.Destination,
-			EndsAt:      trip.EndsAt.Time,
-			ID:          trip.ID.String(),
-			IsConfirmed: trip.IsConfirmed,
-			StartsAt:    trip.StartsAt.Time,
-		},
-	})
+	if err := api.store.EraseOwner(r.Context(), api.pool, ownerEmail); err != nil {
+		api.log(r.Context()).Error("failed to erase owner", zap.Error(err))
+		return spec.DeleteOwnersEmailJSON500Response(spec.Error{Message: "failed to erase owner data, try again"})
+	}
+
+	return spec.DeleteOwnersEmailJSON204Response(nil)
 }
 
-// PutTripsTripID Update a trip.
-// (PUT /trips/{tripId})
-func (api API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantID Assign a participant to a trip activity.
+// (PUT /trips/{tripId}/activities/{activityId}/participants/{participantId})
+func (api API) PutTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	var body spec.UpdateTripRequest
-	err = json.NewDecoder(r.Body).Decode(&body)
+	activityUUID, err := uuid.Parse(activityID)
 	if err != nil {
-		spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid activityID"})
 	}
 
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+	if _, err := uuid.Parse(participantID); err != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid participantID"})
 	}
 
-	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
+	}
+
+	activity, err := api.store.GetActivity(r.Context(), activityUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+			return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+		api.log(r.Context()).Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if activity.TripID != tripUUID {
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "atividade não encontrada"})
 	}
 
-	err = api.store.UpdateTrip(r.Context(), pgstore.UpdateTripParams{
-		Destination: body.Destination,
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
-		ID:          tripUUID,
-	})
+	participant, err := api.tripParticipantID(r.Context(), tripUUID, &participantID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		if errors.Is(err, errNotTripParticipant) {
+			return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "participante não encontrado"})
 		}
-		api.logger.Error("failed to update trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid tripID"})
+		api.log(r.Context()).Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
 	}
 
-	return spec.PutTripsTripIDJSON204Response(nil)
+	err = api.store.AssignActivityParticipant(r.Context(), pgstore.AssignActivityParticipantParams{
+		ActivityID:    activityUUID,
+		ParticipantID: participant.Bytes,
+	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to assign participant", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "failed to assign participant, try again"})
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response(nil)
 }
 
-// GetTripsTripIDActivities Get a trip activities.
-// (GET /trips/{tripId}/activities)
-func (api API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID Unassign a participant from a trip activity.
+// (DELETE /trips/{tripId}/activities/{activityId}/participants/{participantId})
+func (api API) DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	activityUUID, err := uuid.Parse(activityID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "viagem não encontrada"})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid activityID"})
 	}
 
-	activitiesInDB, err := api.store.GetTripActivities(r.Context(), tripUUID)
+	participantUUID, err := uuid.Parse(participantID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "nenhuma atividade encontrada"})
-		}
-		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to get activities"})
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "invalid participantID"})
 	}
 
-	activityMap := make(map[time.Time][]spec.GetTripActivitiesResponseInnerArray)
-	for _, activity := range activitiesInDB {
-		date := activity.OccursAt.Time
-		activityMap[date] = append(activityMap[date], spec.GetTripActivitiesResponseInnerArray{
-			ID:       activity.ID.String(),
-			OccursAt: activity.OccursAt.Time,
-			Title:    activity.Title,
-		})
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
+	if err != nil {
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
 	}
-
-	var activities []spec.GetTripActivitiesResponseOuterArray
-	for date, innerActivities := range activityMap {
-		activities = append(activities, spec.GetTripActivitiesResponseOuterArray{
-			Activities: innerActivities,
-			Date:       date,
-		})
+	if !canEdit {
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
-		Activities: activities,
+	unassigned, err := api.store.UnassignActivityParticipant(r.Context(), pgstore.UnassignActivityParticipantParams{
+		ActivityID:    activityUUID,
+		ParticipantID: participantUUID,
+		TripID:        tripUUID,
 	})
-}
+	if err != nil {
+		api.log(r.Context()).Error("failed to unassign participant", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(spec.Error{Message: "failed to unassign participant, try again"})
+	}
+	if unassigned == 0 {
+		return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(spec.Error{Message: "participante não atribuído à atividade"})
+	}
 
-// PostTripsTripIDActivities Create a trip activity.
-// (POST /trips/{tripId}/activities)
-func (api API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	var body spec.CreateActivityRequest
+	return spec.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response(nil)
+}
 
+// GetTripsTripIDBusiestDay Get the trip day with the most activities.
+// (GET /trips/{tripId}/busiest-day)
+func (api API) GetTripsTripIDBusiestDay(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.GetTripsTripIDBusiestDayJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	err = json.NewDecoder(r.Body).Decode(&body)
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
-		spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
-	}
-
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDBusiestDayJSON400Response(spec.Error{Message: "viagem não encontrada"})
+		}
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBusiestDayJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	activityId, err := api.store.CreateActivity(r.Context(), pgstore.CreateActivityParams{
-		TripID:   tripUUID,
-		Title:    body.Title,
-		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
-	})
+	day, err := api.store.GetTripBusiestDay(r.Context(), tripUUID)
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create trip activity, try again"})
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDBusiestDayJSON204Response(nil)
+		}
+		api.log(r.Context()).Error("failed to get busiest day", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBusiestDayJSON400Response(spec.Error{Message: "failed to get activities"})
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{
-		ActivityID: activityId.String(),
+	return spec.GetTripsTripIDBusiestDayJSON200Response(spec.GetTripBusiestDayResponse{
+		Date:       types.Date{Time: day.Day.Time},
+		Activities: int(day.Activities),
 	})
 }
 
-// GetTripsTripIDConfirm Confirm a trip and send e-mail invitations.
-// (GET /trips/{tripId}/confirm)
-func (api API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// GetTripsTripIDEmailStatus Get the status of the trip confirmation email.
+// (GET /trips/{tripId}/email-status)
+func (api API) GetTripsTripIDEmailStatus(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.GetTripsTripIDEmailStatusJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), tripUUID)
+	_, err = api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "viagem não encontrada"})
+			return spec.GetTripsTripIDEmailStatusJSON404Response(spec.Error{Message: "viagem não encontrada"})
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "invalid tripID"})
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEmailStatusJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	if trip.IsConfirmed {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "trip already confirmed"})
-	}
-
-	err = api.store.ConfirmTrip(r.Context(), trip.ID)
+	entry, err := api.store.GetLatestTripEmail(r.Context(), pgstore.GetLatestTripEmailParams{
+		TripID: tripUUID,
+		Kind:   "confirm_trip",
+	})
 	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Message: "failed to confirm trip, try again"})
+		// The email is sent in the background, nothing is logged until the
+		// first attempt finishes.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return spec.GetTripsTripIDEmailStatusJSON200Response(spec.GetTripEmailStatusResponse{
+				Status: spec.GetTripEmailStatusResponseStatusPending,
+			})
+		}
+		api.log(r.Context()).Error("failed to get email status", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEmailStatusJSON400Response(spec.Error{Message: "failed to get email status"})
 	}
 
-	go func() {
-		// TODO: Implementar email de convite para participantes
-		//if err := api.mailer.SendConfirmTripEmailToTripOwner(tripUUID); err != nil {
-		//	api.logger.Error(
-		//		"failed to send email on PostTrips",
-		//		zap.Error(err),
-		//		zap.String("trip_id", tripUUID.String()),
-		//	)
-		//}
-	}()
+	res := spec.GetTripEmailStatusResponse{
+		Recipient:   &entry.Recipient,
+		AttemptedAt: &entry.CreatedAt.Time,
+	}
+	if err := res.Status.FromValue(entry.Status); err != nil {
+		api.log(r.Context()).Error("unknown email status", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDEmailStatusJSON400Response(spec.Error{Message: "failed to get email status"})
+	}
+	if entry.Error.Valid {
+		res.Error = &entry.Error.String
+	}
 
-	return spec.GetTripsTripIDConfirmJSON204Response(nil)
+	return spec.GetTripsTripIDEmailStatusJSON200Response(res)
 }
 
-// PostTripsTripIDInvites Invite someone to the trip.
-// (POST /trips/{tripId}/invites)
-func (api API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	var body spec.InviteParticipantRequest
-
+// PostTripsTripIDResendConfirmation Send the trip confirmation email to the owner again.
+// (POST /trips/{tripId}/resend-confirmation)
+func (api API) PostTripsTripIDResendConfirmation(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid tripID"})
-	}
-
-	err = json.NewDecoder(r.Body).Decode(&body)
-	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
-	}
-
-	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return spec.PostTripsTripIDResendConfirmationJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
 	trip, err := api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "viagem não encontrada"})
+			return spec.PostTripsTripIDResendConfirmationJSON404Response(spec.Error{Message: "viagem não encontrada"})
 		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid tripID"})
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDResendConfirmationJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
-
-	_, err = api.store.InviteParticipantToTrip(r.Context(), pgstore.InviteParticipantToTripParams{
-		TripID: trip.ID,
-		Email:  string(body.Email),
-	})
-	if err != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "failed to invite user to trip, try again"})
+	if trip.IsConfirmed {
+		return spec.PostTripsTripIDResendConfirmationJSON400Response(spec.Error{Message: "trip already confirmed"})
 	}
 
 	go func() {
-		// TODO: Implementar email de convite para participante
-		//if err := api.mailer.SendConfirmTripEmailToTripOwner(tripUUID); err != nil {
-		//	api.logger.Error(
-		//		"failed to send email on PostTrips",
-		//		zap.Error(err),
-		//		zap.String("trip_id", tripUUID.String()),
-		//	)
-		//}
+		if err := api.mailer.SendConfirmTripEmailToTripOwner(tripUUID); err != nil {
+			api.log(r.Context()).Error(
+				"failed to send email on PostTripsTripIDResendConfirmation",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
 	}()
 
-	return spec.PostTripsTripIDInvitesJSON201Response(nil)
+	return spec.PostTripsTripIDResendConfirmationJSON202Response(nil)
 }
 
-// GetTripsTripIDLinks Get a trip links.
-// (GET /trips/{tripId}/links)
-func (api API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// GetTripsTripIDDateWindows List the days of a trip.
+// (GET /trips/{tripId}/date-windows)
+func (api API) GetTripsTripIDDateWindows(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
-	}
-
-	_, err = api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "viagem não encontrada"})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.GetTripsTripIDDateWindowsJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	linksInDB, err := api.store.GetTripLinks(r.Context(), tripUUID)
+	trip, err := api.store.GetTrip(r.Context(), tripUUID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "nenhum link encontrado"})
+			return spec.GetTripsTripIDDateWindowsJSON404Response(spec.Error{Message: "viagem não encontrada"})
 		}
-		api.logger.Error("failed to get links", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to get links"})
+		api.log(r.Context()).Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDateWindowsJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	var links []spec.GetLinksResponseArray
-	for _, link := range linksInDB {
-		links = append(links, spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		})
+	days := make([]spec.TripDateWindow, 0)
+	for _, day := range tripDays(trip) {
+		days = append(days, spec.TripDateWindow{Date: types.Date{Time: day}})
 	}
 
-	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{
-		Links: links,
-	})
+	return spec.GetTripsTripIDDateWindowsJSON200Response(spec.GetTripDateWindowsResponse{Days: days})
 }
 
-// PostTripsTripIDLinks Create a trip link.
-// (POST /trips/{tripId}/links)
-func (api API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	var body spec.CreateLinkRequest
-
+// PutTripsTripIDLinksLinkID Update a trip link.
+// (PUT /trips/{tripId}/links/{linkId})
+func (api API) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	err = json.NewDecoder(r.Body).Decode(&body)
+	linkUUID, err := uuid.Parse(linkID)
 	if err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid linkID"})
+	}
+
+	var body spec.UpdateLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(validationError(err))
 	}
 
-	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	if !isHTTPURL(body.URL) {
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid URL"})
+	}
+
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "viagem não encontrada"})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid tripID"})
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
+	}
+	if !canEdit {
+		return spec.PutTripsTripIDLinksLinkIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
 	}
 
-	linkID, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
-		TripID: tripUUID,
+	updated, err := api.store.UpdateLink(r.Context(), pgstore.UpdateLinkParams{
 		Title:  body.Title,
 		Url:    body.URL,
+		ID:     linkUUID,
+		TripID: tripUUID,
 	})
 	if err != nil {
-		api.logger.Error("failed to create link", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "failed to create link"})
+		api.log(r.Context()).Error("failed to update link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.PutTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "failed to update link, try again"})
+	}
+	if updated == 0 {
+		return spec.PutTripsTripIDLinksLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
 	}
 
-	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{
-		LinkID: linkID.String(),
-	})
+	return spec.PutTripsTripIDLinksLinkIDJSON204Response(nil)
 }
 
-// GetTripsTripIDParticipants Get a trip participants.
-// (GET /trips/{tripId}/participants)
-func (api API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+// DeleteTripsTripIDLinksLinkID Delete a trip link.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api API) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
 	tripUUID, err := uuid.Parse(tripID)
 	if err != nil {
-		spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid tripID"})
 	}
 
-	_, err = api.store.GetTrip(r.Context(), tripUUID)
+	linkUUID, err := uuid.Parse(linkID)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "viagem não encontrada"})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "invalid tripID"})
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "invalid linkID"})
 	}
 
-	participantsInDB, err := api.store.GetParticipants(r.Context(), tripUUID)
+	canEdit, err := api.canEditTrip(r.Context(), tripUUID, requesterEmail(r))
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "nenhum participante encontrado"})
-		}
-		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Message: "failed to get participants"})
+		api.log(r.Context()).Error("failed to check trip permissions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "something went wrong, try again"})
 	}
-
-	var participants []spec.GetTripParticipantsResponseArray
-	for _, participant := range participantsInDB {
-		participants = append(participants, spec.GetTripParticipantsResponseArray{
-			Email:       types.Email(participant.Email),
-			ID:          participant.ID.String(),
-			IsConfirmed: participant.IsConfirmed,
-			// TODO: Implementar campo nome para participantes
-			Name: nil,
-		})
+	if !canEdit {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON403Response(spec.Error{Message: "apenas o dono ou organizadores podem editar a viagem"})
 	}
 
-	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
-		Participants: participants,
+	// A link of another trip is not deleted, and is reported as not found.
+	deleted, err := api.store.DeleteLink(r.Context(), pgstore.DeleteLinkParams{
+		ID:     linkUUID,
+		TripID: tripUUID,
 	})
+	if err != nil {
+		api.log(r.Context()).Error("failed to delete link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{Message: "failed to delete link, try again"})
+	}
+	if deleted == 0 {
+		return spec.DeleteTripsTripIDLinksLinkIDJSON404Response(spec.Error{Message: "link não encontrado"})
+	}
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// GetAdminEagerParticipants List the confirmed participants of trips not confirmed yet.
+// (GET /admin/eager-participants)
+func (api API) GetAdminEagerParticipants(w http.ResponseWriter, r *http.Request) *spec.Response {
+	if !api.isAdmin(r) {
+		return spec.GetAdminEagerParticipantsJSON403Response(spec.Error{Message: "acesso restrito a administradores"})
+	}
+
+	participants, err := api.store.GetEagerParticipants(r.Context())
+	if err != nil {
+		api.log(r.Context()).Error("failed to get eager participants", zap.Error(err))
+		return spec.GetAdminEagerParticipantsJSON400Response(spec.Error{Message: "failed to get participants, try again"})
+	}
+
+	output := spec.GetEagerParticipantsResponse{
+		Participants: make([]spec.GetEagerParticipantsResponseArray, 0, len(participants)),
+	}
+	for _, p := range participants {
+		participant := spec.GetEagerParticipantsResponseArray{
+			ID:          p.ID.String(),
+			Email:       types.Email(p.Email),
+			TripID:      p.TripID.String(),
+			Destination: p.Destination,
+		}
+		if p.Name.Valid {
+			participant.Name = &p.Name.String
+		}
+		output.Participants = append(output.Participants, participant)
+	}
+
+	return spec.GetAdminEagerParticipantsJSON200Response(output)
 }
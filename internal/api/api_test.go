@@ -0,0 +1,3816 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"io"
+	"journey/internal/api/spec"
+	"journey/internal/geocode"
+	"journey/internal/pgstore"
+	"journey/internal/webhook"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeStore keeps trips and what hangs off them in memory. Store methods no
+// test needs are left to the embedded nil store, and panic when called.
+type fakeStore struct {
+	store
+
+	mu           sync.Mutex
+	trips        map[uuid.UUID]pgstore.Trip
+	participants map[uuid.UUID]pgstore.Participant
+	activities   map[uuid.UUID]pgstore.Activity
+	links        map[uuid.UUID]pgstore.Link
+	packingItems map[uuid.UUID]pgstore.PackingItem
+	assignments  []pgstore.ActivityParticipant
+	snapshots    map[uuid.UUID]pgstore.TripSnapshot
+	emailLog     []pgstore.EmailLog
+	unavailable  []pgstore.ParticipantUnavailability
+	// tripsEstimate is the planner's estimate of how many active (false)
+	// and archived (true) trips there are, -1 when missing.
+	tripsEstimate map[bool]int64
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		trips:        make(map[uuid.UUID]pgstore.Trip),
+		participants: make(map[uuid.UUID]pgstore.Participant),
+		activities:   make(map[uuid.UUID]pgstore.Activity),
+		links:        make(map[uuid.UUID]pgstore.Link),
+		packingItems: make(map[uuid.UUID]pgstore.PackingItem),
+		snapshots:    make(map[uuid.UUID]pgstore.TripSnapshot),
+	}
+}
+
+// addTrip stores a trip owned by owner@example.com running from the 10th to
+// the 15th of June 2030, as changed by opts.
+func (s *fakeStore) addTrip(opts ...func(*pgstore.Trip)) pgstore.Trip {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Florianópolis",
+		OwnerEmail:  "owner@example.com",
+		OwnerName:   "Dono",
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC)},
+		EndsAt:      pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC)},
+		CreatedAt:   pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+		Status:      "active",
+	}
+	for _, opt := range opts {
+		opt(&trip)
+	}
+	s.trips[trip.ID] = trip
+	return trip
+}
+
+// addParticipant stores a participant of the trip with email, as changed by
+// opts.
+func (s *fakeStore) addParticipant(tripID uuid.UUID, email string, opts ...func(*pgstore.Participant)) pgstore.Participant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant := pgstore.Participant{
+		ID:              uuid.New(),
+		TripID:          tripID,
+		Email:           email,
+		InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(24 * time.Hour)},
+		CreatedAt:       pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	for _, opt := range opts {
+		opt(&participant)
+	}
+	s.participants[participant.ID] = participant
+	return participant
+}
+
+// addActivity stores an activity of the trip.
+func (s *fakeStore) addActivity(tripID uuid.UUID, title string, occursAt time.Time) pgstore.Activity {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity := pgstore.Activity{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		Title:     title,
+		OccursAt:  pgtype.Timestamp{Valid: true, Time: occursAt},
+		CreatedAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	s.activities[activity.ID] = activity
+	return activity
+}
+
+// tripActivities lists the activities of the trip ordered by when they occur.
+func (s *fakeStore) tripActivities(tripID uuid.UUID) []pgstore.Activity {
+	var activities []pgstore.Activity
+	for _, a := range s.activities {
+		if a.TripID == tripID {
+			activities = append(activities, a)
+		}
+	}
+	sort.Slice(activities, func(i, j int) bool { return activities[i].OccursAt.Time.Before(activities[j].OccursAt.Time) })
+	return activities
+}
+
+func (s *fakeStore) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[id]
+	if !ok {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return trip, nil
+}
+
+func (s *fakeStore) IsOwner(_ context.Context, arg pgstore.IsOwnerParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[arg.TripID]
+	return ok && strings.EqualFold(trip.OwnerEmail, arg.Email), nil
+}
+
+func (s *fakeStore) IsOrganizer(_ context.Context, arg pgstore.IsOrganizerParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.participants {
+		if p.TripID == arg.TripID && !p.DeletedAt.Valid && p.IsOrganizer && strings.EqualFold(p.Email, arg.Email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *fakeStore) ActivityExists(_ context.Context, arg pgstore.ActivityExistsParams) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.activities {
+		if a.TripID == arg.TripID && a.OccursAt.Time.Equal(arg.OccursAt.Time) &&
+			strings.ToLower(strings.Trim(a.Title, " ")) == strings.ToLower(strings.Trim(arg.Title, " ")) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *fakeStore) CreateActivity(_ context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity := pgstore.Activity{
+		ID:                  uuid.New(),
+		TripID:              arg.TripID,
+		Title:               arg.Title,
+		OccursAt:            arg.OccursAt,
+		RemindBeforeMinutes: arg.RemindBeforeMinutes,
+		DurationMinutes:     arg.DurationMinutes,
+		CreatedAt:           pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	s.activities[activity.ID] = activity
+	return activity.ID, nil
+}
+
+func (s *fakeStore) CreateActivities(ctx context.Context, _ *pgxpool.Pool, params []pgstore.CreateActivityParams) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, len(params))
+	for i, arg := range params {
+		ids[i], _ = s.CreateActivity(ctx, arg)
+	}
+	return ids, nil
+}
+
+func (s *fakeStore) GetTripActivities(_ context.Context, tripID uuid.UUID) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tripActivities(tripID), nil
+}
+
+// addLink stores a link of the trip.
+func (s *fakeStore) addLink(tripID uuid.UUID, title, url string) pgstore.Link {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link := pgstore.Link{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		Title:     title,
+		Url:       url,
+		CreatedAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	s.links[link.ID] = link
+	return link
+}
+
+// tripParticipants lists the live participants of the trip ordered by email.
+func (s *fakeStore) tripParticipants(tripID uuid.UUID) []pgstore.Participant {
+	var participants []pgstore.Participant
+	for _, p := range s.participants {
+		if p.TripID == tripID && !p.DeletedAt.Valid {
+			participants = append(participants, p)
+		}
+	}
+	sort.Slice(participants, func(i, j int) bool { return participants[i].Email < participants[j].Email })
+	return participants
+}
+
+// tripLinks lists the links of the trip ordered by title.
+func (s *fakeStore) tripLinks(tripID uuid.UUID) []pgstore.Link {
+	var links []pgstore.Link
+	for _, l := range s.links {
+		if l.TripID == tripID {
+			links = append(links, l)
+		}
+	}
+	sort.Slice(links, func(i, j int) bool { return links[i].Title < links[j].Title })
+	return links
+}
+
+func (s *fakeStore) CountTripActivities(_ context.Context, tripID uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int64(len(s.tripActivities(tripID))), nil
+}
+
+func (s *fakeStore) CountTripParticipants(_ context.Context, tripID uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int64(len(s.tripParticipants(tripID))), nil
+}
+
+func (s *fakeStore) CountTripLinks(_ context.Context, tripID uuid.UUID) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int64(len(s.tripLinks(tripID))), nil
+}
+
+func (s *fakeStore) participant(id uuid.UUID) pgstore.Participant {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.participants[id]
+}
+
+func (s *fakeStore) InviteParticipant(_ context.Context, arg pgstore.InviteParticipantToTripParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.tripParticipants(arg.TripID) {
+		if strings.EqualFold(p.Email, arg.Email) {
+			return uuid.Nil, pgstore.ErrParticipantAlreadyInvited
+		}
+	}
+
+	participant := pgstore.Participant{
+		ID:              uuid.New(),
+		TripID:          arg.TripID,
+		Email:           arg.Email,
+		InviteExpiresAt: arg.InviteExpiresAt,
+		Name:            arg.Name,
+		CreatedAt:       pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	s.participants[participant.ID] = participant
+	return participant.ID, nil
+}
+
+func (s *fakeStore) GetParticipant(_ context.Context, id uuid.UUID) (pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[id]
+	if !ok || participant.DeletedAt.Valid {
+		return pgstore.Participant{}, pgx.ErrNoRows
+	}
+	return participant, nil
+}
+
+func (s *fakeStore) GetParticipantWithTrip(ctx context.Context, id uuid.UUID) (pgstore.GetParticipantWithTripRow, error) {
+	participant, err := s.GetParticipant(ctx, id)
+	if err != nil {
+		return pgstore.GetParticipantWithTripRow{}, err
+	}
+	trip, err := s.GetTrip(ctx, participant.TripID)
+	return pgstore.GetParticipantWithTripRow{Participant: participant, Trip: trip}, err
+}
+
+func (s *fakeStore) ConfirmParticipant(_ context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant := s.participants[id]
+	participant.IsConfirmed = true
+	participant.ConfirmedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	s.participants[id] = participant
+	return nil
+}
+
+func (s *fakeStore) RenewParticipantInvite(_ context.Context, arg pgstore.RenewParticipantInviteParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant := s.participants[arg.ID]
+	participant.InviteExpiresAt = arg.InviteExpiresAt
+	s.participants[arg.ID] = participant
+	return nil
+}
+
+func (s *fakeStore) GetTripLinks(_ context.Context, tripID uuid.UUID) ([]pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tripLinks(tripID), nil
+}
+
+func (s *fakeStore) SetParticipantOrganizer(_ context.Context, arg pgstore.SetParticipantOrganizerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant := s.participants[arg.ID]
+	participant.IsOrganizer = arg.IsOrganizer
+	s.participants[arg.ID] = participant
+	return nil
+}
+
+func (s *fakeStore) CreatePackingItem(_ context.Context, arg pgstore.CreatePackingItemParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item := pgstore.PackingItem{ID: uuid.New(), TripID: arg.TripID, Text: arg.Text, AssignedTo: arg.AssignedTo}
+	s.packingItems[item.ID] = item
+	return item.ID, nil
+}
+
+func (s *fakeStore) GetTripPackingItems(_ context.Context, tripID uuid.UUID) ([]pgstore.PackingItem, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var items []pgstore.PackingItem
+	for _, item := range s.packingItems {
+		if item.TripID == tripID {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Text < items[j].Text })
+	return items, nil
+}
+
+func (s *fakeStore) UpdatePackingItem(_ context.Context, arg pgstore.UpdatePackingItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.packingItems[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	item.Text, item.AssignedTo = arg.Text, arg.AssignedTo
+	s.packingItems[arg.ID] = item
+	return 1, nil
+}
+
+func (s *fakeStore) TogglePackingItem(_ context.Context, arg pgstore.TogglePackingItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.packingItems[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	item.IsPacked = !item.IsPacked
+	s.packingItems[arg.ID] = item
+	return 1, nil
+}
+
+func (s *fakeStore) DeletePackingItem(_ context.Context, arg pgstore.DeletePackingItemParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.packingItems[arg.ID]
+	if !ok || item.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.packingItems, arg.ID)
+	return 1, nil
+}
+
+func (s *fakeStore) GetTripActivitiesBetween(_ context.Context, arg pgstore.GetTripActivitiesBetweenParams) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var activities []pgstore.Activity
+	for _, a := range s.tripActivities(arg.TripID) {
+		if !a.OccursAt.Time.Before(arg.StartsAt.Time) && a.OccursAt.Time.Before(arg.EndsAt.Time) {
+			activities = append(activities, a)
+		}
+	}
+	return activities, nil
+}
+
+func (s *fakeStore) GetTripLinksCreatedBetween(_ context.Context, arg pgstore.GetTripLinksCreatedBetweenParams) ([]pgstore.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var links []pgstore.Link
+	for _, l := range s.tripLinks(arg.TripID) {
+		if !l.CreatedAt.Time.Before(arg.StartsAt.Time) && l.CreatedAt.Time.Before(arg.EndsAt.Time) {
+			links = append(links, l)
+		}
+	}
+	return links, nil
+}
+
+func (s *fakeStore) GetTripActivityParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityParticipantsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rows []pgstore.GetTripActivityParticipantsRow
+	for _, a := range s.assignments {
+		p, ok := s.participants[a.ParticipantID]
+		if !ok || p.DeletedAt.Valid || s.activities[a.ActivityID].TripID != tripID {
+			continue
+		}
+		rows = append(rows, pgstore.GetTripActivityParticipantsRow{ActivityID: a.ActivityID, ID: p.ID, Email: p.Email, Name: p.Name})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Email < rows[j].Email })
+	return rows, nil
+}
+
+func (s *fakeStore) GetParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.tripParticipants(tripID), nil
+}
+
+func (s *fakeStore) SetParticipantGroup(_ context.Context, arg pgstore.SetParticipantGroupParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.participants[arg.ID]
+	p.GroupName = arg.GroupName
+	s.participants[arg.ID] = p
+	return nil
+}
+
+func (s *fakeStore) CreateParticipantUnavailability(_ context.Context, arg pgstore.CreateParticipantUnavailabilityParams) (uuid.UUID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unavailability := pgstore.ParticipantUnavailability{
+		ID:            uuid.New(),
+		ParticipantID: arg.ParticipantID,
+		StartsAt:      arg.StartsAt,
+		EndsAt:        arg.EndsAt,
+	}
+	s.unavailable = append(s.unavailable, unavailability)
+	return unavailability.ID, nil
+}
+
+func (s *fakeStore) ConfirmTripOnce(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	if trip.IsConfirmed {
+		return pgstore.ErrTripAlreadyConfirmed
+	}
+	trip.IsConfirmed = true
+	s.trips[tripID] = trip
+	return nil
+}
+
+func (s *fakeStore) InviteParticipants(ctx context.Context, _ *pgxpool.Pool, params []pgstore.InviteParticipantToTripParams) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(params))
+	for _, arg := range params {
+		id, err := s.InviteParticipant(ctx, arg)
+		if err != nil {
+			// Nobody is invited, as the transaction is rolled back.
+			s.mu.Lock()
+			for _, id := range ids {
+				delete(s.participants, id)
+			}
+			s.mu.Unlock()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// staleParticipantsStore misses, the first time it lists the participants of
+// a trip, the one invited by a request racing with the caller.
+type staleParticipantsStore struct {
+	*fakeStore
+	racing string
+}
+
+func (s *staleParticipantsStore) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	participants, err := s.fakeStore.GetParticipants(ctx, tripID)
+	if err != nil || s.racing == "" {
+		return participants, err
+	}
+
+	var stale []pgstore.Participant
+	for _, p := range participants {
+		if p.Email != s.racing {
+			stale = append(stale, p)
+		}
+	}
+	s.racing = ""
+	return stale, nil
+}
+
+func (s *fakeStore) GetTripsCreatedPerDay(_ context.Context, arg pgstore.GetTripsCreatedPerDayParams) ([]pgstore.GetTripsCreatedPerDayRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[time.Time]int64)
+	for _, trip := range s.trips {
+		if !trip.CreatedAt.Time.Before(arg.CreatedFrom.Time) && trip.CreatedAt.Time.Before(arg.CreatedTo.Time) {
+			counts[trip.CreatedAt.Time.Truncate(24*time.Hour)]++
+		}
+	}
+	rows := make([]pgstore.GetTripsCreatedPerDayRow, 0, len(counts))
+	for day, trips := range counts {
+		rows = append(rows, pgstore.GetTripsCreatedPerDayRow{Day: pgtype.Timestamp{Valid: true, Time: day}, Trips: trips})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Day.Time.Before(rows[j].Day.Time) })
+	return rows, nil
+}
+
+func (s *fakeStore) GetOwnerActivitiesBetween(_ context.Context, arg pgstore.GetOwnerActivitiesBetweenParams) ([]pgstore.GetOwnerActivitiesBetweenRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rows []pgstore.GetOwnerActivitiesBetweenRow
+	for _, a := range s.activities {
+		trip := s.trips[a.TripID]
+		if !strings.EqualFold(trip.OwnerEmail, arg.OwnerEmail) || a.OccursAt.Time.Before(arg.StartsAt.Time) || !a.OccursAt.Time.Before(arg.EndsAt.Time) {
+			continue
+		}
+		rows = append(rows, pgstore.GetOwnerActivitiesBetweenRow{
+			ID:          a.ID,
+			TripID:      a.TripID,
+			Title:       a.Title,
+			OccursAt:    a.OccursAt,
+			Destination: trip.Destination,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].OccursAt.Time.Before(rows[j].OccursAt.Time) })
+	return rows, nil
+}
+
+func (s *fakeStore) CreateTripLink(_ context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error) {
+	return s.addLink(arg.TripID, arg.Title, arg.Url).ID, nil
+}
+
+func (s *fakeStore) CreateActivityWithLink(ctx context.Context, _ *pgxpool.Pool, activity pgstore.CreateActivityParams, link pgstore.CreateTripLinkParams) (uuid.UUID, uuid.UUID, error) {
+	activityID, _ := s.CreateActivity(ctx, activity)
+	linkID, _ := s.CreateTripLink(ctx, link)
+	return activityID, linkID, nil
+}
+
+func (s *fakeStore) GetOwnerActiveTrips(_ context.Context, ownerEmail string) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if strings.EqualFold(trip.OwnerEmail, ownerEmail) && trip.Status != "archived" {
+			trips = append(trips, trip)
+		}
+	}
+	sort.Slice(trips, func(i, j int) bool { return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time) })
+	return trips, nil
+}
+
+func (s *fakeStore) DeleteParticipant(_ context.Context, arg pgstore.DeleteParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[arg.ID]
+	if !ok || participant.TripID != arg.TripID || participant.DeletedAt.Valid {
+		return 0, nil
+	}
+	participant.DeletedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	s.participants[arg.ID] = participant
+	return 1, nil
+}
+
+func (s *fakeStore) RestoreRemovedParticipant(_ context.Context, arg pgstore.RestoreParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	participant, ok := s.participants[arg.ID]
+	if !ok || participant.TripID != arg.TripID || !participant.DeletedAt.Valid || participant.DeletedAt.Time.Before(arg.DeletedAfter.Time) {
+		return 0, nil
+	}
+	for _, p := range s.tripParticipants(arg.TripID) {
+		if strings.EqualFold(p.Email, participant.Email) {
+			return 0, pgstore.ErrParticipantAlreadyInvited
+		}
+	}
+	participant.DeletedAt = pgtype.Timestamp{}
+	s.participants[arg.ID] = participant
+	return 1, nil
+}
+
+func (s *fakeStore) FinalizeTrip(_ context.Context, _ *pgxpool.Pool, tripID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[tripID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+
+	var confirmed int
+	for _, p := range s.tripParticipants(tripID) {
+		if !p.IsConfirmed {
+			p.IsConfirmed = true
+			s.participants[p.ID] = p
+			confirmed++
+		}
+	}
+	if trip.IsConfirmed && confirmed == 0 {
+		return pgstore.ErrTripAlreadyFinalized
+	}
+	trip.IsConfirmed = true
+	s.trips[tripID] = trip
+	return nil
+}
+
+func (s *fakeStore) GetActivity(_ context.Context, id uuid.UUID) (pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity, ok := s.activities[id]
+	if !ok {
+		return pgstore.Activity{}, pgx.ErrNoRows
+	}
+	return activity, nil
+}
+
+func (s *fakeStore) DeleteActivity(_ context.Context, arg pgstore.DeleteActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if activity, ok := s.activities[arg.ID]; !ok || activity.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.activities, arg.ID)
+	return 1, nil
+}
+
+// addSnapshot stores a snapshot of the trip saving data.
+func (s *fakeStore) addSnapshot(t *testing.T, tripID uuid.UUID, data pgstore.TripSnapshotData) pgstore.TripSnapshot {
+	t.Helper()
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("failed to marshal snapshot: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := pgstore.TripSnapshot{
+		ID:        uuid.New(),
+		TripID:    tripID,
+		Data:      raw,
+		CreatedAt: pgtype.Timestamp{Valid: true, Time: time.Now().UTC()},
+	}
+	s.snapshots[snapshot.ID] = snapshot
+	return snapshot
+}
+
+func (s *fakeStore) GetTripSnapshot(_ context.Context, id uuid.UUID) (pgstore.TripSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		return pgstore.TripSnapshot{}, pgx.ErrNoRows
+	}
+	return snapshot, nil
+}
+
+// RestoreTripSnapshot only brings back the trip details saved in snapshot.
+func (s *fakeStore) RestoreTripSnapshot(_ context.Context, _ *pgxpool.Pool, snapshot pgstore.TripSnapshot) error {
+	var data pgstore.TripSnapshotData
+	if err := json.Unmarshal(snapshot.Data, &data); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip := s.trips[snapshot.TripID]
+	trip.Destination = data.Trip.Destination
+	trip.StartsAt = data.Trip.StartsAt
+	trip.EndsAt = data.Trip.EndsAt
+	s.trips[snapshot.TripID] = trip
+	return nil
+}
+
+func (s *fakeStore) UpdateActivity(_ context.Context, arg pgstore.UpdateActivityParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	activity, ok := s.activities[arg.ID]
+	if !ok || activity.TripID != arg.TripID {
+		return 0, nil
+	}
+	activity.Title = arg.Title
+	activity.OccursAt = arg.OccursAt
+	s.activities[arg.ID] = activity
+	return 1, nil
+}
+
+func (s *fakeStore) GetOwnerTrips(_ context.Context, ownerEmail string) ([]pgstore.Trip, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if strings.EqualFold(trip.OwnerEmail, ownerEmail) {
+			trips = append(trips, trip)
+		}
+	}
+	sort.Slice(trips, func(i, j int) bool { return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time) })
+	return trips, nil
+}
+
+func (s *fakeStore) EraseOwner(_ context.Context, _ *pgxpool.Pool, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, trip := range s.trips {
+		if strings.EqualFold(trip.OwnerEmail, email) {
+			delete(s.trips, id)
+		}
+	}
+	for id, p := range s.participants {
+		if strings.EqualFold(p.Email, email) {
+			p.Email = "deleted-" + id.String() + "@invalid"
+			p.Name = pgtype.Text{}
+			s.participants[id] = p
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) CreateTrip(ctx context.Context, _ *pgxpool.Pool, params spec.CreateTripRequest, inviteExpiresAt time.Time) (uuid.UUID, error) {
+	trip := s.addTrip(func(trip *pgstore.Trip) {
+		trip.Destination = params.Destination
+		trip.OwnerEmail = string(params.OwnerEmail)
+		trip.OwnerName = params.OwnerName
+		trip.StartsAt = pgtype.Timestamp{Valid: true, Time: params.StartsAt}
+		trip.EndsAt = pgtype.Timestamp{Valid: true, Time: params.EndsAt}
+	})
+	for _, email := range params.EmailsToInvite {
+		_, err := s.InviteParticipant(ctx, pgstore.InviteParticipantToTripParams{
+			TripID:          trip.ID,
+			Email:           string(email),
+			InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: inviteExpiresAt},
+		})
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+	return trip.ID, nil
+}
+
+func (s *fakeStore) AssignActivityParticipant(_ context.Context, arg pgstore.AssignActivityParticipantParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.assignments {
+		if a.ActivityID == arg.ActivityID && a.ParticipantID == arg.ParticipantID {
+			return nil
+		}
+	}
+	s.assignments = append(s.assignments, pgstore.ActivityParticipant{ActivityID: arg.ActivityID, ParticipantID: arg.ParticipantID})
+	return nil
+}
+
+func (s *fakeStore) UnassignActivityParticipant(_ context.Context, arg pgstore.UnassignActivityParticipantParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, a := range s.assignments {
+		if a.ActivityID == arg.ActivityID && a.ParticipantID == arg.ParticipantID && s.activities[a.ActivityID].TripID == arg.TripID {
+			s.assignments = append(s.assignments[:i], s.assignments[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (s *fakeStore) GetTripBusiestDay(_ context.Context, tripID uuid.UUID) (pgstore.GetTripBusiestDayRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := make(map[time.Time]int64)
+	for _, a := range s.tripActivities(tripID) {
+		counts[a.OccursAt.Time.Truncate(24*time.Hour)]++
+	}
+
+	var busiest pgstore.GetTripBusiestDayRow
+	for day, n := range counts {
+		if n > busiest.Activities || n == busiest.Activities && day.Before(busiest.Day.Time) {
+			busiest = pgstore.GetTripBusiestDayRow{Day: pgtype.Timestamp{Valid: true, Time: day}, Activities: n}
+		}
+	}
+	if busiest.Activities == 0 {
+		return busiest, pgx.ErrNoRows
+	}
+	return busiest, nil
+}
+
+func (s *fakeStore) DeleteLink(_ context.Context, arg pgstore.DeleteLinkParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if link, ok := s.links[arg.ID]; !ok || link.TripID != arg.TripID {
+		return 0, nil
+	}
+	delete(s.links, arg.ID)
+	return 1, nil
+}
+
+func (s *fakeStore) GetEagerParticipants(context.Context) ([]pgstore.GetEagerParticipantsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var rows []pgstore.GetEagerParticipantsRow
+	for _, p := range s.participants {
+		trip := s.trips[p.TripID]
+		if p.IsConfirmed && !p.DeletedAt.Valid && !trip.IsConfirmed {
+			rows = append(rows, pgstore.GetEagerParticipantsRow{ID: p.ID, Email: p.Email, Name: p.Name, TripID: trip.ID, Destination: trip.Destination})
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Email < rows[j].Email })
+	return rows, nil
+}
+
+// searchTrips filters trips the way SearchTrips and CountSearchTrips do,
+// ignoring sorting and paging.
+func (s *fakeStore) searchTrips(arg pgstore.CountSearchTripsParams) []pgstore.Trip {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	unescape := strings.NewReplacer(`\\`, `\`, `\%`, `%`, `\_`, `_`)
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if arg.Destination.Valid && !strings.Contains(strings.ToLower(trip.Destination), strings.ToLower(unescape.Replace(arg.Destination.String))) {
+			continue
+		}
+		if arg.StartsAfter.Valid && trip.StartsAt.Time.Before(arg.StartsAfter.Time) {
+			continue
+		}
+		if arg.EndsBefore.Valid && trip.EndsAt.Time.After(arg.EndsBefore.Time) {
+			continue
+		}
+		if arg.IsConfirmed.Valid && trip.IsConfirmed != arg.IsConfirmed.Bool {
+			continue
+		}
+		if (trip.Status == "archived") != arg.Archived {
+			continue
+		}
+		trips = append(trips, trip)
+	}
+	sort.Slice(trips, func(i, j int) bool { return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time) })
+	return trips
+}
+
+func (s *fakeStore) SearchTrips(_ context.Context, arg pgstore.SearchTripsParams) ([]pgstore.Trip, error) {
+	return s.searchTrips(pgstore.CountSearchTripsParams{
+		Destination: arg.Destination,
+		StartsAfter: arg.StartsAfter,
+		EndsBefore:  arg.EndsBefore,
+		IsConfirmed: arg.IsConfirmed,
+		Archived:    arg.Archived,
+	}), nil
+}
+
+func (s *fakeStore) CountSearchTrips(_ context.Context, arg pgstore.CountSearchTripsParams) (int64, error) {
+	return int64(len(s.searchTrips(arg))), nil
+}
+
+func (s *fakeStore) logEmail(entry pgstore.EmailLog) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.ID = uuid.New()
+	entry.CreatedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	s.emailLog = append(s.emailLog, entry)
+}
+
+func (s *fakeStore) GetLatestTripEmail(_ context.Context, arg pgstore.GetLatestTripEmailParams) (pgstore.EmailLog, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.emailLog) - 1; i >= 0; i-- {
+		if entry := s.emailLog[i]; entry.TripID == arg.TripID && entry.Kind == arg.Kind {
+			return entry, nil
+		}
+	}
+	return pgstore.EmailLog{}, pgx.ErrNoRows
+}
+
+func (s *fakeStore) ResetParticipantConfirmations(_ context.Context, arg pgstore.ResetParticipantConfirmationsParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, p := range s.participants {
+		if p.TripID == arg.TripID && !p.DeletedAt.Valid {
+			p.IsConfirmed = false
+			p.ConfirmedAt = pgtype.Timestamp{}
+			p.InviteExpiresAt = arg.InviteExpiresAt
+			s.participants[id] = p
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) EstimateTripsCount(_ context.Context, archived bool) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if estimate, ok := s.tripsEstimate[archived]; ok {
+		return estimate, nil
+	}
+	return -1, nil
+}
+
+func (s *fakeStore) GetOwnerConfirmationCounts(_ context.Context, ownerEmail string) (pgstore.GetOwnerConfirmationCountsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var counts pgstore.GetOwnerConfirmationCountsRow
+	for _, p := range s.participants {
+		trip := s.trips[p.TripID]
+		if p.DeletedAt.Valid || !strings.EqualFold(trip.OwnerEmail, ownerEmail) {
+			continue
+		}
+		counts.Invited++
+		if p.IsConfirmed {
+			counts.Confirmed++
+		}
+	}
+	return counts, nil
+}
+
+func (s *fakeStore) UpdateTripPlace(_ context.Context, arg pgstore.UpdateTripPlaceParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	trip, ok := s.trips[arg.ID]
+	if !ok {
+		return nil
+	}
+	trip.PlaceName, trip.Latitude, trip.Longitude = arg.PlaceName, arg.Latitude, arg.Longitude
+	s.trips[arg.ID] = trip
+	return nil
+}
+
+// fakeGeocoder finds the places it knows, and reports on geocoded each
+// destination it is asked about.
+type fakeGeocoder struct {
+	places   map[string]geocode.Place
+	err      error
+	geocoded chan string
+}
+
+func (g *fakeGeocoder) Geocode(_ context.Context, destination string) (geocode.Place, bool, error) {
+	defer func() { g.geocoded <- destination }()
+
+	if g.err != nil {
+		return geocode.Place{}, false, g.err
+	}
+	place, ok := g.places[destination]
+	return place, ok, nil
+}
+
+func (s *fakeStore) GetTripActivitiesCreatedSince(_ context.Context, arg pgstore.GetTripActivitiesCreatedSinceParams) ([]pgstore.Activity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var activities []pgstore.Activity
+	for _, a := range s.activities {
+		if a.TripID == arg.TripID && a.CreatedAt.Time.After(arg.Since.Time) {
+			activities = append(activities, a)
+		}
+	}
+	sort.Slice(activities, func(i, j int) bool { return activities[i].CreatedAt.Time.After(activities[j].CreatedAt.Time) })
+	return activities, nil
+}
+
+// unreachableStore panics on any query, for requests that must be rejected
+// before reaching the database.
+type unreachableStore struct {
+	store
+}
+
+func (s *fakeStore) GetOwnerDestinations(_ context.Context, ownerEmail string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var destinations []string
+	for _, trip := range s.trips {
+		if strings.EqualFold(trip.OwnerEmail, ownerEmail) && trip.Status != "archived" && !slices.Contains(destinations, trip.Destination) {
+			destinations = append(destinations, trip.Destination)
+		}
+	}
+	sort.Strings(destinations)
+	return destinations, nil
+}
+
+// tripsByParticipantEmail lists the trips email takes part in the way
+// GetTripsByParticipantEmail does, ignoring sorting and paging.
+func (s *fakeStore) tripsByParticipantEmail(email string, archived bool) []pgstore.Trip {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var trips []pgstore.Trip
+	for _, trip := range s.trips {
+		if (trip.Status == "archived") != archived {
+			continue
+		}
+		for _, p := range s.participants {
+			if p.TripID == trip.ID && !p.DeletedAt.Valid && strings.EqualFold(p.Email, email) {
+				trips = append(trips, trip)
+				break
+			}
+		}
+	}
+	sort.Slice(trips, func(i, j int) bool { return trips[i].StartsAt.Time.Before(trips[j].StartsAt.Time) })
+	return trips
+}
+
+func (s *fakeStore) GetTripsByParticipantEmail(_ context.Context, arg pgstore.GetTripsByParticipantEmailParams) ([]pgstore.Trip, error) {
+	return s.tripsByParticipantEmail(arg.Email, arg.Archived), nil
+}
+
+func (s *fakeStore) CountTripsByParticipantEmail(_ context.Context, arg pgstore.CountTripsByParticipantEmailParams) (int64, error) {
+	return int64(len(s.tripsByParticipantEmail(arg.Email, arg.Archived))), nil
+}
+
+// fakeMailer records the trips and participants emails were sent for, and
+// fails every send with err when set.
+type fakeMailer struct {
+	mu    sync.Mutex
+	calls []string
+	err   error
+}
+
+func (m *fakeMailer) record(method string, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, method+" "+id.String())
+	return m.err
+}
+
+func (m *fakeMailer) SendConfirmTripEmailToTripOwner(id uuid.UUID) error {
+	return m.record("SendConfirmTripEmailToTripOwner", id)
+}
+
+func (m *fakeMailer) SendTripFinalizedEmails(id uuid.UUID) error {
+	return m.record("SendTripFinalizedEmails", id)
+}
+
+func (m *fakeMailer) SendInviteEmailsToParticipants(id uuid.UUID) error {
+	return m.record("SendInviteEmailsToParticipants", id)
+}
+
+func (m *fakeMailer) ResendInviteEmailsToParticipants(id uuid.UUID) error {
+	return m.record("ResendInviteEmailsToParticipants", id)
+}
+
+func (m *fakeMailer) SendInviteEmailToParticipant(id uuid.UUID) error {
+	return m.record("SendInviteEmailToParticipant", id)
+}
+
+// wait waits for at least n emails to be sent, as handlers send them in the
+// background.
+func (m *fakeMailer) wait(t *testing.T, n int) {
+	t.Helper()
+
+	var sent int
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		m.mu.Lock()
+		sent = len(m.calls)
+		m.mu.Unlock()
+		if sent >= n {
+			return
+		}
+	}
+	t.Fatalf("%d emails sent, want %d", sent, n)
+}
+
+// fakeWebhooks records the events dispatched.
+type fakeWebhooks struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (f *fakeWebhooks) Dispatch(_ uuid.UUID, event string, _ any) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.events = append(f.events, event)
+}
+
+func (f *fakeWebhooks) Replay(context.Context, uuid.UUID) (webhook.Attempt, error) {
+	return webhook.Attempt{}, nil
+}
+
+// newTestAPI builds an API over s with the defaults NewApi falls back to, and
+// the handler routing requests to it.
+func newTestAPI(s *fakeStore) (*API, http.Handler) {
+	apiValidator := validator.New(validator.WithRequiredStructEnabled())
+	apiValidator.RegisterTagNameFunc(jsonFieldName)
+
+	api := &API{
+		store:     s,
+		logger:    zap.NewNop(),
+		validator: apiValidator,
+		mailer:    &fakeMailer{},
+		webhooks:  &fakeWebhooks{},
+		geocoder:  geocode.Noop{},
+		inviteTTL: 7 * 24 * time.Hour,
+		baseURL:   "http://localhost:8080",
+		maxLinks:  50,
+
+		restoreWindow: 72 * time.Hour,
+
+		tripsSort:        "starts_at",
+		activitiesSort:   "occurs_at",
+		participantsSort: "created_at",
+		linksSort:        "created_at",
+	}
+
+	// The handler gets a copy, so tests can still change api between
+	// requests through the pointer.
+	return api, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		spec.Handler(*api).ServeHTTP(w, r)
+	})
+}
+
+// do serves a request with body, and headers given as name and value pairs.
+func do(t *testing.T, h http.Handler, method, target string, body io.Reader, headers ...string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(method, target, body)
+	if body != nil {
+		r.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(headers); i += 2 {
+		r.Header.Set(headers[i], headers[i+1])
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+// jsonBody encodes v as a request body.
+func jsonBody(t *testing.T, v any) io.Reader {
+	t.Helper()
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal body: %v", err)
+	}
+	return bytes.NewReader(raw)
+}
+
+// decode reads the JSON body of w into v.
+func decode(t *testing.T, w *httptest.ResponseRecorder, v any) {
+	t.Helper()
+
+	if err := json.Unmarshal(w.Body.Bytes(), v); err != nil {
+		t.Fatalf("failed to decode %q: %v", w.Body.String(), err)
+	}
+}
+
+// upload serves a multipart request sending content as the file form field.
+func upload(t *testing.T, h http.Handler, target, fileName string, content []byte, headers ...string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile("file", fileName)
+	if err != nil {
+		t.Fatalf("failed to create form file: %v", err)
+	}
+	if _, err := fw.Write(content); err != nil {
+		t.Fatalf("failed to write form file: %v", err)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatalf("failed to close form: %v", err)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, target, &buf)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	for i := 0; i+1 < len(headers); i += 2 {
+		r.Header.Set(headers[i], headers[i+1])
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestPostTripsTripIDActivitiesImportIcs(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	_, h := newTestAPI(s)
+
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"SUMMARY:Passeio de barco",
+		"DTSTART:20300611T090000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Voo de volta",
+		"DTSTART:20300620T090000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART:20300612T090000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Sem horário",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	w := upload(t, h, "/trips/"+trip.ID.String()+"/activities/import-ics", "trip.ics", []byte(ics))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", w.Code, w.Body)
+	}
+
+	var res spec.ImportActivitiesResponse
+	decode(t, w, &res)
+	if len(res.ActivityIds) != 1 {
+		t.Fatalf("imported %d activities, want 1: %+v", len(res.ActivityIds), res)
+	}
+	reasons := make([]string, len(res.Rejected))
+	for i, rejected := range res.Rejected {
+		reasons[i] = rejected.Reason
+	}
+	want := "activity must occur during the trip,missing SUMMARY,missing DTSTART"
+	if got := strings.Join(reasons, ","); got != want {
+		t.Errorf("rejected = %s, want %s", got, want)
+	}
+
+	activities := s.tripActivities(trip.ID)
+	if len(activities) != 1 || activities[0].Title != "Passeio de barco" {
+		t.Errorf("activities = %+v, want the boat trip only", activities)
+	}
+
+	w = upload(t, h, "/trips/"+trip.ID.String()+"/activities/import-ics", "trip.ics", []byte("não é um calendário"))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status of an invalid file = %d, want 400", w.Code)
+	}
+}
+
+func TestHeadCounts(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 9, 0, 0, 0, time.UTC))
+	s.addActivity(trip.ID, "Jantar", time.Date(2030, 6, 11, 20, 0, 0, 0, time.UTC))
+	s.addParticipant(trip.ID, "ana@example.com")
+	s.addParticipant(trip.ID, "bia@example.com", func(p *pgstore.Participant) {
+		p.DeletedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	})
+	s.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+	_, h := newTestAPI(s)
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/activities", "2"},
+		{"/participants", "1"},
+		{"/links", "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			w := do(t, h, http.MethodHead, "/trips/"+trip.ID.String()+tt.path, nil)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want 200", w.Code)
+			}
+			if got := w.Header().Get(totalCountHeader); got != tt.want {
+				t.Errorf("%s = %q, want %q", totalCountHeader, got, tt.want)
+			}
+			if w.Body.Len() != 0 {
+				t.Errorf("body = %q, want none", w.Body)
+			}
+		})
+	}
+
+	w := do(t, h, http.MethodHead, "/trips/"+uuid.NewString()+"/activities", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status for a missing trip = %d, want 400", w.Code)
+	}
+}
+
+func TestInviteExpiration(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	api, h := newTestAPI(s)
+	api.inviteTTL = 48 * time.Hour
+
+	before := time.Now().UTC()
+	w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", jsonBody(t, map[string]string{"email": "ana@example.com"}))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", w.Code, w.Body)
+	}
+
+	participants := s.tripParticipants(trip.ID)
+	if len(participants) != 1 {
+		t.Fatalf("got %d participants, want 1", len(participants))
+	}
+	expiresAt := participants[0].InviteExpiresAt.Time
+	if expiresAt.Before(before.Add(48*time.Hour)) || expiresAt.After(time.Now().UTC().Add(48*time.Hour)) {
+		t.Errorf("invite expires at %s, want 48h from now", expiresAt)
+	}
+
+	expired := s.addParticipant(trip.ID, "bia@example.com", func(p *pgstore.Participant) {
+		p.InviteExpiresAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(-time.Minute)}
+	})
+	w = do(t, h, http.MethodPatch, "/participants/"+expired.ID.String()+"/confirm", nil)
+	if w.Code != http.StatusGone {
+		t.Fatalf("status confirming an expired invite = %d, want 410: %s", w.Code, w.Body)
+	}
+
+	reissue := "/participants/" + expired.ID.String() + "/reissue-invite"
+	for _, email := range []string{"", expired.Email} {
+		if w := do(t, h, http.MethodPost, reissue, nil, requesterEmailHeader, email); w.Code != http.StatusForbidden {
+			t.Errorf("status reissuing the invite as %q = %d, want 403", email, w.Code)
+		}
+	}
+
+	w = do(t, h, http.MethodPost, reissue, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status reissuing the invite = %d, want 204: %s", w.Code, w.Body)
+	}
+	if got := s.participant(expired.ID).InviteExpiresAt.Time; got.Before(before.Add(48 * time.Hour)) {
+		t.Errorf("reissued invite expires at %s, want 48h from now", got)
+	}
+
+	w = do(t, h, http.MethodPatch, "/participants/"+expired.ID.String()+"/confirm", nil)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status confirming the reissued invite = %d, want 204: %s", w.Code, w.Body)
+	}
+	if !s.participant(expired.ID).IsConfirmed {
+		t.Error("participant was not confirmed")
+	}
+}
+
+func TestGetTripsTripIDPrint(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	s.addActivity(trip.ID, "<script>alert(1)</script>", time.Date(2030, 6, 11, 9, 0, 0, 0, time.UTC))
+	s.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/print", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Errorf("Content-Type = %q, want text/html", got)
+	}
+
+	page := w.Body.String()
+	for _, want := range []string{"<h1>Florianópolis</h1>", "11/06/2030", "&lt;script&gt;", `href="https://example.com/hotel"`} {
+		if !strings.Contains(page, want) {
+			t.Errorf("page is missing %q", want)
+		}
+	}
+	if strings.Contains(page, "<script>") {
+		t.Error("activity title was not escaped")
+	}
+}
+
+func TestPostTripsImportValidate(t *testing.T) {
+	_, h := newTestAPI(newFakeStore())
+
+	valid := `{
+		"trip": {"destination": "Salvador", "owner_email": "owner@example.com", "owner_name": "Dono",
+			"starts_at": "2030-06-10T00:00:00Z", "ends_at": "2030-06-15T00:00:00Z"},
+		"participants": [{"email": "ana@example.com"}, {"email": "bia@example.com"}],
+		"activities": [{"id": "2b1d3c52-4d6f-4d0e-9f5f-0d7f1b1a6c11", "title": "Pelourinho", "occurs_at": "2030-06-11T09:00:00Z"}],
+		"links": [{"title": "Ingresso", "url": "https://example.com/ingresso", "activity_id": "2b1d3c52-4d6f-4d0e-9f5f-0d7f1b1a6c11"}]
+	}`
+	w := do(t, h, http.MethodPost, "/trips/import/validate", strings.NewReader(valid))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var res spec.ValidateTripBundleResponse
+	decode(t, w, &res)
+	if !res.Valid || len(res.Issues) != 0 {
+		t.Errorf("valid bundle reported issues: %v", res.Issues)
+	}
+	if res.Counts.Participants != 2 || res.Counts.Activities != 1 || res.Counts.Links != 1 {
+		t.Errorf("counts = %+v, want 2 participants, 1 activity and 1 link", res.Counts)
+	}
+
+	invalid := `{
+		"trip": {"destination": "Rio", "owner_email": "dono", "owner_name": "Dono",
+			"starts_at": "2030-06-15T00:00:00Z", "ends_at": "2030-06-10T00:00:00Z"},
+		"participants": [{"email": "ana@example.com"}, {"email": "ana@example.com"}],
+		"activities": [{"title": "", "occurs_at": "2030-06-11T09:00:00Z"}],
+		"links": [{"title": "Ingresso", "url": "ftp://example.com", "activity_id": "2b1d3c52-4d6f-4d0e-9f5f-0d7f1b1a6c11"}]
+	}`
+	w = do(t, h, http.MethodPost, "/trips/import/validate", strings.NewReader(invalid))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	res = spec.ValidateTripBundleResponse{}
+	decode(t, w, &res)
+	want := []string{
+		"trip: destination must have at least 4 characters",
+		"trip: invalid owner_email",
+		"trip: ends_at is before starts_at",
+		"participants[1]: duplicated email ana@example.com",
+		"activities[0]: missing title",
+		"activities[0]: activity must occur during the trip",
+		"links[0]: invalid url",
+		"links[0]: unknown activity_id",
+	}
+	if res.Valid || strings.Join(res.Issues, "\n") != strings.Join(want, "\n") {
+		t.Errorf("issues = %q, want %q", res.Issues, want)
+	}
+}
+
+func TestPatchParticipantsParticipantIDOrganizer(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	api, h := newTestAPI(s)
+	target := "/participants/" + ana.ID.String() + "/organizer"
+
+	w := do(t, h, http.MethodPatch, target, strings.NewReader(`{"is_organizer": true}`), requesterEmailHeader, bia.Email)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status for a participant = %d, want 403: %s", w.Code, w.Body)
+	}
+
+	w = do(t, h, http.MethodPatch, target, strings.NewReader(`{"is_organizer": true}`), requesterEmailHeader, "Owner@Example.com")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status for the owner = %d, want 204: %s", w.Code, w.Body)
+	}
+	if !s.participant(ana.ID).IsOrganizer {
+		t.Fatal("participant was not made an organizer")
+	}
+
+	canEdit, err := api.canEditTrip(context.Background(), trip.ID, ana.Email)
+	if err != nil || !canEdit {
+		t.Errorf("canEditTrip for the organizer = %v, %v, want true", canEdit, err)
+	}
+
+	w = do(t, h, http.MethodPatch, target, strings.NewReader(`{"is_organizer": false}`), requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status demoting = %d, want 204: %s", w.Code, w.Body)
+	}
+	if s.participant(ana.ID).IsOrganizer {
+		t.Error("participant is still an organizer")
+	}
+}
+
+func TestPackingItems(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	otherTrip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	stranger := s.addParticipant(otherTrip.ID, "bia@example.com")
+	_, h := newTestAPI(s)
+	items := "/trips/" + trip.ID.String() + "/packing-items"
+
+	w := do(t, h, http.MethodPost, items, jsonBody(t, map[string]string{"text": "Protetor solar", "assigned_to": stranger.ID.String()}))
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status assigning a participant of another trip = %d, want 400: %s", w.Code, w.Body)
+	}
+
+	w = do(t, h, http.MethodPost, items, jsonBody(t, map[string]string{"text": "Protetor solar", "assigned_to": ana.ID.String()}))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", w.Code, w.Body)
+	}
+	var created spec.CreatePackingItemResponse
+	decode(t, w, &created)
+	item := items + "/" + created.PackingItemID
+
+	for _, email := range []string{"", ana.Email} {
+		if w := do(t, h, http.MethodPatch, item+"/toggle", nil, requesterEmailHeader, email); w.Code != http.StatusForbidden {
+			t.Errorf("status toggling as %q = %d, want 403", email, w.Code)
+		}
+		if w := do(t, h, http.MethodPut, item, jsonBody(t, map[string]string{"text": "Chinelo"}), requesterEmailHeader, email); w.Code != http.StatusForbidden {
+			t.Errorf("status updating as %q = %d, want 403", email, w.Code)
+		}
+		if w := do(t, h, http.MethodDelete, item, nil, requesterEmailHeader, email); w.Code != http.StatusForbidden {
+			t.Errorf("status deleting as %q = %d, want 403", email, w.Code)
+		}
+	}
+
+	if w := do(t, h, http.MethodPatch, item+"/toggle", nil, requesterEmailHeader, trip.OwnerEmail); w.Code != http.StatusNoContent {
+		t.Fatalf("status toggling = %d, want 204: %s", w.Code, w.Body)
+	}
+	if w := do(t, h, http.MethodPut, item, jsonBody(t, map[string]string{"text": "Protetor solar FPS 50"}), requesterEmailHeader, trip.OwnerEmail); w.Code != http.StatusNoContent {
+		t.Fatalf("status updating = %d, want 204: %s", w.Code, w.Body)
+	}
+
+	w = do(t, h, http.MethodGet, items, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status listing = %d, want 200: %s", w.Code, w.Body)
+	}
+	var list spec.GetTripPackingItemsResponse
+	decode(t, w, &list)
+	if len(list.PackingItems) != 1 {
+		t.Fatalf("got %d items, want 1", len(list.PackingItems))
+	}
+	got := list.PackingItems[0]
+	if got.Text != "Protetor solar FPS 50" || !got.IsPacked || got.AssignedTo != nil {
+		t.Errorf("item = %+v, want packed, renamed and unassigned", got)
+	}
+
+	otherItem := "/trips/" + otherTrip.ID.String() + "/packing-items/" + created.PackingItemID
+	if w := do(t, h, http.MethodDelete, otherItem, nil, requesterEmailHeader, otherTrip.OwnerEmail); w.Code != http.StatusBadRequest {
+		t.Errorf("status deleting through another trip = %d, want 400", w.Code)
+	}
+	if w := do(t, h, http.MethodDelete, item, nil, requesterEmailHeader, trip.OwnerEmail); w.Code != http.StatusNoContent {
+		t.Fatalf("status deleting = %d, want 204: %s", w.Code, w.Body)
+	}
+	if w := do(t, h, http.MethodDelete, item, nil, requesterEmailHeader, trip.OwnerEmail); w.Code != http.StatusBadRequest {
+		t.Errorf("status deleting twice = %d, want 400", w.Code)
+	}
+}
+
+func TestGetTripsTripIDDaysDate(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 9, 0, 0, 0, time.UTC))
+	dinner := s.addActivity(trip.ID, "Jantar", time.Date(2030, 6, 11, 23, 59, 0, 0, time.UTC))
+	s.addActivity(trip.ID, "Café", time.Date(2030, 6, 12, 0, 0, 0, 0, time.UTC))
+	s.addActivity(trip.ID, "Voo", time.Date(2030, 6, 10, 23, 0, 0, 0, time.UTC))
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	s.assignments = append(s.assignments, pgstore.ActivityParticipant{ActivityID: boat.ID, ParticipantID: ana.ID})
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/days/2030-06-11", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var day spec.GetTripDayResponse
+	decode(t, w, &day)
+	if day.Date.String() != "2030-06-11" {
+		t.Errorf("date = %s, want 2030-06-11", day.Date)
+	}
+	if len(day.Activities) != 2 || day.Activities[0].ID != boat.ID.String() || day.Activities[1].ID != dinner.ID.String() {
+		t.Fatalf("activities = %+v, want the boat trip and the dinner", day.Activities)
+	}
+	if got := day.Activities[0]; got.DayNumber != 2 || len(got.Participants) != 1 || got.Participants[0].ID != ana.ID.String() {
+		t.Errorf("boat trip = %+v, want day 2 with ana", got)
+	}
+	if day.Links == nil {
+		t.Error("links = null, want an empty list")
+	}
+
+	for _, date := range []string{"2030-06-09", "2030-06-16", "amanha"} {
+		w = do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/days/"+date, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("status for %s = %d, want 400", date, w.Code)
+		}
+	}
+}
+
+func TestParticipantGroups(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	caio := s.addParticipant(trip.ID, "caio@example.com")
+	duda := s.addParticipant(trip.ID, "duda@example.com")
+	_, h := newTestAPI(s)
+
+	setGroup := func(id uuid.UUID, name string) {
+		t.Helper()
+		w := do(t, h, http.MethodPatch, "/participants/"+id.String()+"/group", jsonBody(t, map[string]string{"group_name": name}), requesterEmailHeader, trip.OwnerEmail)
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("status setting the group = %d, want 204: %s", w.Code, w.Body)
+		}
+	}
+	setGroup(ana.ID, " Silva ")
+	setGroup(bia.ID, "Silva")
+	setGroup(caio.ID, "Costa")
+	setGroup(duda.ID, "Costa")
+	// A blank name takes the participant out of their group.
+	setGroup(duda.ID, "  ")
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/participants/grouped", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var body spec.GetTripParticipantGroupsResponse
+	decode(t, w, &body)
+
+	want := []struct {
+		name   string
+		emails []string
+	}{
+		{"Costa", []string{"caio@example.com"}},
+		{"Silva", []string{"ana@example.com", "bia@example.com"}},
+		{"", []string{"duda@example.com"}},
+	}
+	if len(body.Groups) != len(want) {
+		t.Fatalf("got %d groups, want %d: %+v", len(body.Groups), len(want), body.Groups)
+	}
+	for i, group := range body.Groups {
+		name := ""
+		if group.GroupName != nil {
+			name = *group.GroupName
+		}
+		var emails []string
+		for _, p := range group.Participants {
+			emails = append(emails, string(p.Email))
+		}
+		if name != want[i].name || strings.Join(emails, ",") != strings.Join(want[i].emails, ",") {
+			t.Errorf("group %d = %q %v, want %q %v", i, name, emails, want[i].name, want[i].emails)
+		}
+	}
+
+	w = do(t, h, http.MethodPatch, "/participants/"+ana.ID.String()+"/group", jsonBody(t, map[string]string{"group_name": "Costa"}), requesterEmailHeader, ana.Email)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status setting the group as a participant = %d, want 403", w.Code)
+	}
+	if got := s.participant(ana.ID).GroupName.String; got != "Silva" {
+		t.Errorf("group = %q, want Silva", got)
+	}
+
+	w = do(t, h, http.MethodPatch, "/participants/"+uuid.NewString()+"/group", jsonBody(t, map[string]string{"group_name": "Silva"}))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status for a missing participant = %d, want 400", w.Code)
+	}
+}
+
+func TestPostParticipantsParticipantIDUnavailabilities(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	_, h := newTestAPI(s)
+	target := "/participants/" + ana.ID.String() + "/unavailabilities"
+	body := map[string]string{"starts_at": "2030-06-11T00:00:00Z", "ends_at": "2030-06-12T23:59:59Z"}
+
+	for _, email := range []string{"", bia.Email} {
+		if w := do(t, h, http.MethodPost, target, jsonBody(t, body), requesterEmailHeader, email); w.Code != http.StatusForbidden {
+			t.Errorf("status as %q = %d, want 403", email, w.Code)
+		}
+	}
+	if len(s.unavailable) != 0 {
+		t.Fatalf("got %d unavailabilities after forbidden requests, want 0", len(s.unavailable))
+	}
+
+	for _, email := range []string{ana.Email, trip.OwnerEmail} {
+		if w := do(t, h, http.MethodPost, target, jsonBody(t, body), requesterEmailHeader, email); w.Code != http.StatusCreated {
+			t.Errorf("status as %q = %d, want 201: %s", email, w.Code, w.Body)
+		}
+	}
+	if len(s.unavailable) != 2 {
+		t.Errorf("got %d unavailabilities, want 2", len(s.unavailable))
+	}
+}
+
+func TestGetTripsTripIDConfirmConcurrently(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	api, h := newTestAPI(s)
+
+	const confirms = 10
+	codes := make([]int, confirms)
+	var wg sync.WaitGroup
+	for i := range codes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i] = do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/confirm", nil).Code
+		}(i)
+	}
+	wg.Wait()
+
+	var confirmed int
+	for _, code := range codes {
+		switch code {
+		case http.StatusNoContent:
+			confirmed++
+		case http.StatusBadRequest:
+		default:
+			t.Errorf("status = %d, want 204 or 400", code)
+		}
+	}
+	if confirmed != 1 {
+		t.Errorf("%d confirmations went through, want exactly 1", confirmed)
+	}
+
+	hooks := api.webhooks.(*fakeWebhooks)
+	hooks.mu.Lock()
+	defer hooks.mu.Unlock()
+	if len(hooks.events) != 1 || hooks.events[0] != webhook.EventTripConfirmed {
+		t.Errorf("events = %v, want one %s", hooks.events, webhook.EventTripConfirmed)
+	}
+}
+
+func TestPostTripsTripIDParticipantsImportCsv(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	s.addParticipant(trip.ID, "ana@example.com")
+	_, h := newTestAPI(s)
+
+	csv := strings.Join([]string{
+		"email,name",
+		"bia@example.com, Bia",
+		"não é um email,Caio",
+		"ANA@example.com,Ana",
+		"duda@example.com",
+		"bia@example.com,Bia de novo",
+		"edu@example.com,Edu,extra",
+	}, "\n")
+	w := upload(t, h, "/trips/"+trip.ID.String()+"/participants/import-csv", "participants.csv", []byte(csv))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", w.Code, w.Body)
+	}
+	var body spec.ImportParticipantsResponse
+	decode(t, w, &body)
+
+	want := []struct {
+		row    int
+		status spec.ImportParticipantsResponseArrayStatus
+	}{
+		{2, spec.ImportParticipantsResponseArrayStatusCreated},
+		{3, spec.ImportParticipantsResponseArrayStatusError},
+		{4, spec.ImportParticipantsResponseArrayStatusSkipped},
+		{5, spec.ImportParticipantsResponseArrayStatusCreated},
+		{6, spec.ImportParticipantsResponseArrayStatusSkipped},
+		{7, spec.ImportParticipantsResponseArrayStatusError},
+	}
+	if len(body.Rows) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(body.Rows), len(want), body.Rows)
+	}
+	for i, row := range body.Rows {
+		if row.Row != want[i].row || row.Status != want[i].status {
+			t.Errorf("row %d = %d %s, want %d %s", i, row.Row, row.Status, want[i].row, want[i].status)
+		}
+		if (row.Status == spec.ImportParticipantsResponseArrayStatusCreated) != (row.ParticipantID != nil) {
+			t.Errorf("row %d participant_id = %v with status %s", row.Row, row.ParticipantID, row.Status)
+		}
+		if row.Status != spec.ImportParticipantsResponseArrayStatusCreated && row.Reason == nil {
+			t.Errorf("row %d has no reason", row.Row)
+		}
+	}
+
+	participants := s.tripParticipants(trip.ID)
+	if len(participants) != 3 {
+		t.Fatalf("got %d participants, want 3", len(participants))
+	}
+	if bia := participants[1]; bia.Email != "bia@example.com" || bia.Name.String != "Bia" {
+		t.Errorf("participant = %s %q, want bia@example.com named Bia", bia.Email, bia.Name.String)
+	}
+}
+
+func TestPostTripsTripIDParticipantsImportCsvRacingInvite(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	s.addParticipant(trip.ID, "caio@example.com")
+	api, h := newTestAPI(s)
+	api.store = &staleParticipantsStore{fakeStore: s, racing: "caio@example.com"}
+
+	csv := "email,name\ncaio@example.com,Caio\nbia@example.com,Bia\n"
+	w := upload(t, h, "/trips/"+trip.ID.String()+"/participants/import-csv", "participants.csv", []byte(csv))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want 201: %s", w.Code, w.Body)
+	}
+	var body spec.ImportParticipantsResponse
+	decode(t, w, &body)
+
+	if len(body.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2: %+v", len(body.Rows), body.Rows)
+	}
+	if caio := body.Rows[0]; caio.Status != spec.ImportParticipantsResponseArrayStatusSkipped || caio.ParticipantID != nil {
+		t.Errorf("caio = %s %v, want skipped", caio.Status, caio.ParticipantID)
+	}
+	if bia := body.Rows[1]; bia.Status != spec.ImportParticipantsResponseArrayStatusCreated || bia.ParticipantID == nil {
+		t.Errorf("bia = %s %v, want created", bia.Status, bia.ParticipantID)
+	}
+	if participants := s.tripParticipants(trip.ID); len(participants) != 2 {
+		t.Errorf("got %d participants, want 2", len(participants))
+	}
+}
+
+func TestGetAdminStatsTripsPerDay(t *testing.T) {
+	s := newFakeStore()
+	createdAt := func(day, hour int) func(*pgstore.Trip) {
+		return func(trip *pgstore.Trip) {
+			trip.CreatedAt = pgtype.Timestamp{Valid: true, Time: time.Date(2030, 5, day, hour, 0, 0, 0, time.UTC)}
+		}
+	}
+	s.addTrip(createdAt(1, 9))
+	s.addTrip(createdAt(2, 8))
+	s.addTrip(createdAt(2, 23))
+	s.addTrip(createdAt(4, 12))
+	s.addTrip(createdAt(5, 0))
+	api, h := newTestAPI(s)
+	api.adminToken = "segredo"
+
+	target := "/admin/stats/trips-per-day?from=2030-05-02&to=2030-05-04"
+	w := do(t, h, http.MethodGet, target, nil, adminTokenHeader, "segredo")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var body spec.GetTripsPerDayResponse
+	decode(t, w, &body)
+
+	want := []struct {
+		day   string
+		trips int
+	}{
+		{"2030-05-02", 2},
+		{"2030-05-04", 1},
+	}
+	if len(body.Days) != len(want) {
+		t.Fatalf("days = %+v, want %+v", body.Days, want)
+	}
+	for i, day := range body.Days {
+		if day.Day.String() != want[i].day || day.Trips != want[i].trips {
+			t.Errorf("day %d = %s %d, want %s %d", i, day.Day, day.Trips, want[i].day, want[i].trips)
+		}
+	}
+
+	w = do(t, h, http.MethodGet, target, nil, adminTokenHeader, "errado")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status with a wrong token = %d, want 403", w.Code)
+	}
+
+	w = do(t, h, http.MethodGet, "/admin/stats/trips-per-day?from=2030-05-04&to=2030-05-02", nil, adminTokenHeader, "segredo")
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status for a reversed range = %d, want 400", w.Code)
+	}
+
+	for _, target := range []string{"/admin/stats/trips-per-day?to=2030-05-04", "/admin/stats/trips-per-day?from=2030-05-02"} {
+		w = do(t, h, http.MethodGet, target, nil, adminTokenHeader, "segredo")
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", target, w.Code)
+		}
+	}
+}
+
+func TestGetTripsTripIDCard(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip(func(trip *pgstore.Trip) { trip.IsConfirmed = true })
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/card", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var card map[string]any
+	decode(t, w, &card)
+
+	want := map[string]any{
+		"destination": "Florianópolis",
+		"starts_at":   "2030-06-10T00:00:00Z",
+		"ends_at":     "2030-06-15T00:00:00Z",
+		"status":      "confirmed",
+	}
+	if len(card) != len(want) {
+		t.Errorf("card = %v, want only %v", card, want)
+	}
+	for field, value := range want {
+		if card[field] != value {
+			t.Errorf("%s = %v, want %v", field, card[field], value)
+		}
+	}
+}
+
+func TestGetActivitiesOnDate(t *testing.T) {
+	s := newFakeStore()
+	beach := s.addTrip()
+	city := s.addTrip(func(trip *pgstore.Trip) { trip.Destination = "São Paulo" })
+	other := s.addTrip(func(trip *pgstore.Trip) { trip.OwnerEmail = "someone@example.com" })
+	museum := s.addActivity(city.ID, "Museu", time.Date(2030, 6, 11, 14, 0, 0, 0, time.UTC))
+	boat := s.addActivity(beach.ID, "Passeio de barco", time.Date(2030, 6, 11, 9, 0, 0, 0, time.UTC))
+	s.addActivity(beach.ID, "Jantar", time.Date(2030, 6, 12, 20, 0, 0, 0, time.UTC))
+	s.addActivity(other.ID, "Show", time.Date(2030, 6, 11, 21, 0, 0, 0, time.UTC))
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/activities/on/2030-06-11?owner_email=owner@example.com", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var body spec.GetActivitiesOnDateResponse
+	decode(t, w, &body)
+
+	want := []struct {
+		id          uuid.UUID
+		destination string
+	}{
+		{boat.ID, "Florianópolis"},
+		{museum.ID, "São Paulo"},
+	}
+	if len(body.Activities) != len(want) {
+		t.Fatalf("activities = %+v, want %d", body.Activities, len(want))
+	}
+	for i, activity := range body.Activities {
+		if activity.ID != want[i].id.String() || activity.Destination != want[i].destination {
+			t.Errorf("activity %d = %s in %s, want %s in %s", i, activity.ID, activity.Destination, want[i].id, want[i].destination)
+		}
+	}
+
+	w = do(t, h, http.MethodGet, "/activities/on/amanha?owner_email=owner@example.com", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status for an invalid date = %d, want 400", w.Code)
+	}
+}
+
+func TestPostTripsTripIDLinksCap(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	api, h := newTestAPI(s)
+	api.maxLinks = 2
+
+	post := func(title string) *httptest.ResponseRecorder {
+		body := jsonBody(t, map[string]string{"title": title, "url": "https://example.com/" + title})
+		return do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/links", body)
+	}
+	for _, title := range []string{"hotel", "voo"} {
+		if w := post(title); w.Code != http.StatusCreated {
+			t.Fatalf("status of link %s = %d, want 201: %s", title, w.Code, w.Body)
+		}
+	}
+
+	w := post("passeio")
+	if w.Code != http.StatusConflict {
+		t.Fatalf("status past the cap = %d, want 409: %s", w.Code, w.Body)
+	}
+	if got := len(s.tripLinks(trip.ID)); got != 2 {
+		t.Errorf("trip has %d links, want 2", got)
+	}
+
+	// Activities created with a link count against the same cap.
+	postActivity := func(title string) *httptest.ResponseRecorder {
+		body := jsonBody(t, map[string]any{
+			"title":     title,
+			"occurs_at": trip.StartsAt.Time.Add(time.Hour),
+			"link":      map[string]string{"title": title, "url": "https://example.com/" + title},
+		})
+		return do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body)
+	}
+	w = postActivity("museu")
+	if w.Code != http.StatusConflict {
+		t.Fatalf("status of an activity with a link past the cap = %d, want 409: %s", w.Code, w.Body)
+	}
+	if got := len(s.tripActivities(trip.ID)); got != 0 {
+		t.Errorf("trip has %d activities, want none", got)
+	}
+
+	api.maxLinks = 3
+	if w := postActivity("museu"); w.Code != http.StatusCreated {
+		t.Fatalf("status of an activity with a link under the cap = %d, want 201: %s", w.Code, w.Body)
+	}
+	if got := len(s.tripLinks(trip.ID)); got != 3 {
+		t.Errorf("trip has %d links, want 3", got)
+	}
+}
+
+func TestGetTripsByMonth(t *testing.T) {
+	s := newFakeStore()
+	startsOn := func(month time.Month, day int) func(*pgstore.Trip) {
+		return func(trip *pgstore.Trip) {
+			trip.StartsAt = pgtype.Timestamp{Valid: true, Time: time.Date(2030, month, day, 0, 0, 0, 0, time.UTC)}
+			trip.EndsAt = pgtype.Timestamp{Valid: true, Time: trip.StartsAt.Time.AddDate(0, 0, 3)}
+		}
+	}
+	late := s.addTrip(startsOn(time.June, 28))
+	early := s.addTrip(startsOn(time.June, 2))
+	july := s.addTrip(startsOn(time.July, 1))
+	s.addTrip(startsOn(time.June, 15), func(trip *pgstore.Trip) { trip.OwnerEmail = "someone@example.com" })
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/trips/by-month?owner_email=owner@example.com", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var body spec.GetTripsByMonthResponse
+	decode(t, w, &body)
+
+	want := []struct {
+		month string
+		trips []uuid.UUID
+	}{
+		{"2030-06", []uuid.UUID{early.ID, late.ID}},
+		{"2030-07", []uuid.UUID{july.ID}},
+	}
+	if len(body.Months) != len(want) {
+		t.Fatalf("months = %+v, want %d", body.Months, len(want))
+	}
+	for i, month := range body.Months {
+		var ids []string
+		for _, trip := range month.Trips {
+			ids = append(ids, trip.ID)
+		}
+		var wantIDs []string
+		for _, id := range want[i].trips {
+			wantIDs = append(wantIDs, id.String())
+		}
+		if month.Month != want[i].month || strings.Join(ids, ",") != strings.Join(wantIDs, ",") {
+			t.Errorf("month %d = %s %v, want %s %v", i, month.Month, ids, want[i].month, wantIDs)
+		}
+	}
+}
+
+func TestGetTripsTripIDParticipantsAnalytics(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	invitedAt := time.Date(2030, 6, 1, 10, 0, 0, 0, time.UTC)
+	confirmed := s.addParticipant(trip.ID, "ana@example.com", func(p *pgstore.Participant) {
+		p.CreatedAt = pgtype.Timestamp{Valid: true, Time: invitedAt}
+		p.IsConfirmed = true
+		p.ConfirmedAt = pgtype.Timestamp{Valid: true, Time: invitedAt.Add(30*time.Hour + 30*time.Minute)}
+	})
+	s.addParticipant(trip.ID, "bia@example.com", func(p *pgstore.Participant) {
+		p.CreatedAt = pgtype.Timestamp{Valid: true, Time: invitedAt}
+	})
+	_, h := newTestAPI(s)
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/participants/analytics", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+	}
+	var body spec.GetTripParticipantsAnalyticsResponse
+	decode(t, w, &body)
+
+	if len(body.Participants) != 2 {
+		t.Fatalf("got %d participants, want 2", len(body.Participants))
+	}
+	ana, bia := body.Participants[0], body.Participants[1]
+	if ana.ID != confirmed.ID.String() || ana.AcceptanceHours == nil || *ana.AcceptanceHours != 30.5 {
+		t.Errorf("confirmed participant = %+v, want 30.5 acceptance hours", ana)
+	}
+	if !ana.InvitedAt.Equal(invitedAt) || ana.ConfirmedAt == nil {
+		t.Errorf("confirmed participant invited at %s, confirmed at %v", ana.InvitedAt, ana.ConfirmedAt)
+	}
+	if bia.AcceptanceHours != nil || bia.ConfirmedAt != nil {
+		t.Errorf("unconfirmed participant = %+v, want no acceptance hours", bia)
+	}
+}
+
+func TestDefaultSort(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	s.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+	s.addLink(trip.ID, "Aluguel de carro", "https://example.com/carro")
+	s.addLink(trip.ID, "Voo", "https://example.com/voo")
+	api, h := newTestAPI(s)
+	api.linksSort = "-title"
+
+	titles := func(query string) []string {
+		t.Helper()
+		w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/links"+query, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want 200: %s", w.Code, w.Body)
+		}
+		var body spec.GetLinksResponse
+		decode(t, w, &body)
+		var titles []string
+		for _, link := range body.Links {
+			titles = append(titles, link.Title)
+		}
+		return titles
+	}
+
+	if got := strings.Join(titles(""), ","); got != "Voo,Hotel,Aluguel de carro" {
+		t.Errorf("links without a sort = %s, want the configured -title order", got)
+	}
+	if got := strings.Join(titles("?sort=title"), ","); got != "Aluguel de carro,Hotel,Voo" {
+		t.Errorf("links sorted by title = %s", got)
+	}
+}
+
+func TestDeleteAndRestoreParticipant(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	old := s.addParticipant(trip.ID, "caio@example.com", func(p *pgstore.Participant) {
+		p.DeletedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(-100 * time.Hour)}
+	})
+	_, h := newTestAPI(s)
+	owner := []string{requesterEmailHeader, "owner@example.com"}
+	participantURL := func(id uuid.UUID) string {
+		return "/trips/" + trip.ID.String() + "/participants/" + id.String()
+	}
+
+	w := do(t, h, http.MethodDelete, participantURL(ana.ID), nil, requesterEmailHeader, "bia@example.com")
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("status removing as a participant = %d, want 403: %s", w.Code, w.Body)
+	}
+
+	for _, id := range []uuid.UUID{ana.ID, bia.ID} {
+		w = do(t, h, http.MethodDelete, participantURL(id), nil, owner...)
+		if w.Code != http.StatusNoContent {
+			t.Fatalf("status removing = %d, want 204: %s", w.Code, w.Body)
+		}
+	}
+	if got := s.tripParticipants(trip.ID); len(got) != 0 {
+		t.Fatalf("participants after removing = %+v, want none", got)
+	}
+	w = do(t, h, http.MethodDelete, participantURL(ana.ID), nil, owner...)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status removing twice = %d, want 404", w.Code)
+	}
+
+	w = do(t, h, http.MethodPost, participantURL(ana.ID)+"/restore", nil, owner...)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status restoring = %d, want 204: %s", w.Code, w.Body)
+	}
+	if got := s.participant(ana.ID); got.DeletedAt.Valid {
+		t.Error("restored participant is still removed")
+	}
+
+	// Bia was invited again since, so the old invite cannot come back.
+	s.addParticipant(trip.ID, "bia@example.com")
+	w = do(t, h, http.MethodPost, participantURL(bia.ID)+"/restore", nil, owner...)
+	if w.Code != http.StatusConflict {
+		t.Errorf("status restoring a reinvited participant = %d, want 409: %s", w.Code, w.Body)
+	}
+
+	w = do(t, h, http.MethodPost, participantURL(old.ID)+"/restore", nil, owner...)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status restoring past the window = %d, want 400: %s", w.Code, w.Body)
+	}
+}
+
+func TestGetTripsTripIDGaps(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	at := func(day, hour, minute int) time.Time { return time.Date(2030, 6, day, hour, minute, 0, 0, time.UTC) }
+	post := func(title string, occursAt time.Time, durationMinutes int) {
+		t.Helper()
+		body := map[string]any{"title": title, "occurs_at": occursAt}
+		if durationMinutes > 0 {
+			body["duration_minutes"] = durationMinutes
+		}
+		w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", jsonBody(t, body))
+		if w.Code != http.StatusCreated {
+			t.Fatalf("creating %s: status = %d, want %d: %s", title, w.Code, http.StatusCreated, w.Body)
+		}
+	}
+
+	post("Café", at(11, 9, 0), 60)
+	post("Passeio de barco", at(11, 10, 30), 120)
+	// Within the boat trip, so it leaves no gap.
+	post("Foto", at(11, 11, 0), 0)
+	post("Almoço", at(11, 14, 0), 90)
+	post("Museu", at(12, 9, 0), 0)
+
+	w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities",
+		jsonBody(t, map[string]any{"title": "Praia", "occurs_at": at(12, 10, 0), "duration_minutes": 0}))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("zero duration: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	w = do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/gaps", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got struct {
+		Days []struct {
+			Date string                        `json:"date"`
+			Gaps []spec.GetTripGapsResponseGap `json:"gaps"`
+		} `json:"days"`
+	}
+	decode(t, w, &got)
+
+	if len(got.Days) != 2 || got.Days[0].Date != "2030-06-11" || got.Days[1].Date != "2030-06-12" {
+		t.Fatalf("days = %+v, want 2030-06-11 and 2030-06-12", got.Days)
+	}
+	want := []spec.GetTripGapsResponseGap{
+		{StartsAt: at(11, 10, 0), EndsAt: at(11, 10, 30), Minutes: 30},
+		{StartsAt: at(11, 12, 30), EndsAt: at(11, 14, 0), Minutes: 90},
+	}
+	gaps := got.Days[0].Gaps
+	if len(gaps) != len(want) {
+		t.Fatalf("gaps = %+v, want %+v", gaps, want)
+	}
+	for i := range want {
+		if !gaps[i].StartsAt.Equal(want[i].StartsAt) || !gaps[i].EndsAt.Equal(want[i].EndsAt) || gaps[i].Minutes != want[i].Minutes {
+			t.Errorf("gap %d = %+v, want %+v", i, gaps[i], want[i])
+		}
+	}
+	if len(got.Days[1].Gaps) != 0 {
+		t.Errorf("a day with one activity has gaps %+v", got.Days[1].Gaps)
+	}
+
+	w = do(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/gaps", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("missing trip: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetTripsTripIDConfirmationEmail(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip(func(trip *pgstore.Trip) {
+		trip.OwnerName = "Ana"
+		trip.Destination = "Ilha <do Mel>"
+	})
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	target := "/trips/" + trip.ID.String() + "/confirmation-email"
+
+	w := do(t, h, http.MethodGet, target, nil, requesterEmailHeader, bia.Email)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("participant: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w = do(t, h, http.MethodGet, target, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got spec.GetTripConfirmationEmailResponse
+	decode(t, w, &got)
+
+	if string(got.To) != trip.OwnerEmail || got.Subject != "Confirme sua viagem" {
+		t.Errorf("to = %q, subject = %q, want %q and %q", got.To, got.Subject, trip.OwnerEmail, "Confirme sua viagem")
+	}
+	for _, want := range []string{"Olá, Ana!", "Ilha <do Mel>", "2030-06-10"} {
+		if !strings.Contains(got.TextBody, want) {
+			t.Errorf("text body %q does not contain %q", got.TextBody, want)
+		}
+	}
+	if !strings.Contains(got.HTMLBody, "Ilha &lt;do Mel&gt;") || strings.Contains(got.HTMLBody, "<do Mel>") {
+		t.Errorf("html body does not escape the destination: %s", got.HTMLBody)
+	}
+}
+
+func TestParticipantNames(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	for _, invite := range []map[string]string{
+		{"email": "ana@example.com", "name": "  Ana Souza "},
+		{"email": "bia@example.com", "name": "   "},
+		{"email": "caio@example.com"},
+	} {
+		w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", jsonBody(t, invite))
+		if w.Code != http.StatusCreated {
+			t.Fatalf("inviting %s: status = %d, want %d: %s", invite["email"], w.Code, http.StatusCreated, w.Body)
+		}
+	}
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/participants", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got spec.GetTripParticipantsResponse
+	decode(t, w, &got)
+
+	want := map[string]string{"ana@example.com": "Ana Souza"}
+	if len(got.Participants) != 3 {
+		t.Fatalf("participants = %+v, want 3", got.Participants)
+	}
+	for _, p := range got.Participants {
+		name, named := want[string(p.Email)]
+		switch {
+		case named && (p.Name == nil || *p.Name != name):
+			t.Errorf("%s name = %v, want %q", p.Email, p.Name, name)
+		case !named && p.Name != nil:
+			t.Errorf("%s name = %q, want null", p.Email, *p.Name)
+		}
+	}
+}
+
+func TestGetTripsTripIDActivitiesFullcalendar(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+	boat.DurationMinutes = pgtype.Int4{Valid: true, Int32: 90}
+	s.activities[boat.ID] = boat
+	dinner := s.addActivity(trip.ID, "Jantar", time.Date(2030, 6, 11, 20, 0, 0, 0, time.UTC))
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/fullcalendar", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	// Decoded loosely, to check the field names FullCalendar reads.
+	var got []map[string]any
+	decode(t, w, &got)
+
+	want := []map[string]any{
+		{"id": boat.ID.String(), "title": "Passeio de barco", "start": "2030-06-11T10:00:00Z", "end": "2030-06-11T11:30:00Z"},
+		{"id": dinner.ID.String(), "title": "Jantar", "start": "2030-06-11T20:00:00Z"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	for i := range want {
+		if len(got[i]) != len(want[i]) {
+			t.Errorf("event %d = %v, want %v", i, got[i], want[i])
+			continue
+		}
+		for field, value := range want[i] {
+			if got[i][field] != value {
+				t.Errorf("event %d %s = %v, want %v", i, field, got[i][field], value)
+			}
+		}
+	}
+}
+
+func TestPostTripsTripIDFinalize(t *testing.T) {
+	s := newFakeStore()
+	api, h := newTestAPI(s)
+	trip := s.addTrip()
+	s.addParticipant(trip.ID, "ana@example.com")
+	s.addParticipant(trip.ID, "bia@example.com", func(p *pgstore.Participant) { p.IsConfirmed = true })
+	caio := s.addParticipant(trip.ID, "caio@example.com")
+	target := "/trips/" + trip.ID.String() + "/finalize"
+
+	w := do(t, h, http.MethodPost, target, nil, requesterEmailHeader, caio.Email)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("participant: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w = do(t, h, http.MethodPost, target, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if !s.trips[trip.ID].IsConfirmed {
+		t.Error("trip is not confirmed")
+	}
+	for _, p := range s.tripParticipants(trip.ID) {
+		if !p.IsConfirmed {
+			t.Errorf("%s is not confirmed", p.Email)
+		}
+	}
+
+	w = do(t, h, http.MethodPost, target, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("finalizing again: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	mailer := api.mailer.(*fakeMailer)
+	mailer.wait(t, 1)
+	if want := "SendTripFinalizedEmails " + trip.ID.String(); len(mailer.calls) != 1 || mailer.calls[0] != want {
+		t.Errorf("emails sent %q, want only %q", mailer.calls, want)
+	}
+	if events := api.webhooks.(*fakeWebhooks).events; len(events) != 1 || events[0] != webhook.EventTripConfirmed {
+		t.Errorf("events = %q, want only %q", events, webhook.EventTripConfirmed)
+	}
+}
+
+func TestDeleteTripsTripIDActivitiesActivityID(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	tripA := s.addTrip()
+	tripB := s.addTrip()
+	boat := s.addActivity(tripB.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+	target := func(tripID uuid.UUID) string { return "/trips/" + tripID.String() + "/activities/" + boat.ID.String() }
+
+	w := do(t, h, http.MethodDelete, target(tripA.ID), nil, requesterEmailHeader, tripA.OwnerEmail)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("activity of another trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if _, ok := s.activities[boat.ID]; !ok {
+		t.Fatal("activity of another trip was deleted")
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, "someone@example.com")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("someone else: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, tripB.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if len(s.tripActivities(tripB.ID)) != 0 {
+		t.Error("activity was not deleted")
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, tripB.OwnerEmail)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("deleting again: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestPostTripsTripIDSnapshotsSnapshotIDRestore(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	saved := trip
+	saved.Destination = "Salvador"
+	snapshot := s.addSnapshot(t, trip.ID, pgstore.TripSnapshotData{Trip: saved})
+	target := "/trips/" + trip.ID.String() + "/snapshots/" + snapshot.ID.String() + "/restore"
+
+	for _, email := range []string{"", ana.Email, "someone@example.com"} {
+		w := do(t, h, http.MethodPost, target, nil, requesterEmailHeader, email)
+		if w.Code != http.StatusForbidden {
+			t.Errorf("as %q: status = %d, want %d: %s", email, w.Code, http.StatusForbidden, w.Body)
+		}
+	}
+	if got := s.trips[trip.ID].Destination; got != trip.Destination {
+		t.Fatalf("destination after forbidden restores = %q, want %q", got, trip.Destination)
+	}
+
+	w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/snapshots", nil, requesterEmailHeader, ana.Email)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("snapshotting as a participant: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w = do(t, h, http.MethodPost, target, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if got := s.trips[trip.ID].Destination; got != saved.Destination {
+		t.Errorf("destination = %q, want %q", got, saved.Destination)
+	}
+}
+
+func TestGetTripsTripIDSnapshotsDiff(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	otherTrip := s.addTrip()
+
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+	hotel := s.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+	from := s.addSnapshot(t, trip.ID, pgstore.TripSnapshotData{
+		Trip:         trip,
+		Participants: []pgstore.Participant{ana, bia},
+		Activities:   []pgstore.Activity{boat},
+		Links:        []pgstore.Link{hotel},
+	})
+
+	dinner := s.addActivity(trip.ID, "Jantar", time.Date(2030, 6, 11, 20, 0, 0, 0, time.UTC))
+	renamedBoat := boat
+	renamedBoat.Title = "Passeio de escuna"
+	confirmedAna := ana
+	confirmedAna.IsConfirmed = true
+	to := s.addSnapshot(t, trip.ID, pgstore.TripSnapshotData{
+		Trip:         trip,
+		Participants: []pgstore.Participant{confirmedAna},
+		Activities:   []pgstore.Activity{renamedBoat, dinner},
+		Links:        []pgstore.Link{hotel},
+	})
+	elsewhere := s.addSnapshot(t, otherTrip.ID, pgstore.TripSnapshotData{Trip: otherTrip})
+
+	diff := func(from, to uuid.UUID) *httptest.ResponseRecorder {
+		return do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/snapshots/diff?from="+from.String()+"&to="+to.String(), nil)
+	}
+
+	w := diff(from.ID, to.ID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got spec.GetSnapshotDiffResponse
+	decode(t, w, &got)
+
+	activities := got.Activities
+	if len(activities.Added) != 1 || activities.Added[0].ID != dinner.ID.String() || activities.Added[0].Name != "Jantar" {
+		t.Errorf("added activities = %+v, want %s", activities.Added, dinner.ID)
+	}
+	if len(activities.Removed) != 0 {
+		t.Errorf("removed activities = %+v, want none", activities.Removed)
+	}
+	if len(activities.Changed) != 1 || len(activities.Changed[0].Changes) != 1 {
+		t.Fatalf("changed activities = %+v, want the title of %s", activities.Changed, boat.ID)
+	}
+	change := activities.Changed[0].Changes[0]
+	if change.Field != "title" || change.From == nil || *change.From != "Passeio de barco" || change.To == nil || *change.To != "Passeio de escuna" {
+		t.Errorf("activity change = %+v, want the title renamed", change)
+	}
+
+	participants := got.Participants
+	if len(participants.Removed) != 1 || participants.Removed[0].Name != bia.Email {
+		t.Errorf("removed participants = %+v, want %s", participants.Removed, bia.Email)
+	}
+	if len(participants.Changed) != 1 || participants.Changed[0].Changes[0].Field != "is_confirmed" {
+		t.Errorf("changed participants = %+v, want %s confirmed", participants.Changed, ana.Email)
+	}
+
+	links := got.Links
+	if len(links.Added)+len(links.Removed)+len(links.Changed) != 0 {
+		t.Errorf("links diff = %+v, want no changes", links)
+	}
+
+	for name, snapshotID := range map[string]uuid.UUID{"snapshot of another trip": elsewhere.ID, "missing snapshot": uuid.New()} {
+		if w := diff(from.ID, snapshotID); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", name, w.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestActivitiesOutsideTheTrip(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		occursAt time.Time
+		want     int
+	}{
+		{"trip start", trip.StartsAt.Time, http.StatusCreated},
+		{"trip end", trip.EndsAt.Time, http.StatusCreated},
+		{"before the trip", trip.StartsAt.Time.Add(-time.Minute), http.StatusUnprocessableEntity},
+		{"after the trip", trip.EndsAt.Time.Add(time.Minute), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := map[string]any{"title": "Atividade " + tt.name, "occurs_at": tt.occursAt}
+			w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", jsonBody(t, body))
+			if w.Code != tt.want {
+				t.Errorf("creating: status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+
+			// Updates answer 204 instead of 201.
+			want := tt.want
+			if want == http.StatusCreated {
+				want = http.StatusNoContent
+			}
+			body["title"] = boat.Title
+			w = do(t, h, http.MethodPut, "/trips/"+trip.ID.String()+"/activities/"+boat.ID.String(), jsonBody(t, body),
+				requesterEmailHeader, trip.OwnerEmail)
+			if w.Code != want {
+				t.Errorf("updating: status = %d, want %d: %s", w.Code, want, w.Body)
+			}
+		})
+	}
+	if got := len(s.tripActivities(trip.ID)); got != 3 {
+		t.Errorf("trip has %d activities, want 3", got)
+	}
+}
+
+func TestGetOwnersEmailExport(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com")
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+	hotel := s.addLink(trip.ID, "Hotel", "https://example.com/hotel")
+	otherTrip := s.addTrip(func(trip *pgstore.Trip) { trip.Destination = "Salvador" })
+	s.addTrip(func(trip *pgstore.Trip) { trip.OwnerEmail = "someone@example.com" })
+	api, h := newTestAPI(s)
+	api.adminToken = "segredo"
+	target := "/owners/owner@example.com/export"
+
+	if w := do(t, h, http.MethodGet, target, nil, requesterEmailHeader, "someone@example.com"); w.Code != http.StatusForbidden {
+		t.Errorf("someone else: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if w := do(t, h, http.MethodGet, target, nil, adminTokenHeader, "segredo"); w.Code != http.StatusOK {
+		t.Errorf("admin: status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w := do(t, h, http.MethodGet, target, nil, requesterEmailHeader, "Owner@Example.com")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/zip" {
+		t.Errorf("Content-Type = %q, want application/zip", ct)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	exported := make(map[string]pgstore.TripSnapshotData)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("failed to open %s: %v", f.Name, err)
+		}
+		var data pgstore.TripSnapshotData
+		if err := json.NewDecoder(rc).Decode(&data); err != nil {
+			t.Errorf("%s does not parse: %v", f.Name, err)
+		}
+		_ = rc.Close()
+		exported[f.Name] = data
+	}
+
+	if len(exported) != 2 {
+		t.Fatalf("archive has %d entries, want one per trip of the owner", len(exported))
+	}
+	data, ok := exported["trip-"+trip.ID.String()+".json"]
+	if !ok {
+		t.Fatalf("archive has no entry for trip %s", trip.ID)
+	}
+	if data.Trip.ID != trip.ID ||
+		len(data.Participants) != 1 || data.Participants[0].ID != ana.ID ||
+		len(data.Activities) != 1 || data.Activities[0].ID != boat.ID ||
+		len(data.Links) != 1 || data.Links[0].ID != hotel.ID {
+		t.Errorf("trip %s exported as %+v, want it with its participant, activity and link", trip.ID, data)
+	}
+	if data := exported["trip-"+otherTrip.ID.String()+".json"]; data.Trip.Destination != "Salvador" {
+		t.Errorf("trip %s exported as %+v", otherTrip.ID, data.Trip)
+	}
+}
+
+func TestDeleteOwnersEmail(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	otherTrip := s.addTrip(func(trip *pgstore.Trip) { trip.OwnerEmail = "ana@example.com" })
+	asParticipant := s.addParticipant(otherTrip.ID, "owner@example.com")
+	api, h := newTestAPI(s)
+	api.adminToken = "segredo"
+
+	if w := do(t, h, http.MethodDelete, "/owners/owner@example.com", nil, requesterEmailHeader, trip.OwnerEmail); w.Code != http.StatusForbidden {
+		t.Errorf("owner without the admin token: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if _, ok := s.trips[trip.ID]; !ok {
+		t.Fatal("trip erased without the admin token")
+	}
+
+	w := do(t, h, http.MethodDelete, "/owners/owner@example.com", nil, adminTokenHeader, "segredo")
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if _, ok := s.trips[trip.ID]; ok {
+		t.Error("owner trip was not erased")
+	}
+	if _, ok := s.trips[otherTrip.ID]; !ok {
+		t.Error("trip of someone else was erased")
+	}
+	if email := s.participants[asParticipant.ID].Email; email == asParticipant.Email {
+		t.Errorf("participant email = %q, want it anonymized", email)
+	}
+}
+
+func TestPostTripsMinLeadTime(t *testing.T) {
+	s := newFakeStore()
+	api, h := newTestAPI(s)
+	api.minLeadTime = 24 * time.Hour
+
+	create := func(startsIn time.Duration) *httptest.ResponseRecorder {
+		startsAt := time.Now().UTC().Add(startsIn)
+		return do(t, h, http.MethodPost, "/trips", jsonBody(t, map[string]any{
+			"destination":      "Florianópolis",
+			"owner_email":      "owner@example.com",
+			"owner_name":       "Dono",
+			"emails_to_invite": []string{"ana@example.com"},
+			"starts_at":        startsAt,
+			"ends_at":          startsAt.Add(72 * time.Hour),
+		}))
+	}
+
+	if w := create(23 * time.Hour); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("starting inside the lead time: status = %d, want %d", w.Code, http.StatusUnprocessableEntity)
+	}
+	if len(s.trips) != 0 {
+		t.Fatal("trip starting too soon was created")
+	}
+	if w := create(25 * time.Hour); w.Code != http.StatusCreated {
+		t.Errorf("starting after the lead time: status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body)
+	}
+
+	api.minLeadTime = 0
+	if w := create(time.Hour); w.Code != http.StatusCreated {
+		t.Errorf("without a lead time: status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body)
+	}
+}
+
+func TestPostTripsTripIDActivitiesLinkURL(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	for _, url := range []string{"javascript:alert(1)", "ftp://example.com/ingresso", "/ingresso"} {
+		body := jsonBody(t, map[string]any{
+			"title":     "Show",
+			"occurs_at": trip.StartsAt.Time.Add(time.Hour),
+			"link":      map[string]string{"title": "Ingresso", "url": url},
+		})
+		w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", body)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d: %s", url, w.Code, http.StatusBadRequest, w.Body)
+			continue
+		}
+		var got spec.Error
+		decode(t, w, &got)
+		if got.Message != "invalid URL" {
+			t.Errorf("%s: message = %q, want %q", url, got.Message, "invalid URL")
+		}
+	}
+	if len(s.tripActivities(trip.ID)) != 0 || len(s.tripLinks(trip.ID)) != 0 {
+		t.Error("activity with an invalid link was created")
+	}
+}
+
+func TestActivityParticipants(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	otherTrip := s.addTrip()
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	ana := s.addParticipant(trip.ID, "ana@example.com", func(p *pgstore.Participant) { p.Name = pgtype.Text{Valid: true, String: "Ana"} })
+	stranger := s.addParticipant(otherTrip.ID, "caio@example.com")
+	boat := s.addActivity(trip.ID, "Passeio de barco", time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+	activity := "/trips/" + trip.ID.String() + "/activities/" + boat.ID.String()
+
+	assign := func(method string, participantID uuid.UUID) int {
+		return do(t, h, method, activity+"/participants/"+participantID.String(), nil, requesterEmailHeader, trip.OwnerEmail).Code
+	}
+	for _, p := range []pgstore.Participant{bia, ana, ana} {
+		if code := assign(http.MethodPut, p.ID); code != http.StatusNoContent {
+			t.Errorf("assigning %s: status = %d, want %d", p.Email, code, http.StatusNoContent)
+		}
+	}
+	if code := assign(http.MethodPut, stranger.ID); code != http.StatusNotFound {
+		t.Errorf("assigning a participant of another trip: status = %d, want %d", code, http.StatusNotFound)
+	}
+
+	assigned := func() []spec.ActivityParticipant {
+		t.Helper()
+
+		w := do(t, h, http.MethodGet, activity, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+		}
+		var got spec.GetTripActivitiesResponseInnerArray
+		decode(t, w, &got)
+		return got.Participants
+	}
+
+	got := assigned()
+	if len(got) != 2 || got[0].ID != ana.ID.String() || got[1].ID != bia.ID.String() {
+		t.Fatalf("participants = %+v, want %s and %s", got, ana.Email, bia.Email)
+	}
+	if got[0].Name == nil || *got[0].Name != "Ana" || string(got[0].Email) != ana.Email || got[1].Name != nil {
+		t.Errorf("participants = %+v, want their emails and names", got)
+	}
+
+	if code := assign(http.MethodDelete, bia.ID); code != http.StatusNoContent {
+		t.Errorf("unassigning: status = %d, want %d", code, http.StatusNoContent)
+	}
+	if code := assign(http.MethodDelete, bia.ID); code != http.StatusNotFound {
+		t.Errorf("unassigning again: status = %d, want %d", code, http.StatusNotFound)
+	}
+	if got := assigned(); len(got) != 1 || got[0].ID != ana.ID.String() {
+		t.Errorf("participants after unassigning = %+v, want only %s", got, ana.Email)
+	}
+}
+
+func TestPostTripsTripIDInvitesSendsEmail(t *testing.T) {
+	s := newFakeStore()
+	api, h := newTestAPI(s)
+	mailer := api.mailer.(*fakeMailer)
+	// A failed email is only logged.
+	mailer.err = errors.New("smtp down")
+	trip := s.addTrip()
+
+	w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/invites", jsonBody(t, map[string]string{"email": "ana@example.com"}))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body)
+	}
+
+	participants := s.tripParticipants(trip.ID)
+	if len(participants) != 1 {
+		t.Fatalf("participants = %+v, want the one invited", participants)
+	}
+	mailer.wait(t, 1)
+	if want := "SendInviteEmailToParticipant " + participants[0].ID.String(); mailer.calls[0] != want {
+		t.Errorf("emails sent %q, want %q", mailer.calls, want)
+	}
+}
+
+func TestGetTripsTripIDBusiestDay(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	target := "/trips/" + trip.ID.String() + "/busiest-day"
+
+	if w := do(t, h, http.MethodGet, target, nil); w.Code != http.StatusNoContent {
+		t.Errorf("trip without activities: status = %d, want %d", w.Code, http.StatusNoContent)
+	}
+
+	for i, day := range []int{13, 11, 12, 13, 12} {
+		s.addActivity(trip.ID, fmt.Sprintf("Atividade %d", i), time.Date(2030, 6, day, 9+i, 0, 0, 0, time.UTC))
+	}
+
+	w := do(t, h, http.MethodGet, target, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got struct {
+		Date       string `json:"date"`
+		Activities int    `json:"activities"`
+	}
+	decode(t, w, &got)
+	if got.Date != "2030-06-12" || got.Activities != 2 {
+		t.Errorf("busiest day = %s with %d activities, want 2030-06-12 with 2", got.Date, got.Activities)
+	}
+}
+
+func TestDeleteTripsTripIDLinksLinkID(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	tripA := s.addTrip()
+	tripB := s.addTrip()
+	hotel := s.addLink(tripB.ID, "Hotel", "https://example.com/hotel")
+	target := func(tripID uuid.UUID) string { return "/trips/" + tripID.String() + "/links/" + hotel.ID.String() }
+
+	w := do(t, h, http.MethodDelete, target(tripA.ID), nil, requesterEmailHeader, tripA.OwnerEmail)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("link of another trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+	if _, ok := s.links[hotel.ID]; !ok {
+		t.Fatal("link of another trip was deleted")
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, "someone@example.com")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("someone else: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, tripB.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+	if len(s.tripLinks(tripB.ID)) != 0 {
+		t.Error("link was not deleted")
+	}
+
+	w = do(t, h, http.MethodDelete, target(tripB.ID), nil, requesterEmailHeader, tripB.OwnerEmail)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("deleting again: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestGetAdminEagerParticipants(t *testing.T) {
+	s := newFakeStore()
+	trip := s.addTrip()
+	ana := s.addParticipant(trip.ID, "ana@example.com", func(p *pgstore.Participant) {
+		p.IsConfirmed = true
+		p.Name = pgtype.Text{Valid: true, String: "Ana"}
+	})
+	s.addParticipant(trip.ID, "bia@example.com")
+	confirmedTrip := s.addTrip(func(trip *pgstore.Trip) { trip.IsConfirmed = true })
+	s.addParticipant(confirmedTrip.ID, "caio@example.com", func(p *pgstore.Participant) { p.IsConfirmed = true })
+	api, h := newTestAPI(s)
+	api.adminToken = "segredo"
+
+	if w := do(t, h, http.MethodGet, "/admin/eager-participants", nil, adminTokenHeader, "errado"); w.Code != http.StatusForbidden {
+		t.Errorf("wrong token: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	w := do(t, h, http.MethodGet, "/admin/eager-participants", nil, adminTokenHeader, "segredo")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got spec.GetEagerParticipantsResponse
+	decode(t, w, &got)
+
+	want := spec.GetEagerParticipantsResponseArray{
+		ID:          ana.ID.String(),
+		Email:       types.Email(ana.Email),
+		TripID:      trip.ID.String(),
+		Destination: trip.Destination,
+	}
+	if len(got.Participants) != 1 {
+		t.Fatalf("participants = %+v, want only %s", got.Participants, ana.Email)
+	}
+	p := got.Participants[0]
+	if p.ID != want.ID || p.Email != want.Email || p.TripID != want.TripID || p.Destination != want.Destination || p.Name == nil || *p.Name != "Ana" {
+		t.Errorf("participant = %+v, want %+v named Ana", p, want)
+	}
+}
+
+func TestActivityDayNumber(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	// Starting in the afternoon, so day 2 begins well before 24 hours in.
+	trip := s.addTrip(func(trip *pgstore.Trip) {
+		trip.StartsAt = pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 10, 14, 0, 0, 0, time.UTC)}
+		trip.EndsAt = pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 15, 23, 0, 0, 0, time.UTC)}
+	})
+
+	tests := []struct {
+		occursAt      time.Time
+		wantDay       int
+		wantTimeOfDay string
+	}{
+		{time.Date(2030, 6, 10, 20, 0, 0, 0, time.UTC), 1, "20:00"},
+		{time.Date(2030, 6, 11, 8, 30, 0, 0, time.UTC), 2, "08:30"},
+		{time.Date(2030, 6, 11, 23, 59, 0, 0, time.UTC), 2, "23:59"},
+		{time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC), 6, "00:00"},
+	}
+	for _, tt := range tests {
+		activity := s.addActivity(trip.ID, "Atividade", tt.occursAt)
+
+		w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/"+activity.ID.String(), nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+		}
+		var got spec.GetTripActivitiesResponseInnerArray
+		decode(t, w, &got)
+		if got.DayNumber != tt.wantDay || got.TimeOfDay != tt.wantTimeOfDay {
+			t.Errorf("activity at %s = day %d at %s, want day %d at %s", tt.occursAt, got.DayNumber, got.TimeOfDay, tt.wantDay, tt.wantTimeOfDay)
+		}
+	}
+}
+
+func TestGetTripsCombinedFilters(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+
+	day := func(d int) pgtype.Timestamp {
+		return pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, d, 0, 0, 0, 0, time.UTC)}
+	}
+	trip := func(destination string, starts, ends int, confirmed bool) pgstore.Trip {
+		return s.addTrip(func(trip *pgstore.Trip) {
+			trip.Destination = destination
+			trip.StartsAt = day(starts)
+			trip.EndsAt = day(ends)
+			trip.IsConfirmed = confirmed
+		})
+	}
+	match := trip("Praia do Rosa", 10, 15, true)
+	alsoMatch := trip("ROSARIO", 12, 20, true)
+	trip("Praia do Rosa", 10, 15, false)
+	trip("Florianópolis", 10, 15, true)
+	trip("Rosa Norte", 5, 15, true)
+	trip("Rosa", 10, 25, true)
+
+	w := do(t, h, http.MethodGet, "/trips?destination=rosa&starts_after=2030-06-08T00:00:00Z&ends_before=2030-06-20T00:00:00Z&is_confirmed=true", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got struct {
+		Trips []struct {
+			ID string `json:"id"`
+		} `json:"trips"`
+		Total int `json:"total"`
+	}
+	decode(t, w, &got)
+	if got.Total != 2 || len(got.Trips) != 2 || got.Trips[0].ID != match.ID.String() || got.Trips[1].ID != alsoMatch.ID.String() {
+		t.Errorf("got %+v, want %s then %s", got, match.ID, alsoMatch.ID)
+	}
+
+	// Without any filter every active trip is listed.
+	w = do(t, h, http.MethodGet, "/trips", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("without filters: status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	decode(t, w, &got)
+	if got.Total != 6 {
+		t.Errorf("without filters: total = %d, want 6", got.Total)
+	}
+
+	// Wildcards in the destination are matched literally.
+	trip("100% Rosa", 10, 15, true)
+	w = do(t, h, http.MethodGet, "/trips?destination=0%25+R", nil)
+	decode(t, w, &got)
+	if got.Total != 1 {
+		t.Errorf("destination with a wildcard: total = %d, want 1", got.Total)
+	}
+
+	w = do(t, h, http.MethodGet, "/trips?starts_after=2030-06-20T00:00:00Z&ends_before=2030-06-10T00:00:00Z", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("inverted date range: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGetTripsTripIDEmailStatus(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	target := "/trips/" + trip.ID.String() + "/email-status"
+
+	status := func() (got struct {
+		Status    string  `json:"status"`
+		Recipient *string `json:"recipient"`
+		Error     *string `json:"error"`
+	}) {
+		t.Helper()
+		w := do(t, h, http.MethodGet, target, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+		}
+		decode(t, w, &got)
+		return got
+	}
+
+	if got := status(); got.Status != "pending" || got.Recipient != nil {
+		t.Errorf("before the email is sent: got %+v, want pending", got)
+	}
+
+	s.logEmail(pgstore.EmailLog{TripID: trip.ID, Recipient: trip.OwnerEmail, Kind: "invite", Status: "failed"})
+	if got := status(); got.Status != "pending" {
+		t.Errorf("with only other emails logged: status = %s, want pending", got.Status)
+	}
+
+	s.logEmail(pgstore.EmailLog{
+		TripID:    trip.ID,
+		Recipient: trip.OwnerEmail,
+		Kind:      "confirm_trip",
+		Status:    "failed",
+		Error:     pgtype.Text{Valid: true, String: "smtp down"},
+	})
+	if got := status(); got.Status != "failed" || got.Error == nil || *got.Error != "smtp down" {
+		t.Errorf("after a failed attempt: got %+v, want failed with the error", got)
+	}
+
+	s.logEmail(pgstore.EmailLog{TripID: trip.ID, Recipient: trip.OwnerEmail, Kind: "confirm_trip", Status: "sent"})
+	got := status()
+	if got.Status != "sent" || got.Recipient == nil || *got.Recipient != trip.OwnerEmail || got.Error != nil {
+		t.Errorf("after the email is sent: got %+v, want sent to %s", got, trip.OwnerEmail)
+	}
+
+	if w := do(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/email-status", nil); w.Code != http.StatusNotFound {
+		t.Errorf("unknown trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestActivityTitleDeduplication(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	lunch := time.Date(2030, 6, 11, 12, 0, 0, 0, time.UTC)
+	s.addActivity(trip.ID, "Almoço", lunch)
+
+	tests := []struct {
+		title    string
+		occursAt time.Time
+		want     int
+	}{
+		{"almoço ", lunch, http.StatusConflict},
+		{"  ALMOÇO", lunch, http.StatusConflict},
+		{"Almoço na praia", lunch, http.StatusCreated},
+		{"almoço", lunch.Add(time.Hour), http.StatusCreated},
+	}
+	for _, tt := range tests {
+		body := map[string]any{"title": tt.title, "occurs_at": tt.occursAt}
+		w := do(t, h, http.MethodPost, "/trips/"+trip.ID.String()+"/activities", jsonBody(t, body))
+		if w.Code != tt.want {
+			t.Errorf("%q at %s: status = %d, want %d: %s", tt.title, tt.occursAt.Format(time.Kitchen), w.Code, tt.want, w.Body)
+		}
+	}
+
+	// Imports apply the same rule, within the file and against the trip.
+	ics := "BEGIN:VCALENDAR\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:Jantar\r\nDTSTART:20300612T200000Z\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:jantar \r\nDTSTART:20300612T200000Z\r\nEND:VEVENT\r\n" +
+		"BEGIN:VEVENT\r\nSUMMARY:ALMOÇO\r\nDTSTART:20300611T120000Z\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	w := upload(t, h, "/trips/"+trip.ID.String()+"/activities/import-ics", "trip.ics", []byte(ics))
+	if w.Code != http.StatusCreated {
+		t.Fatalf("import: status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body)
+	}
+	var got struct {
+		ActivityIDs []string `json:"activityIds"`
+		Rejected    []struct {
+			Title  string `json:"title"`
+			Reason string `json:"reason"`
+		} `json:"rejected"`
+	}
+	decode(t, w, &got)
+	if len(got.ActivityIDs) != 1 || len(got.Rejected) != 2 ||
+		got.Rejected[0].Reason != "duplicated activity" || got.Rejected[1].Reason != "duplicated activity" {
+		t.Errorf("import = %+v, want 1 imported and 2 duplicates", got)
+	}
+}
+
+func TestGetTripsTripIDDateWindows(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+
+	at := func(month time.Month, day, hour int) pgtype.Timestamp {
+		return pgtype.Timestamp{Valid: true, Time: time.Date(2030, month, day, hour, 0, 0, 0, time.UTC)}
+	}
+	tests := []struct {
+		name       string
+		start, end pgtype.Timestamp
+		want       []string
+	}{
+		{"multi-day trip", at(6, 10, 0), at(6, 15, 0), []string{"2030-06-10", "2030-06-11", "2030-06-12", "2030-06-13", "2030-06-14", "2030-06-15"}},
+		{"partial days", at(6, 10, 23), at(6, 12, 1), []string{"2030-06-10", "2030-06-11", "2030-06-12"}},
+		{"single day", at(6, 10, 8), at(6, 10, 20), []string{"2030-06-10"}},
+		{"across months", at(6, 30, 12), at(7, 1, 12), []string{"2030-06-30", "2030-07-01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trip := s.addTrip(func(trip *pgstore.Trip) {
+				trip.StartsAt = tt.start
+				trip.EndsAt = tt.end
+			})
+			w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/date-windows", nil)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+			}
+			var got struct {
+				Days []struct {
+					Date string `json:"date"`
+				} `json:"days"`
+			}
+			decode(t, w, &got)
+			dates := make([]string, len(got.Days))
+			for i, day := range got.Days {
+				dates[i] = day.Date
+			}
+			if !slices.Equal(dates, tt.want) {
+				t.Errorf("days = %v, want %v", dates, tt.want)
+			}
+		})
+	}
+
+	if w := do(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/date-windows", nil); w.Code != http.StatusNotFound {
+		t.Errorf("unknown trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestPostTripsTripIDRequestReconfirmation(t *testing.T) {
+	s := newFakeStore()
+	api, h := newTestAPI(s)
+	mailer := api.mailer.(*fakeMailer)
+	trip := s.addTrip()
+	other := s.addTrip()
+
+	confirmed := func(p *pgstore.Participant) {
+		p.IsConfirmed = true
+		p.ConfirmedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+		p.InviteExpiresAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(-time.Hour)}
+	}
+	ana := s.addParticipant(trip.ID, "ana@example.com", confirmed)
+	bia := s.addParticipant(trip.ID, "bia@example.com")
+	caio := s.addParticipant(other.ID, "caio@example.com", confirmed)
+	target := "/trips/" + trip.ID.String() + "/request-reconfirmation"
+
+	if w := do(t, h, http.MethodPost, target, nil, requesterEmailHeader, ana.Email); w.Code != http.StatusForbidden {
+		t.Errorf("as a participant: status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if !s.participant(ana.ID).IsConfirmed {
+		t.Fatal("a participant reset the confirmations")
+	}
+
+	w := do(t, h, http.MethodPost, target, nil, requesterEmailHeader, trip.OwnerEmail)
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+
+	for _, p := range []pgstore.Participant{ana, bia} {
+		got := s.participant(p.ID)
+		if got.IsConfirmed || got.ConfirmedAt.Valid {
+			t.Errorf("%s is still confirmed", p.Email)
+		}
+		if !got.InviteExpiresAt.Time.After(time.Now()) {
+			t.Errorf("%s invite expires at %s, want a fresh expiration", p.Email, got.InviteExpiresAt.Time)
+		}
+	}
+	if !s.participant(caio.ID).IsConfirmed {
+		t.Error("a participant of another trip was reset")
+	}
+
+	mailer.wait(t, 1)
+	if want := "ResendInviteEmailsToParticipants " + trip.ID.String(); len(mailer.calls) != 1 || mailer.calls[0] != want {
+		t.Errorf("emails sent %q, want %q", mailer.calls, want)
+	}
+}
+
+func TestGetTripsEstimatedTotal(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	s.addTrip()
+	s.addTrip(func(trip *pgstore.Trip) { trip.Destination = "Salvador" })
+	s.addTrip(func(trip *pgstore.Trip) { trip.Status = "archived" })
+
+	total := func(target string) (got struct {
+		Total      int  `json:"total"`
+		IsEstimate bool `json:"is_estimate"`
+	}) {
+		t.Helper()
+		w := do(t, h, http.MethodGet, target, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want %d: %s", target, w.Code, http.StatusOK, w.Body)
+		}
+		decode(t, w, &got)
+		return got
+	}
+
+	// Without statistics the exact count is used.
+	if got := total("/trips?exact=false"); got.Total != 2 || got.IsEstimate {
+		t.Errorf("without an estimate: got %+v, want an exact 2", got)
+	}
+
+	s.tripsEstimate = map[bool]int64{false: 1000, true: 40}
+	tests := []struct {
+		target     string
+		total      int
+		isEstimate bool
+	}{
+		{"/trips?exact=false", 1000, true},
+		{"/trips?exact=false&archived=true", 40, true},
+		{"/trips", 2, false},
+		{"/trips?exact=true", 2, false},
+		{"/trips?exact=false&destination=salvador", 1, false},
+	}
+	for _, tt := range tests {
+		if got := total(tt.target); got.Total != tt.total || got.IsEstimate != tt.isEstimate {
+			t.Errorf("%s: got %+v, want total %d, is_estimate %v", tt.target, got, tt.total, tt.isEstimate)
+		}
+	}
+}
+
+func TestGetParticipantsConfirmValidate(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	pending := s.addParticipant(trip.ID, "ana@example.com")
+	confirmed := s.addParticipant(trip.ID, "bia@example.com", func(p *pgstore.Participant) { p.IsConfirmed = true })
+	expired := s.addParticipant(trip.ID, "caio@example.com", func(p *pgstore.Participant) {
+		p.InviteExpiresAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC().Add(-time.Minute)}
+	})
+	removed := s.addParticipant(trip.ID, "duda@example.com", func(p *pgstore.Participant) {
+		p.DeletedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	})
+
+	tests := []struct {
+		name   string
+		token  string
+		valid  bool
+		reason string
+	}{
+		{"valid", pending.ID.String(), true, ""},
+		{"already confirmed", confirmed.ID.String(), false, "already_confirmed"},
+		{"expired", expired.ID.String(), false, "expired"},
+		{"removed", removed.ID.String(), false, "not_found"},
+		{"unknown", uuid.NewString(), false, "not_found"},
+		{"malformed", "not-a-token", false, "not_found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := do(t, h, http.MethodGet, "/participants/confirm/validate?token="+tt.token, nil)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+			}
+			var got struct {
+				Valid     bool       `json:"valid"`
+				Reason    string     `json:"reason"`
+				ExpiresAt *time.Time `json:"expires_at"`
+			}
+			decode(t, w, &got)
+			if got.Valid != tt.valid || got.Reason != tt.reason {
+				t.Errorf("got valid %v, reason %q, want %v, %q", got.Valid, got.Reason, tt.valid, tt.reason)
+			}
+			if tt.valid && (got.ExpiresAt == nil || !got.ExpiresAt.Equal(pending.InviteExpiresAt.Time)) {
+				t.Errorf("expires_at = %v, want %s", got.ExpiresAt, pending.InviteExpiresAt.Time)
+			}
+		})
+	}
+
+	if s.participant(pending.ID).IsConfirmed {
+		t.Error("validating the token confirmed the participant")
+	}
+	if w := do(t, h, http.MethodPatch, "/participants/"+pending.ID.String()+"/confirm", nil); w.Code != http.StatusNoContent {
+		t.Errorf("confirming after validating: status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+}
+
+func TestParsePagination(t *testing.T) {
+	ptr := func(v int) *int { return &v }
+	str := func(v string) *string { return &v }
+
+	tests := []struct {
+		name          string
+		sort          *string
+		limit, offset *int
+		want          pagination
+		err           error
+	}{
+		{"defaults", nil, nil, nil, pagination{limit: defaultPageLimit, sort: "starts_at"}, nil},
+		{"given", str("-destination"), ptr(10), ptr(30), pagination{limit: 10, offset: 30, sort: "-destination"}, nil},
+		{"max limit", nil, ptr(maxPageLimit), nil, pagination{limit: maxPageLimit, sort: "starts_at"}, nil},
+		{"limit over the cap", nil, ptr(maxPageLimit + 1), nil, pagination{}, errInvalidLimit},
+		{"zero limit", nil, ptr(0), nil, pagination{}, errInvalidLimit},
+		{"negative offset", nil, nil, ptr(-1), pagination{}, errInvalidOffset},
+		{"offset over int32", nil, nil, ptr(math.MaxInt32 + 1), pagination{}, errInvalidOffset},
+		{"unknown sort", str("owner_email"), nil, nil, pagination{}, errInvalidSort},
+		{"empty sort", str(""), nil, nil, pagination{}, errInvalidSort},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePagination(tt.sort, tt.limit, tt.offset, tripSorts, "starts_at")
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("pagination = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPaginationErrors(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/trips?limit=201", "invalid limit"},
+		{"/trips?offset=-1", "invalid offset"},
+		{"/trips?sort=owner_email", "invalid sort"},
+		{"/participants?email=ana@example.com&limit=0", "invalid limit"},
+		{"/trips/" + trip.ID.String() + "/activities?sort=destination", "invalid sort"},
+		{"/trips/" + trip.ID.String() + "/activities?limit=1000", "invalid limit"},
+	}
+	for _, tt := range tests {
+		w := do(t, h, http.MethodGet, tt.target, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", tt.target, w.Code, http.StatusBadRequest)
+			continue
+		}
+		var got spec.Error
+		decode(t, w, &got)
+		if got.Message != tt.want {
+			t.Errorf("%s: message = %q, want %q", tt.target, got.Message, tt.want)
+		}
+	}
+}
+
+func TestGetOwnersEmailConfirmationRate(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+
+	rate := func(email string) (got struct {
+		Invited   int     `json:"invited"`
+		Confirmed int     `json:"confirmed"`
+		Rate      float64 `json:"rate"`
+	}) {
+		t.Helper()
+		w := do(t, h, http.MethodGet, "/owners/"+email+"/confirmation-rate", nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+		}
+		decode(t, w, &got)
+		return got
+	}
+
+	if got := rate("owner@example.com"); got.Invited != 0 || got.Rate != 0 {
+		t.Errorf("without participants: got %+v, want a zero rate", got)
+	}
+
+	confirmed := func(p *pgstore.Participant) { p.IsConfirmed = true }
+	first := s.addTrip()
+	second := s.addTrip()
+	other := s.addTrip(func(trip *pgstore.Trip) { trip.OwnerEmail = "other@example.com" })
+	s.addParticipant(first.ID, "ana@example.com", confirmed)
+	s.addParticipant(first.ID, "bia@example.com", confirmed)
+	s.addParticipant(first.ID, "caio@example.com")
+	s.addParticipant(second.ID, "duda@example.com", confirmed)
+	s.addParticipant(second.ID, "edu@example.com", confirmed, func(p *pgstore.Participant) {
+		p.DeletedAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+	})
+	s.addParticipant(other.ID, "fabi@example.com")
+
+	got := rate("owner@example.com")
+	if got.Invited != 4 || got.Confirmed != 3 || got.Rate != 0.75 {
+		t.Errorf("got %+v, want 3 of 4 confirmed, a 0.75 rate", got)
+	}
+
+	if w := do(t, h, http.MethodGet, "/owners/not-an-email/confirmation-rate", nil); w.Code != http.StatusBadRequest {
+		t.Errorf("invalid email: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestPostTripsGeocodesDestination(t *testing.T) {
+	s := newFakeStore()
+	api, h := newTestAPI(s)
+	geocoder := &fakeGeocoder{
+		places: map[string]geocode.Place{
+			"Floripa": {Name: "Florianópolis, Santa Catarina, Brasil", Latitude: -27.5954, Longitude: -48.548},
+		},
+		geocoded: make(chan string, 1),
+	}
+	api.geocoder = geocoder
+
+	create := func(destination string) pgstore.Trip {
+		t.Helper()
+		startsAt := time.Now().UTC().Add(48 * time.Hour)
+		w := do(t, h, http.MethodPost, "/trips", jsonBody(t, map[string]any{
+			"destination":      destination,
+			"owner_email":      "owner@example.com",
+			"owner_name":       "Dono",
+			"emails_to_invite": []string{},
+			"starts_at":        startsAt,
+			"ends_at":          startsAt.Add(72 * time.Hour),
+		}))
+		if w.Code != http.StatusCreated {
+			t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusCreated, w.Body)
+		}
+		var got spec.CreateTripResponse
+		decode(t, w, &got)
+
+		select {
+		case got := <-geocoder.geocoded:
+			if got != destination {
+				t.Errorf("geocoded %q, want %q", got, destination)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("%q was not geocoded", destination)
+		}
+
+		// The place is stored right after the geocoder answers.
+		var trip pgstore.Trip
+		for deadline := time.Now().Add(100 * time.Millisecond); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			s.mu.Lock()
+			trip = s.trips[uuid.MustParse(got.TripID)]
+			s.mu.Unlock()
+			if trip.PlaceName.Valid {
+				break
+			}
+		}
+		return trip
+	}
+
+	trip := create("Floripa")
+	if !trip.PlaceName.Valid || trip.PlaceName.String != "Florianópolis, Santa Catarina, Brasil" ||
+		trip.Latitude.Float64 != -27.5954 || trip.Longitude.Float64 != -48.548 {
+		t.Errorf("trip place = %v (%v, %v), want Florianópolis at -27.5954, -48.548", trip.PlaceName, trip.Latitude, trip.Longitude)
+	}
+	if trip.Destination != "Floripa" {
+		t.Errorf("destination = %q, want it kept as given", trip.Destination)
+	}
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String(), nil)
+	var details struct {
+		Trip struct {
+			Place *struct {
+				Name      string  `json:"name"`
+				Latitude  float64 `json:"latitude"`
+				Longitude float64 `json:"longitude"`
+			} `json:"place"`
+		} `json:"trip"`
+	}
+	decode(t, w, &details)
+	if details.Trip.Place == nil || details.Trip.Place.Latitude != -27.5954 {
+		t.Errorf("trip details place = %+v, want the geocoded place", details.Trip.Place)
+	}
+
+	if trip := create("Lugar nenhum"); trip.PlaceName.Valid {
+		t.Errorf("unknown destination got place %q", trip.PlaceName.String)
+	}
+
+	geocoder.err = errors.New("geocoder down")
+	if trip := create("Floripa"); trip.PlaceName.Valid {
+		t.Errorf("failed geocoding got place %q", trip.PlaceName.String)
+	}
+}
+
+func TestGetTripsTripIDActivitiesRecent(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	other := s.addTrip()
+
+	created := func(tripID uuid.UUID, title string, at time.Time) pgstore.Activity {
+		a := s.addActivity(tripID, title, time.Date(2030, 6, 11, 10, 0, 0, 0, time.UTC))
+		s.mu.Lock()
+		a.CreatedAt = pgtype.Timestamp{Valid: true, Time: at}
+		s.activities[a.ID] = a
+		s.mu.Unlock()
+		return a
+	}
+	base := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+	created(trip.ID, "Praia", base.Add(-time.Hour))
+	atSince := created(trip.ID, "Museu", base)
+	boat := created(trip.ID, "Passeio de barco", base.Add(time.Minute))
+	dinner := created(trip.ID, "Jantar", base.Add(2*time.Hour))
+	created(other.ID, "Trilha", base.Add(3*time.Hour))
+
+	recent := func(since string) []string {
+		t.Helper()
+		w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/recent?since="+url.QueryEscape(since), nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("since %s: status = %d, want %d: %s", since, w.Code, http.StatusOK, w.Body)
+		}
+		var got struct {
+			Activities []struct {
+				ID string `json:"id"`
+			} `json:"activities"`
+		}
+		decode(t, w, &got)
+		ids := make([]string, len(got.Activities))
+		for i, a := range got.Activities {
+			ids[i] = a.ID
+		}
+		return ids
+	}
+
+	// Newest first, and only what was created after since.
+	want := []string{dinner.ID.String(), boat.ID.String()}
+	if got := recent(base.Format(time.RFC3339)); !slices.Equal(got, want) {
+		t.Errorf("since %s: got %v, want %v", base, got, want)
+	}
+	// The same instant in another time zone.
+	if got := recent(base.In(time.FixedZone("BRT", -3*60*60)).Format(time.RFC3339)); !slices.Equal(got, want) {
+		t.Errorf("since %s in BRT: got %v, want %v", base, got, want)
+	}
+	if got := recent(base.Add(-time.Second).Format(time.RFC3339)); len(got) != 3 || got[2] != atSince.ID.String() {
+		t.Errorf("since just before %s: got %v, want 3 activities ending with %s", atSince.Title, got, atSince.ID)
+	}
+	if got := recent(base.Add(24 * time.Hour).Format(time.RFC3339)); len(got) != 0 {
+		t.Errorf("since after everything: got %v, want none", got)
+	}
+
+	if w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/activities/recent", nil); w.Code != http.StatusBadRequest {
+		t.Errorf("without since: status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+	if w := do(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/activities/recent?since=2030-01-01T00:00:00Z", nil); w.Code != http.StatusNotFound {
+		t.Errorf("unknown trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestSemanticValidationStatus(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	participant := s.addParticipant(trip.ID, "ana@example.com")
+
+	startsAt := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Second)
+	endsBefore := startsAt.Add(-time.Hour)
+	jsonString := func(v any) string {
+		b, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(b)
+	}
+
+	tests := []struct {
+		name           string
+		method, target string
+		body           string
+		want           int
+	}{
+		{
+			"trip with reversed dates", http.MethodPost, "/trips",
+			jsonString(map[string]any{
+				"destination": "Florianópolis", "owner_email": "owner@example.com", "owner_name": "Dono",
+				"emails_to_invite": []string{}, "starts_at": startsAt, "ends_at": endsBefore,
+			}),
+			http.StatusUnprocessableEntity,
+		},
+		{"malformed trip", http.MethodPost, "/trips", `{"destination": "Florianópolis",`, http.StatusBadRequest},
+		{"trip missing fields", http.MethodPost, "/trips", `{"destination": "Florianópolis"}`, http.StatusBadRequest},
+		{
+			"trip update with reversed dates", http.MethodPut, "/trips/" + trip.ID.String(),
+			jsonString(map[string]any{"destination": "Florianópolis", "starts_at": startsAt, "ends_at": endsBefore}),
+			http.StatusUnprocessableEntity,
+		},
+		{"malformed trip update", http.MethodPut, "/trips/" + trip.ID.String(), `{"destination": 1}`, http.StatusBadRequest},
+		{
+			"unavailability with reversed dates", http.MethodPost, "/participants/" + participant.ID.String() + "/unavailabilities",
+			jsonString(map[string]any{"starts_at": startsAt, "ends_at": endsBefore}),
+			http.StatusUnprocessableEntity,
+		},
+		{"malformed unavailability", http.MethodPost, "/participants/" + participant.ID.String() + "/unavailabilities", `[`, http.StatusBadRequest},
+		{
+			"activities outside the trip", http.MethodPost, "/trips/" + trip.ID.String() + "/activities/batch",
+			jsonString([]map[string]any{{"title": "Voo", "occurs_at": trip.EndsAt.Time.AddDate(0, 0, 1)}}),
+			http.StatusUnprocessableEntity,
+		},
+		{"malformed activities", http.MethodPost, "/trips/" + trip.ID.String() + "/activities/batch", `{"title": "Voo"}`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := do(t, h, tt.method, tt.target, strings.NewReader(tt.body), requesterEmailHeader, trip.OwnerEmail)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
+
+func TestLinkDomain(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://www.airbnb.com/rooms/1", "www.airbnb.com"},
+		{"HTTPS://Booking.COM/hotel?x=1", "booking.com"},
+		{"http://localhost:8080/roteiro", "localhost"},
+		{"  https://maps.google.com  ", "maps.google.com"},
+		{"http://[::1]:3000/", "::1"},
+		{"not a url", ""},
+		{"http://%41", ""},
+	}
+	for _, tt := range tests {
+		if got := linkDomain(tt.url); got != tt.want {
+			t.Errorf("linkDomain(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestGetTripsTripIDLinksByDomain(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+	trip := s.addTrip()
+	other := s.addTrip()
+
+	s.addLink(trip.ID, "Hospedagem", "https://www.airbnb.com/rooms/1")
+	s.addLink(trip.ID, "Casa na praia", "https://WWW.AIRBNB.COM/rooms/2")
+	s.addLink(trip.ID, "Hotel", "https://booking.com/hotel")
+	s.addLink(trip.ID, "Rascunho", "not a url")
+	s.addLink(other.ID, "Passagens", "https://booking.com/flights")
+
+	w := do(t, h, http.MethodGet, "/trips/"+trip.ID.String()+"/links/by-domain", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got spec.GetLinksByDomainResponse
+	decode(t, w, &got)
+
+	want := []struct {
+		domain string
+		titles []string
+	}{
+		{"", []string{"Rascunho"}},
+		{"booking.com", []string{"Hotel"}},
+		{"www.airbnb.com", []string{"Casa na praia", "Hospedagem"}},
+	}
+	if len(got.Domains) != len(want) {
+		t.Fatalf("domains = %+v, want %+v", got.Domains, want)
+	}
+	for i, domain := range got.Domains {
+		titles := make([]string, len(domain.Links))
+		for j, link := range domain.Links {
+			titles[j] = link.Title
+		}
+		if domain.Domain != want[i].domain || !slices.Equal(titles, want[i].titles) {
+			t.Errorf("domain %d = %q %v, want %q %v", i, domain.Domain, titles, want[i].domain, want[i].titles)
+		}
+	}
+
+	if w := do(t, h, http.MethodGet, "/trips/"+uuid.NewString()+"/links/by-domain", nil); w.Code != http.StatusNotFound {
+		t.Errorf("unknown trip: status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestInvalidRequestsStopAtTheFirst400(t *testing.T) {
+	api, h := newTestAPI(newFakeStore())
+	api.store = unreachableStore{}
+	tripID := uuid.NewString()
+
+	tests := []struct {
+		method, target, body string
+	}{
+		{http.MethodGet, "/trips/not-a-uuid", ""},
+		{http.MethodPut, "/trips/not-a-uuid", `{"destination": "Florianópolis"}`},
+		{http.MethodPut, "/trips/" + tripID, `{"destination":`},
+		{http.MethodGet, "/trips/not-a-uuid/activities", ""},
+		{http.MethodPost, "/trips/not-a-uuid/activities", `{"title": "Praia"}`},
+		{http.MethodPost, "/trips/" + tripID + "/activities", `{"title":`},
+		{http.MethodGet, "/trips/not-a-uuid/confirm", ""},
+		{http.MethodGet, "/trips/not-a-uuid/participants", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.target, func(t *testing.T) {
+			defer func() {
+				if err := recover(); err != nil {
+					t.Errorf("the request reached the store: %v", err)
+				}
+			}()
+
+			w := do(t, h, tt.method, tt.target, strings.NewReader(tt.body))
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body)
+			}
+
+			// A single error is written.
+			dec := json.NewDecoder(w.Body)
+			var got spec.Error
+			if err := dec.Decode(&got); err != nil || got.Message == "" {
+				t.Errorf("body is not an error (%v): %+v", err, got)
+			}
+			if dec.More() {
+				t.Errorf("more than one response was written: %s", w.Body)
+			}
+		})
+	}
+}
+
+func TestArchivedTrips(t *testing.T) {
+	s := newFakeStore()
+	_, h := newTestAPI(s)
+
+	archived := func(trip *pgstore.Trip) { trip.Status = "archived" }
+	active := s.addTrip(func(trip *pgstore.Trip) { trip.Destination = "Salvador" })
+	old := s.addTrip(archived, func(trip *pgstore.Trip) {
+		trip.Destination = "Recife"
+		trip.StartsAt = pgtype.Timestamp{Valid: true, Time: time.Date(2029, 1, 10, 0, 0, 0, 0, time.UTC)}
+		trip.EndsAt = pgtype.Timestamp{Valid: true, Time: time.Date(2029, 1, 15, 0, 0, 0, 0, time.UTC)}
+	})
+	s.addParticipant(active.ID, "ana@example.com")
+	s.addParticipant(old.ID, "ana@example.com")
+
+	listed := func(target string) []string {
+		t.Helper()
+		w := do(t, h, http.MethodGet, target, nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d, want %d: %s", target, w.Code, http.StatusOK, w.Body)
+		}
+		var got struct {
+			Trips []struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"trips"`
+			Total int `json:"total"`
+		}
+		decode(t, w, &got)
+		if got.Total != len(got.Trips) {
+			t.Errorf("%s: total = %d, want %d", target, got.Total, len(got.Trips))
+		}
+		var ids []string
+		for _, trip := range got.Trips {
+			ids = append(ids, trip.ID+" "+trip.Status)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{"/trips", []string{active.ID.String() + " active"}},
+		{"/trips?archived=true", []string{old.ID.String() + " archived"}},
+		{"/participants?email=ana@example.com", []string{active.ID.String() + " active"}},
+		{"/participants?email=ana@example.com&archived=true", []string{old.ID.String() + " archived"}},
+	}
+	for _, tt := range tests {
+		if got := listed(tt.target); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: trips = %v, want %v", tt.target, got, tt.want)
+		}
+	}
+
+	// Archived trips stay reachable by id.
+	w := do(t, h, http.MethodGet, "/trips/"+old.ID.String(), nil)
+	if w.Code != http.StatusOK {
+		t.Errorf("archived trip: status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	w = do(t, h, http.MethodGet, "/trips/by-month?owner_email=owner@example.com", nil)
+	var byMonth spec.GetTripsByMonthResponse
+	decode(t, w, &byMonth)
+	if len(byMonth.Months) != 1 || len(byMonth.Months[0].Trips) != 1 || byMonth.Months[0].Trips[0].ID != active.ID.String() {
+		t.Errorf("trips by month = %+v, want only %s", byMonth.Months, active.ID)
+	}
+
+	w = do(t, h, http.MethodGet, "/owners/owner@example.com/destinations", nil)
+	var destinations spec.GetOwnerDestinationsResponse
+	decode(t, w, &destinations)
+	if !slices.Equal(destinations.Destinations, []string{"Salvador"}) {
+		t.Errorf("owner destinations = %q, want only Salvador", destinations.Destinations)
+	}
+}
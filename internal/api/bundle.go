@@ -0,0 +1,71 @@
+package api
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"journey/internal/pgstore"
+)
+
+// validateTripBundle reports every problem that would keep bundle from being
+// imported. A bundle has the same shape as the data of a trip snapshot.
+func (api API) validateTripBundle(bundle pgstore.TripSnapshotData) []string {
+	issues := make([]string, 0)
+
+	trip := bundle.Trip
+	if len(trip.Destination) < 4 {
+		issues = append(issues, "trip: destination must have at least 4 characters")
+	}
+	if api.validator.Var(trip.OwnerEmail, "required,email") != nil {
+		issues = append(issues, "trip: invalid owner_email")
+	}
+	if trip.OwnerName == "" {
+		issues = append(issues, "trip: missing owner_name")
+	}
+	if !trip.StartsAt.Valid || !trip.EndsAt.Valid {
+		issues = append(issues, "trip: missing starts_at or ends_at")
+	} else if trip.EndsAt.Time.Before(trip.StartsAt.Time) {
+		issues = append(issues, "trip: ends_at is before starts_at")
+	}
+
+	emails := make(map[string]bool, len(bundle.Participants))
+	for i, p := range bundle.Participants {
+		if api.validator.Var(p.Email, "required,email") != nil {
+			issues = append(issues, fmt.Sprintf("participants[%d]: invalid email", i))
+			continue
+		}
+		if emails[p.Email] {
+			issues = append(issues, fmt.Sprintf("participants[%d]: duplicated email %s", i, p.Email))
+		}
+		emails[p.Email] = true
+	}
+
+	activityIDs := make(map[uuid.UUID]bool, len(bundle.Activities))
+	for i, a := range bundle.Activities {
+		activityIDs[a.ID] = true
+		if a.Title == "" {
+			issues = append(issues, fmt.Sprintf("activities[%d]: missing title", i))
+		}
+		if !a.OccursAt.Valid {
+			issues = append(issues, fmt.Sprintf("activities[%d]: missing occurs_at", i))
+			continue
+		}
+		if trip.StartsAt.Valid && trip.EndsAt.Valid &&
+			(a.OccursAt.Time.Before(trip.StartsAt.Time) || a.OccursAt.Time.After(trip.EndsAt.Time)) {
+			issues = append(issues, fmt.Sprintf("activities[%d]: activity must occur during the trip", i))
+		}
+	}
+
+	for i, l := range bundle.Links {
+		if l.Title == "" {
+			issues = append(issues, fmt.Sprintf("links[%d]: missing title", i))
+		}
+		if !isHTTPURL(l.Url) {
+			issues = append(issues, fmt.Sprintf("links[%d]: invalid url", i))
+		}
+		if l.ActivityID.Valid && !activityIDs[uuid.UUID(l.ActivityID.Bytes)] {
+			issues = append(issues, fmt.Sprintf("links[%d]: unknown activity_id", i))
+		}
+	}
+
+	return issues
+}
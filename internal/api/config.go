@@ -0,0 +1,18 @@
+package api
+
+import (
+	"os"
+	"strconv"
+
+	_ "github.com/joho/godotenv/autoload"
+)
+
+// envInt reads an integer setting from the environment, falling back to def
+// when the variable is unset or malformed.
+func envInt(key string, def int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return def
+	}
+	return v
+}
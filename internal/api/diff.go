@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"journey/internal/api/spec"
+	"journey/internal/pgstore"
+	"strconv"
+	"time"
+)
+
+// errSnapshotNotFound is returned when a snapshot referenced by a request does
+// not exist or belongs to another trip.
+var errSnapshotNotFound = errors.New("snapshot not found")
+
+// tripSnapshotData loads the state of the trip saved in one of its snapshots.
+func (api API) tripSnapshotData(ctx context.Context, tripID, snapshotID uuid.UUID) (pgstore.TripSnapshotData, error) {
+	snapshot, err := api.store.GetTripSnapshot(ctx, snapshotID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.TripSnapshotData{}, errSnapshotNotFound
+		}
+		return pgstore.TripSnapshotData{}, err
+	}
+	if snapshot.TripID != tripID {
+		return pgstore.TripSnapshotData{}, errSnapshotNotFound
+	}
+
+	var data pgstore.TripSnapshotData
+	if err := json.Unmarshal(snapshot.Data, &data); err != nil {
+		return pgstore.TripSnapshotData{}, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	return data, nil
+}
+
+// diffField is a field compared between the two versions of an item, rendered
+// as a string or nil when null.
+type diffField[T any] struct {
+	name  string
+	value func(T) *string
+}
+
+var activityDiffFields = []diffField[pgstore.Activity]{
+	{"title", func(a pgstore.Activity) *string { return &a.Title }},
+	{"occurs_at", func(a pgstore.Activity) *string { return diffTimestamp(a.OccursAt) }},
+	{"remind_before_minutes", func(a pgstore.Activity) *string { return diffInt4(a.RemindBeforeMinutes) }},
+	{"duration_minutes", func(a pgstore.Activity) *string { return diffInt4(a.DurationMinutes) }},
+}
+
+var linkDiffFields = []diffField[pgstore.Link]{
+	{"title", func(l pgstore.Link) *string { return &l.Title }},
+	{"url", func(l pgstore.Link) *string { return &l.Url }},
+	{"activity_id", func(l pgstore.Link) *string { return diffUUID(l.ActivityID) }},
+}
+
+var participantDiffFields = []diffField[pgstore.Participant]{
+	{"email", func(p pgstore.Participant) *string { return &p.Email }},
+	{"name", func(p pgstore.Participant) *string { return diffText(p.Name) }},
+	{"is_confirmed", func(p pgstore.Participant) *string { return diffBool(p.IsConfirmed) }},
+	{"is_organizer", func(p pgstore.Participant) *string { return diffBool(p.IsOrganizer) }},
+	{"group_name", func(p pgstore.Participant) *string { return diffText(p.GroupName) }},
+}
+
+// diffSnapshots lists the activities, links and participants added, removed
+// or changed between two snapshots of a trip.
+func diffSnapshots(from, to pgstore.TripSnapshotData) spec.GetSnapshotDiffResponse {
+	return spec.GetSnapshotDiffResponse{
+		Activities: diffItems(from.Activities, to.Activities,
+			func(a pgstore.Activity) (uuid.UUID, string) { return a.ID, a.Title }, activityDiffFields),
+		Links: diffItems(from.Links, to.Links,
+			func(l pgstore.Link) (uuid.UUID, string) { return l.ID, l.Title }, linkDiffFields),
+		Participants: diffItems(from.Participants, to.Participants,
+			func(p pgstore.Participant) (uuid.UUID, string) { return p.ID, p.Email }, participantDiffFields),
+	}
+}
+
+// diffItems matches the items of both versions by id, reporting those only in
+// to as added, those only in from as removed and those whose fields differ as
+// changed.
+func diffItems[T any](from, to []T, key func(T) (uuid.UUID, string), fields []diffField[T]) spec.SnapshotDiff {
+	diff := spec.SnapshotDiff{
+		Added:   make([]spec.SnapshotDiffItem, 0),
+		Removed: make([]spec.SnapshotDiffItem, 0),
+		Changed: make([]spec.SnapshotDiffItem, 0),
+	}
+
+	before := make(map[uuid.UUID]T, len(from))
+	for _, item := range from {
+		id, _ := key(item)
+		before[id] = item
+	}
+
+	after := make(map[uuid.UUID]bool, len(to))
+	for _, item := range to {
+		id, name := key(item)
+		after[id] = true
+
+		old, ok := before[id]
+		if !ok {
+			diff.Added = append(diff.Added, spec.SnapshotDiffItem{ID: id.String(), Name: name})
+			continue
+		}
+
+		var changes []spec.SnapshotDiffChange
+		for _, field := range fields {
+			oldValue, newValue := field.value(old), field.value(item)
+			if !equalDiffValues(oldValue, newValue) {
+				changes = append(changes, spec.SnapshotDiffChange{Field: field.name, From: oldValue, To: newValue})
+			}
+		}
+		if len(changes) > 0 {
+			diff.Changed = append(diff.Changed, spec.SnapshotDiffItem{ID: id.String(), Name: name, Changes: changes})
+		}
+	}
+
+	for _, item := range from {
+		if id, name := key(item); !after[id] {
+			diff.Removed = append(diff.Removed, spec.SnapshotDiffItem{ID: id.String(), Name: name})
+		}
+	}
+
+	return diff
+}
+
+func equalDiffValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func diffTimestamp(v pgtype.Timestamp) *string {
+	if !v.Valid {
+		return nil
+	}
+	s := v.Time.Format(time.RFC3339)
+	return &s
+}
+
+func diffInt4(v pgtype.Int4) *string {
+	if !v.Valid {
+		return nil
+	}
+	s := strconv.Itoa(int(v.Int32))
+	return &s
+}
+
+func diffText(v pgtype.Text) *string {
+	if !v.Valid {
+		return nil
+	}
+	return &v.String
+}
+
+func diffUUID(v pgtype.UUID) *string {
+	if !v.Valid {
+		return nil
+	}
+	s := uuid.UUID(v.Bytes).String()
+	return &s
+}
+
+func diffBool(v bool) *string {
+	s := strconv.FormatBool(v)
+	return &s
+}
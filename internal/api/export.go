@@ -0,0 +1,43 @@
+package api
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"journey/internal/pgstore"
+)
+
+// writeOwnerExport writes a zip archive to w with one JSON file per trip,
+// holding the trip with its participants, activities and links in the same
+// shape as a trip snapshot. Each trip is loaded only when its file is
+// written, so the archive is never held in memory as a whole.
+func (api API) writeOwnerExport(ctx context.Context, w io.Writer, trips []pgstore.Trip) error {
+	zw := zip.NewWriter(w)
+
+	for _, trip := range trips {
+		data := pgstore.TripSnapshotData{Trip: trip}
+
+		var err error
+		if data.Participants, err = api.store.GetParticipants(ctx, trip.ID); err != nil {
+			return fmt.Errorf("failed to get participants of trip %s: %w", trip.ID, err)
+		}
+		if data.Activities, err = api.store.GetTripActivities(ctx, trip.ID); err != nil {
+			return fmt.Errorf("failed to get activities of trip %s: %w", trip.ID, err)
+		}
+		if data.Links, err = api.store.GetTripLinks(ctx, trip.ID); err != nil {
+			return fmt.Errorf("failed to get links of trip %s: %w", trip.ID, err)
+		}
+
+		f, err := zw.Create(fmt.Sprintf("trip-%s.json", trip.ID))
+		if err != nil {
+			return fmt.Errorf("failed to create archive entry for trip %s: %w", trip.ID, err)
+		}
+		if err := json.NewEncoder(f).Encode(data); err != nil {
+			return fmt.Errorf("failed to write trip %s: %w", trip.ID, err)
+		}
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"github.com/google/uuid"
+	"journey/internal/pgstore"
+	"net/http"
+	"strings"
+)
+
+// requesterEmailHeader identifies who is performing a guarded mutation.
+//
+// The header is taken at face value: nothing proves the caller owns the
+// email it sends, so anyone who knows the owner's or an organizer's email
+// passes the guards. It stands in for real authentication, which the API does
+// not have yet, and must not be relied on outside trusted deployments.
+const requesterEmailHeader = "X-User-Email"
+
+// adminTokenHeader carries the token that unlocks the admin routes.
+const adminTokenHeader = "X-Admin-Token"
+
+// requesterEmail is the email the request was made on behalf of, or "" if none
+// was sent. It is unverified; see requesterEmailHeader.
+func requesterEmail(r *http.Request) string {
+	return strings.TrimSpace(r.Header.Get(requesterEmailHeader))
+}
+
+// canEditTrip reports whether email may mutate the trip, that is, whether it
+// belongs to the trip owner or to one of its organizers.
+func (api API) canEditTrip(ctx context.Context, tripID uuid.UUID, email string) (bool, error) {
+	if email == "" {
+		return false, nil
+	}
+
+	isOwner, err := api.store.IsOwner(ctx, pgstore.IsOwnerParams{TripID: tripID, Email: email})
+	if err != nil || isOwner {
+		return isOwner, err
+	}
+
+	return api.store.IsOrganizer(ctx, pgstore.IsOrganizerParams{TripID: tripID, Email: email})
+}
+
+// isAdmin reports whether the request carries the configured admin token. No
+// request is an admin one while JOURNEY_ADMIN_TOKEN is unset.
+func (api API) isAdmin(r *http.Request) bool {
+	token := r.Header.Get(adminTokenHeader)
+	return api.adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(api.adminToken)) == 1
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"go.uber.org/zap"
+)
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger, which handlers serving the
+// request log with instead of the API logger.
+func WithLogger(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// LoggerFrom returns the logger stored in ctx by WithLogger, or fallback when
+// there is none.
+func LoggerFrom(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*zap.Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+// log is the logger of the request ctx belongs to.
+func (api API) log(ctx context.Context) *zap.Logger {
+	return LoggerFrom(ctx, api.logger)
+}
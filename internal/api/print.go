@@ -0,0 +1,70 @@
+package api
+
+import (
+	"embed"
+	"html/template"
+	"journey/internal/pgstore"
+	"sort"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var printTemplate = template.Must(template.ParseFS(templatesFS, "templates/print.html"))
+
+type printPage struct {
+	Destination string
+	StartsAt    string
+	EndsAt      string
+	Days        []printDay
+	Links       []printLink
+}
+
+type printDay struct {
+	Date       string
+	Activities []printActivity
+}
+
+type printActivity struct {
+	Time  string
+	Title string
+}
+
+type printLink struct {
+	Title string
+	URL   string
+}
+
+const printDateLayout = "02/01/2006"
+
+// newPrintPage lays out a trip itinerary, grouping activities by day in
+// chronological order.
+func newPrintPage(trip pgstore.Trip, activities []pgstore.Activity, links []pgstore.Link) printPage {
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].OccursAt.Time.Before(activities[j].OccursAt.Time)
+	})
+
+	page := printPage{
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time.Format(printDateLayout),
+		EndsAt:      trip.EndsAt.Time.Format(printDateLayout),
+	}
+
+	for _, activity := range activities {
+		date := activity.OccursAt.Time.Format(printDateLayout)
+		if len(page.Days) == 0 || page.Days[len(page.Days)-1].Date != date {
+			page.Days = append(page.Days, printDay{Date: date})
+		}
+		day := &page.Days[len(page.Days)-1]
+		day.Activities = append(day.Activities, printActivity{
+			Time:  activity.OccursAt.Time.Format("15:04"),
+			Title: activity.Title,
+		})
+	}
+
+	for _, link := range links {
+		page.Links = append(page.Links, printLink{Title: link.Title, URL: link.Url})
+	}
+
+	return page
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"journey/internal/pgstore"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNewPrintPage(t *testing.T) {
+	at := func(day, hour int) pgtype.Timestamp {
+		return pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, day, hour, 30, 0, 0, time.UTC)}
+	}
+	trip := pgstore.Trip{
+		Destination: "Salvador",
+		StartsAt:    at(10, 0),
+		EndsAt:      at(15, 0),
+	}
+	activities := []pgstore.Activity{
+		{Title: "Jantar", OccursAt: at(11, 20)},
+		{Title: "Voo", OccursAt: at(10, 8)},
+		{Title: "Pelourinho", OccursAt: at(11, 9)},
+	}
+	links := []pgstore.Link{{Title: "Hotel", Url: "https://example.com/hotel"}}
+
+	got := newPrintPage(trip, activities, links)
+
+	want := printPage{
+		Destination: "Salvador",
+		StartsAt:    "10/06/2030",
+		EndsAt:      "15/06/2030",
+		Days: []printDay{
+			{Date: "10/06/2030", Activities: []printActivity{{Time: "08:30", Title: "Voo"}}},
+			{Date: "11/06/2030", Activities: []printActivity{
+				{Time: "09:30", Title: "Pelourinho"},
+				{Time: "20:30", Title: "Jantar"},
+			}},
+		},
+		Links: []printLink{{Title: "Hotel", URL: "https://example.com/hotel"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newPrintPage = %+v, want %+v", got, want)
+	}
+}
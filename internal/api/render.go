@@ -0,0 +1,35 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/go-chi/render"
+	"net/http"
+	"strconv"
+)
+
+// Respond is a drop-in replacement for render.DefaultResponder that indents
+// JSON bodies when the request carries ?pretty=true. Responses stay compact
+// by default.
+func Respond(w http.ResponseWriter, r *http.Request, v interface{}) {
+	pretty, _ := strconv.ParseBool(r.URL.Query().Get("pretty"))
+	if !pretty || render.GetAcceptedContentType(r) == render.ContentTypeXML {
+		render.DefaultResponder(w, r, v)
+		return
+	}
+
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(true)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if status, ok := r.Context().Value(render.StatusCtxKey).(int); ok {
+		w.WriteHeader(status)
+	}
+	_, _ = w.Write(buf.Bytes())
+}
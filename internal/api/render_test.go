@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"github.com/go-chi/render"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRespond(t *testing.T) {
+	body := map[string]string{"message": "olá"}
+
+	tests := []struct {
+		name   string
+		target string
+		want   string
+	}{
+		{"compact by default", "/trips", "{\"message\":\"olá\"}\n"},
+		{"indented when pretty", "/trips?pretty=true", "{\n  \"message\": \"olá\"\n}\n"},
+		{"compact when not pretty", "/trips?pretty=false", "{\"message\":\"olá\"}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			r = r.WithContext(context.WithValue(r.Context(), render.StatusCtxKey, http.StatusCreated))
+			w := httptest.NewRecorder()
+
+			Respond(w, r, body)
+
+			if w.Code != http.StatusCreated {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusCreated)
+			}
+			if got := w.Header().Get("Content-Type"); got != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", got)
+			}
+			if got := w.Body.String(); got != tt.want {
+				t.Errorf("body = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
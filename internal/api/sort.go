@@ -0,0 +1,73 @@
+package api
+
+import (
+	"journey/internal/pgstore"
+	"os"
+	"slices"
+	"strings"
+)
+
+// listSort maps the keys a list endpoint can be sorted by to how they compare
+// two items. The sort query param names a key, prefixed with "-" to sort
+// descending.
+type listSort[T any] map[string]func(a, b T) int
+
+var tripSorts = listSort[pgstore.Trip]{
+	"starts_at":   func(a, b pgstore.Trip) int { return a.StartsAt.Time.Compare(b.StartsAt.Time) },
+	"created_at":  func(a, b pgstore.Trip) int { return a.CreatedAt.Time.Compare(b.CreatedAt.Time) },
+	"destination": func(a, b pgstore.Trip) int { return strings.Compare(a.Destination, b.Destination) },
+}
+
+var activitySorts = listSort[pgstore.Activity]{
+	"occurs_at": func(a, b pgstore.Activity) int { return a.OccursAt.Time.Compare(b.OccursAt.Time) },
+	"title":     func(a, b pgstore.Activity) int { return strings.Compare(a.Title, b.Title) },
+}
+
+var participantSorts = listSort[pgstore.Participant]{
+	"created_at": func(a, b pgstore.Participant) int { return a.CreatedAt.Time.Compare(b.CreatedAt.Time) },
+	"email":      func(a, b pgstore.Participant) int { return strings.Compare(a.Email, b.Email) },
+}
+
+var linkSorts = listSort[pgstore.Link]{
+	"created_at": func(a, b pgstore.Link) int { return a.CreatedAt.Time.Compare(b.CreatedAt.Time) },
+	"title":      func(a, b pgstore.Link) int { return strings.Compare(a.Title, b.Title) },
+}
+
+// compare returns how sort compares two items, or nil if sort names no key.
+func (s listSort[T]) compare(sort string) func(a, b T) int {
+	key, desc := strings.CutPrefix(sort, "-")
+	cmp, ok := s[key]
+	if !ok {
+		return nil
+	}
+	if desc {
+		return func(a, b T) int { return cmp(b, a) }
+	}
+	return cmp
+}
+
+// apply stably sorts items by sort, or by def when sort is nil. It reports
+// false, leaving items untouched, if sort names no key.
+func (s listSort[T]) apply(items []T, sort *string, def string) bool {
+	if sort != nil {
+		def = *sort
+	}
+
+	cmp := s.compare(def)
+	if cmp == nil {
+		return false
+	}
+
+	slices.SortStableFunc(items, cmp)
+	return true
+}
+
+// envSort reads the default sort of a list endpoint from the environment,
+// falling back to def when the variable is unset or names no key of sorts.
+func envSort[T any](key string, sorts listSort[T], def string) string {
+	v := os.Getenv(key)
+	if sorts.compare(v) == nil {
+		return def
+	}
+	return v
+}
@@ -0,0 +1,23 @@
+package api
+
+import "testing"
+
+func TestEnvSort(t *testing.T) {
+	tests := []struct {
+		env  string
+		want string
+	}{
+		{"", "occurs_at"},
+		{"title", "title"},
+		{"-occurs_at", "-occurs_at"},
+		{"duration", "occurs_at"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("JOURNEY_DEFAULT_SORT_ACTIVITIES", tt.env)
+			if got := envSort("JOURNEY_DEFAULT_SORT_ACTIVITIES", activitySorts, "occurs_at"); got != tt.want {
+				t.Errorf("envSort = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
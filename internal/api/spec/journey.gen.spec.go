@@ -23,21 +23,127 @@ import (
 	"github.com/go-chi/render"
 )
 
+// Defines values for GetTripCardResponseStatus.
+var (
+	UnknownGetTripCardResponseStatus = GetTripCardResponseStatus{}
+
+	GetTripCardResponseStatusConfirmed = GetTripCardResponseStatus{"confirmed"}
+
+	GetTripCardResponseStatusPending = GetTripCardResponseStatus{"pending"}
+)
+
+// Defines values for GetTripDetailsResponseTripObjStatus.
+var (
+	UnknownGetTripDetailsResponseTripObjStatus = GetTripDetailsResponseTripObjStatus{}
+
+	GetTripDetailsResponseTripObjStatusActive = GetTripDetailsResponseTripObjStatus{"active"}
+
+	GetTripDetailsResponseTripObjStatusArchived = GetTripDetailsResponseTripObjStatus{"archived"}
+)
+
+// Defines values for GetTripEmailStatusResponseStatus.
+var (
+	UnknownGetTripEmailStatusResponseStatus = GetTripEmailStatusResponseStatus{}
+
+	GetTripEmailStatusResponseStatusFailed = GetTripEmailStatusResponseStatus{"failed"}
+
+	GetTripEmailStatusResponseStatusPending = GetTripEmailStatusResponseStatus{"pending"}
+
+	GetTripEmailStatusResponseStatusQueued = GetTripEmailStatusResponseStatus{"queued"}
+
+	GetTripEmailStatusResponseStatusSent = GetTripEmailStatusResponseStatus{"sent"}
+)
+
+// Defines values for ImportParticipantsResponseArrayStatus.
+var (
+	UnknownImportParticipantsResponseArrayStatus = ImportParticipantsResponseArrayStatus{}
+
+	ImportParticipantsResponseArrayStatusCreated = ImportParticipantsResponseArrayStatus{"created"}
+
+	ImportParticipantsResponseArrayStatusError = ImportParticipantsResponseArrayStatus{"error"}
+
+	ImportParticipantsResponseArrayStatusSkipped = ImportParticipantsResponseArrayStatus{"skipped"}
+)
+
+// Defines values for ReplayWebhookDeliveryResponseStatus.
+var (
+	UnknownReplayWebhookDeliveryResponseStatus = ReplayWebhookDeliveryResponseStatus{}
+
+	ReplayWebhookDeliveryResponseStatusDead = ReplayWebhookDeliveryResponseStatus{"dead"}
+
+	ReplayWebhookDeliveryResponseStatusDelivered = ReplayWebhookDeliveryResponseStatus{"delivered"}
+
+	ReplayWebhookDeliveryResponseStatusPending = ReplayWebhookDeliveryResponseStatus{"pending"}
+)
+
+// Defines values for ValidateConfirmationTokenResponseReason.
+var (
+	UnknownValidateConfirmationTokenResponseReason = ValidateConfirmationTokenResponseReason{}
+
+	ValidateConfirmationTokenResponseReasonAlreadyConfirmed = ValidateConfirmationTokenResponseReason{"already_confirmed"}
+
+	ValidateConfirmationTokenResponseReasonExpired = ValidateConfirmationTokenResponseReason{"expired"}
+
+	ValidateConfirmationTokenResponseReasonNotFound = ValidateConfirmationTokenResponseReason{"not_found"}
+)
+
+// Defines values for WebhookSubscriptionRequestEvents.
+var (
+	UnknownWebhookSubscriptionRequestEvents = WebhookSubscriptionRequestEvents{}
+
+	WebhookSubscriptionRequestEventsActivityCreated = WebhookSubscriptionRequestEvents{"activity.created"}
+
+	WebhookSubscriptionRequestEventsParticipantConfirmed = WebhookSubscriptionRequestEvents{"participant.confirmed"}
+
+	WebhookSubscriptionRequestEventsTripConfirmed = WebhookSubscriptionRequestEvents{"trip.confirmed"}
+)
+
+// ActivityParticipant defines model for ActivityParticipant.
+type ActivityParticipant struct {
+	Email openapi_types.Email `json:"email"`
+	ID    string              `json:"id"`
+	Name  *string             `json:"name"`
+}
+
+// CreateActivitiesBatchResponse defines model for CreateActivitiesBatchResponse.
+type CreateActivitiesBatchResponse struct {
+	// The ids of the created activities, in the order they were sent.
+	ActivityIds []string `json:"activity_ids"`
+}
+
+// CreateActivityAttachmentResponse defines model for CreateActivityAttachmentResponse.
+type CreateActivityAttachmentResponse struct {
+	AttachmentID string `json:"attachmentId"`
+}
+
 // CreateActivityRequest defines model for CreateActivityRequest.
 type CreateActivityRequest struct {
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+	// How long the activity lasts.
+	DurationMinutes *int               `json:"duration_minutes,omitempty" validate:"omitempty,min=1"`
+	Link            *CreateLinkRequest `json:"link,omitempty"`
+	OccursAt        time.Time          `json:"occurs_at" validate:"required"`
+
+	// Email confirmed participants this many minutes before the activity.
+	RemindBeforeMinutes *int   `json:"remind_before_minutes,omitempty" validate:"omitempty,min=1"`
+	Title               string `json:"title" validate:"required"`
 }
 
 // CreateActivityResponse defines model for CreateActivityResponse.
 type CreateActivityResponse struct {
-	ActivityID string `json:"activityId"`
+	ActivityID string  `json:"activityId"`
+	LinkID     *string `json:"linkId,omitempty"`
+}
+
+// CreateBatchActivityRequest defines model for CreateBatchActivityRequest.
+type CreateBatchActivityRequest struct {
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
 }
 
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
 	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
+	URL   string `json:"url" validate:"required"`
 }
 
 // CreateLinkResponse defines model for CreateLinkResponse.
@@ -45,6 +151,17 @@ type CreateLinkResponse struct {
 	LinkID string `json:"linkId"`
 }
 
+// CreatePackingItemRequest defines model for CreatePackingItemRequest.
+type CreatePackingItemRequest struct {
+	AssignedTo *string `json:"assigned_to" validate:"omitempty,uuid"`
+	Text       string  `json:"text" validate:"required"`
+}
+
+// CreatePackingItemResponse defines model for CreatePackingItemResponse.
+type CreatePackingItemResponse struct {
+	PackingItemID string `json:"packingItemId"`
+}
+
 // CreateTripRequest defines model for CreateTripRequest.
 type CreateTripRequest struct {
 	Destination    string                `json:"destination" validate:"required,min=4"`
@@ -60,11 +177,83 @@ type CreateTripResponse struct {
 	TripID string `json:"tripId"`
 }
 
+// CreateTripSnapshotResponse defines model for CreateTripSnapshotResponse.
+type CreateTripSnapshotResponse struct {
+	SnapshotID string `json:"snapshotId"`
+}
+
+// CreateUnavailabilityRequest defines model for CreateUnavailabilityRequest.
+type CreateUnavailabilityRequest struct {
+	EndsAt   time.Time `json:"ends_at" validate:"required"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
+}
+
+// CreateUnavailabilityResponse defines model for CreateUnavailabilityResponse.
+type CreateUnavailabilityResponse struct {
+	UnavailabilityID string `json:"unavailabilityId"`
+}
+
+// CreateWebhookSubscriptionResponse defines model for CreateWebhookSubscriptionResponse.
+type CreateWebhookSubscriptionResponse struct {
+	// Key of the HMAC-SHA256 signature sent in X-Journey-Signature. It is only shown once.
+	Secret    string `json:"secret"`
+	WebhookID string `json:"webhookId"`
+}
+
 // Bad request
 type Error struct {
+	Fields  []FieldError `json:"fields,omitempty"`
+	Message string       `json:"message"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	Field   string `json:"field"`
 	Message string `json:"message"`
 }
 
+// FullCalendarEvent defines model for FullCalendarEvent.
+type FullCalendarEvent struct {
+	// Only sent for activities with a duration.
+	End   *time.Time `json:"end,omitempty"`
+	ID    string     `json:"id"`
+	Start time.Time  `json:"start"`
+	Title string     `json:"title"`
+}
+
+// GetActivitiesOnDateResponse defines model for GetActivitiesOnDateResponse.
+type GetActivitiesOnDateResponse struct {
+	Activities []GetActivitiesOnDateResponseArray `json:"activities"`
+}
+
+// GetActivitiesOnDateResponseArray defines model for GetActivitiesOnDateResponseArray.
+type GetActivitiesOnDateResponseArray struct {
+	Destination string    `json:"destination"`
+	ID          string    `json:"id"`
+	OccursAt    time.Time `json:"occurs_at"`
+	Title       string    `json:"title"`
+	TripID      string    `json:"trip_id"`
+}
+
+// GetEagerParticipantsResponse defines model for GetEagerParticipantsResponse.
+type GetEagerParticipantsResponse struct {
+	Participants []GetEagerParticipantsResponseArray `json:"participants"`
+}
+
+// GetEagerParticipantsResponseArray defines model for GetEagerParticipantsResponseArray.
+type GetEagerParticipantsResponseArray struct {
+	Destination string              `json:"destination"`
+	Email       openapi_types.Email `json:"email"`
+	ID          string              `json:"id"`
+	Name        *string             `json:"name"`
+	TripID      string              `json:"trip_id"`
+}
+
+// GetLinksByDomainResponse defines model for GetLinksByDomainResponse.
+type GetLinksByDomainResponse struct {
+	Domains []LinkDomain `json:"domains"`
+}
+
 // GetLinksResponse defines model for GetLinksResponse.
 type GetLinksResponse struct {
 	Links []GetLinksResponseArray `json:"links"`
@@ -77,16 +266,62 @@ type GetLinksResponseArray struct {
 	URL   string `json:"url"`
 }
 
+// GetOwnerConfirmationRateResponse defines model for GetOwnerConfirmationRateResponse.
+type GetOwnerConfirmationRateResponse struct {
+	Confirmed int `json:"confirmed"`
+	Invited   int `json:"invited"`
+
+	// Fraction of the invited participants who confirmed, 0 when nobody was invited.
+	Rate float32 `json:"rate"`
+}
+
+// GetOwnerDestinationsResponse defines model for GetOwnerDestinationsResponse.
+type GetOwnerDestinationsResponse struct {
+	Destinations []string `json:"destinations"`
+}
+
+// GetParticipantTripsResponse defines model for GetParticipantTripsResponse.
+type GetParticipantTripsResponse struct {
+	// Number of trips the email is on, across all pages.
+	Total int                             `json:"total"`
+	Trips []GetTripDetailsResponseTripObj `json:"trips"`
+}
+
+// GetRecentActivitiesResponse defines model for GetRecentActivitiesResponse.
+type GetRecentActivitiesResponse struct {
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+}
+
+// GetSnapshotDiffResponse defines model for GetSnapshotDiffResponse.
+type GetSnapshotDiffResponse struct {
+	Activities   SnapshotDiff `json:"activities"`
+	Links        SnapshotDiff `json:"links"`
+	Participants SnapshotDiff `json:"participants"`
+}
+
 // GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
 type GetTripActivitiesResponse struct {
 	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+
+	// Number of activities matching the filters, across all pages.
+	Total int `json:"total"`
 }
 
 // GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
 type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Day of the trip the activity occurs on, starting at 1 on the day the trip starts.
+	DayNumber           int                   `json:"day_number"`
+	DurationMinutes     *int                  `json:"duration_minutes"`
+	ID                  string                `json:"id"`
+	OccursAt            time.Time             `json:"occurs_at"`
+	Participants        []ActivityParticipant `json:"participants"`
+	RemindBeforeMinutes *int                  `json:"remind_before_minutes"`
+
+	// Time the activity occurs at, as HH:MM in the trip local time.
+	TimeOfDay string `json:"time_of_day"`
+	Title     string `json:"title"`
 }
 
 // GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
@@ -95,6 +330,54 @@ type GetTripActivitiesResponseOuterArray struct {
 	Date       time.Time                             `json:"date"`
 }
 
+// GetTripBusiestDayResponse defines model for GetTripBusiestDayResponse.
+type GetTripBusiestDayResponse struct {
+	Activities int                `json:"activities"`
+	Date       openapi_types.Date `json:"date"`
+}
+
+// GetTripCardResponse defines model for GetTripCardResponse.
+type GetTripCardResponse struct {
+	Destination string                    `json:"destination"`
+	EndsAt      time.Time                 `json:"ends_at"`
+	StartsAt    time.Time                 `json:"starts_at"`
+	Status      GetTripCardResponseStatus `json:"status"`
+}
+
+// GetTripConfirmationEmailResponse defines model for GetTripConfirmationEmailResponse.
+type GetTripConfirmationEmailResponse struct {
+	HTMLBody string              `json:"html_body"`
+	Subject  string              `json:"subject"`
+	TextBody string              `json:"text_body"`
+	To       openapi_types.Email `json:"to"`
+}
+
+// GetTripConflictsResponse defines model for GetTripConflictsResponse.
+type GetTripConflictsResponse struct {
+	Conflicts []GetTripConflictsResponseArray `json:"conflicts"`
+}
+
+// GetTripConflictsResponseArray defines model for GetTripConflictsResponseArray.
+type GetTripConflictsResponseArray struct {
+	ActivityID    string              `json:"activity_id"`
+	Email         openapi_types.Email `json:"email"`
+	OccursAt      time.Time           `json:"occurs_at"`
+	ParticipantID string              `json:"participant_id"`
+	Title         string              `json:"title"`
+}
+
+// GetTripDateWindowsResponse defines model for GetTripDateWindowsResponse.
+type GetTripDateWindowsResponse struct {
+	Days []TripDateWindow `json:"days"`
+}
+
+// GetTripDayResponse defines model for GetTripDayResponse.
+type GetTripDayResponse struct {
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+	Date       openapi_types.Date                    `json:"date"`
+	Links      []GetLinksResponseArray               `json:"links"`
+}
+
 // GetTripDetailsResponse defines model for GetTripDetailsResponse.
 type GetTripDetailsResponse struct {
 	Trip GetTripDetailsResponseTripObj `json:"trip"`
@@ -102,11 +385,85 @@ type GetTripDetailsResponse struct {
 
 // GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
 type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+	Destination string              `json:"destination"`
+	EndsAt      time.Time           `json:"ends_at"`
+	ID          string              `json:"id"`
+	IsConfirmed bool                `json:"is_confirmed"`
+	OwnerEmail  openapi_types.Email `json:"owner_email"`
+	OwnerName   string              `json:"owner_name"`
+
+	// Where the destination was geocoded to, missing until it is.
+	Place    *TripPlace `json:"place,omitempty"`
+	StartsAt time.Time  `json:"starts_at"`
+
+	// Trips are archived some time after they end.
+	Status GetTripDetailsResponseTripObjStatus `json:"status"`
+}
+
+// GetTripEmailStatusResponse defines model for GetTripEmailStatusResponse.
+type GetTripEmailStatusResponse struct {
+	AttemptedAt *time.Time                       `json:"attempted_at,omitempty"`
+	Error       *string                          `json:"error,omitempty"`
+	Recipient   *string                          `json:"recipient,omitempty"`
+	Status      GetTripEmailStatusResponseStatus `json:"status"`
+}
+
+// GetTripGapsResponse defines model for GetTripGapsResponse.
+type GetTripGapsResponse struct {
+	Days []GetTripGapsResponseDay `json:"days"`
+}
+
+// GetTripGapsResponseDay defines model for GetTripGapsResponseDay.
+type GetTripGapsResponseDay struct {
+	Date openapi_types.Date       `json:"date"`
+	Gaps []GetTripGapsResponseGap `json:"gaps"`
+}
+
+// GetTripGapsResponseGap defines model for GetTripGapsResponseGap.
+type GetTripGapsResponseGap struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Minutes  int       `json:"minutes"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// GetTripPackingItemsResponse defines model for GetTripPackingItemsResponse.
+type GetTripPackingItemsResponse struct {
+	PackingItems []GetTripPackingItemsResponseArray `json:"packing_items"`
+}
+
+// GetTripPackingItemsResponseArray defines model for GetTripPackingItemsResponseArray.
+type GetTripPackingItemsResponseArray struct {
+	AssignedTo *string `json:"assigned_to"`
+	ID         string  `json:"id"`
+	IsPacked   bool    `json:"is_packed"`
+	Text       string  `json:"text"`
+}
+
+// GetTripParticipantGroupsResponse defines model for GetTripParticipantGroupsResponse.
+type GetTripParticipantGroupsResponse struct {
+	Groups []GetTripParticipantGroupsResponseArray `json:"groups"`
+}
+
+// GetTripParticipantGroupsResponseArray defines model for GetTripParticipantGroupsResponseArray.
+type GetTripParticipantGroupsResponseArray struct {
+	GroupName    *string                            `json:"group_name"`
+	Participants []GetTripParticipantsResponseArray `json:"participants"`
+}
+
+// GetTripParticipantsAnalyticsResponse defines model for GetTripParticipantsAnalyticsResponse.
+type GetTripParticipantsAnalyticsResponse struct {
+	Participants []GetTripParticipantsAnalyticsResponseArray `json:"participants"`
+}
+
+// GetTripParticipantsAnalyticsResponseArray defines model for GetTripParticipantsAnalyticsResponseArray.
+type GetTripParticipantsAnalyticsResponseArray struct {
+	// Hours between the invite and the confirmation, null while unconfirmed.
+	AcceptanceHours *float32            `json:"acceptance_hours"`
+	ConfirmedAt     *time.Time          `json:"confirmed_at"`
+	Email           openapi_types.Email `json:"email"`
+	ID              string              `json:"id"`
+	InvitedAt       time.Time           `json:"invited_at"`
+	IsConfirmed     bool                `json:"is_confirmed"`
 }
 
 // GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
@@ -119,384 +476,5178 @@ type GetTripParticipantsResponseArray struct {
 	Email       openapi_types.Email `json:"email"`
 	ID          string              `json:"id"`
 	IsConfirmed bool                `json:"is_confirmed"`
+	IsOrganizer bool                `json:"is_organizer"`
 	Name        *string             `json:"name"`
 }
 
-// InviteParticipantRequest defines model for InviteParticipantRequest.
-type InviteParticipantRequest struct {
-	Email openapi_types.Email `json:"email" validate:"required,email"`
+// GetTripSnapshotsResponse defines model for GetTripSnapshotsResponse.
+type GetTripSnapshotsResponse struct {
+	Snapshots []GetTripSnapshotsResponseArray `json:"snapshots"`
 }
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+// GetTripSnapshotsResponseArray defines model for GetTripSnapshotsResponseArray.
+type GetTripSnapshotsResponseArray struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// GetTripWebhooksResponse defines model for GetTripWebhooksResponse.
+type GetTripWebhooksResponse struct {
+	Webhooks []GetTripWebhooksResponseArray `json:"webhooks"`
+}
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+// GetTripWebhooksResponseArray defines model for GetTripWebhooksResponseArray.
+type GetTripWebhooksResponseArray struct {
+	CreatedAt time.Time `json:"created_at"`
+	Events    []string  `json:"events"`
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+}
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+// GetTripsByMonthResponse defines model for GetTripsByMonthResponse.
+type GetTripsByMonthResponse struct {
+	Months []GetTripsByMonthResponseArray `json:"months"`
+}
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+// GetTripsByMonthResponseArray defines model for GetTripsByMonthResponseArray.
+type GetTripsByMonthResponseArray struct {
+	// Month as YYYY-MM.
+	Month string                          `json:"month"`
+	Trips []GetTripDetailsResponseTripObj `json:"trips"`
+}
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+// GetTripsPerDayResponse defines model for GetTripsPerDayResponse.
+type GetTripsPerDayResponse struct {
+	Days []GetTripsPerDayResponseArray `json:"days"`
+}
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+// GetTripsPerDayResponseArray defines model for GetTripsPerDayResponseArray.
+type GetTripsPerDayResponseArray struct {
+	Day   openapi_types.Date `json:"day"`
+	Trips int                `json:"trips"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// GetTripsResponse defines model for GetTripsResponse.
+type GetTripsResponse struct {
+	// Whether total is an estimate rather than an exact count.
+	IsEstimate bool `json:"is_estimate"`
+
+	// Number of trips matching the filters, across all pages.
+	Total int                             `json:"total"`
+	Trips []GetTripDetailsResponseTripObj `json:"trips"`
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// ImportActivitiesResponse defines model for ImportActivitiesResponse.
+type ImportActivitiesResponse struct {
+	ActivityIds []string                                `json:"activityIds"`
+	Rejected    []ImportActivitiesResponseRejectedArray `json:"rejected"`
+}
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ImportActivitiesResponseRejectedArray defines model for ImportActivitiesResponseRejectedArray.
+type ImportActivitiesResponseRejectedArray struct {
+	OccursAt *time.Time `json:"occurs_at"`
+	Reason   string     `json:"reason"`
+	Title    string     `json:"title"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+// ImportParticipantsResponse defines model for ImportParticipantsResponse.
+type ImportParticipantsResponse struct {
+	Rows []ImportParticipantsResponseArray `json:"rows"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ImportParticipantsResponseArray defines model for ImportParticipantsResponseArray.
+type ImportParticipantsResponseArray struct {
+	Email         string                                `json:"email"`
+	ParticipantID *string                               `json:"participant_id"`
+	Reason        *string                               `json:"reason"`
+	Row           int                                   `json:"row"`
+	Status        ImportParticipantsResponseArrayStatus `json:"status"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// InviteParticipantRequest defines model for InviteParticipantRequest.
+type InviteParticipantRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+	// How the participant is shown next to their email.
+	Name *string `json:"name,omitempty" validate:"omitempty,max=255"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// LinkDomain defines model for LinkDomain.
+type LinkDomain struct {
+	Domain string                  `json:"domain"`
+	Links  []GetLinksResponseArray `json:"links"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ReplayWebhookDeliveryResponse defines model for ReplayWebhookDeliveryResponse.
+type ReplayWebhookDeliveryResponse struct {
+	Attempts   int                                 `json:"attempts"`
+	Error      *string                             `json:"error"`
+	Status     ReplayWebhookDeliveryResponseStatus `json:"status"`
+	StatusCode *int                                `json:"status_code"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// SetParticipantGroupRequest defines model for SetParticipantGroupRequest.
+type SetParticipantGroupRequest struct {
+	GroupName *string `json:"group_name" validate:"omitempty,max=255"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// SetParticipantOrganizerRequest defines model for SetParticipantOrganizerRequest.
+type SetParticipantOrganizerRequest struct {
+	IsOrganizer bool `json:"is_organizer"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// SnapshotDiff defines model for SnapshotDiff.
+type SnapshotDiff struct {
+	Added   []SnapshotDiffItem `json:"added"`
+	Changed []SnapshotDiffItem `json:"changed"`
+	Removed []SnapshotDiffItem `json:"removed"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// SnapshotDiffChange defines model for SnapshotDiffChange.
+type SnapshotDiffChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// SnapshotDiffItem defines model for SnapshotDiffItem.
+type SnapshotDiffItem struct {
+	Changes []SnapshotDiffChange `json:"changes,omitempty"`
+	ID      string               `json:"id"`
+
+	// The title of an activity or link, or the email of a participant.
+	Name string `json:"name"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// TripDateWindow defines model for TripDateWindow.
+type TripDateWindow struct {
+	Date openapi_types.Date `json:"date"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// Where the destination was geocoded to, missing until it is.
+type TripPlace struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Name      string  `json:"name"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// UpdateActivityRequest defines model for UpdateActivityRequest.
+type UpdateActivityRequest struct {
+	OccursAt time.Time `json:"occurs_at" validate:"required"`
+	Title    string    `json:"title" validate:"required"`
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// UpdateLinkRequest defines model for UpdateLinkRequest.
+type UpdateLinkRequest struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required"`
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// UpdatePackingItemRequest defines model for UpdatePackingItemRequest.
+type UpdatePackingItemRequest struct {
+	AssignedTo *string `json:"assigned_to" validate:"omitempty,uuid"`
+	Text       string  `json:"text" validate:"required"`
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	Destination string    `json:"destination" validate:"required,min=4"`
+	EndsAt      time.Time `json:"ends_at" validate:"required"`
+	StartsAt    time.Time `json:"starts_at" validate:"required"`
+}
+
+// ValidateConfirmationTokenResponse defines model for ValidateConfirmationTokenResponse.
+type ValidateConfirmationTokenResponse struct {
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Why the token cannot be used, set when it is not valid.
+	Reason *ValidateConfirmationTokenResponseReason `json:"reason,omitempty"`
+	Valid  bool                                     `json:"valid"`
+}
+
+// ValidateTripBundleResponse defines model for ValidateTripBundleResponse.
+type ValidateTripBundleResponse struct {
+	Counts ValidateTripBundleResponseCountsObj `json:"counts"`
+	Issues []string                            `json:"issues"`
+	Valid  bool                                `json:"valid"`
+}
+
+// ValidateTripBundleResponseCountsObj defines model for ValidateTripBundleResponseCountsObj.
+type ValidateTripBundleResponseCountsObj struct {
+	Activities   int `json:"activities"`
+	Links        int `json:"links"`
+	Participants int `json:"participants"`
+}
+
+// WebhookSubscriptionRequest defines model for WebhookSubscriptionRequest.
+type WebhookSubscriptionRequest struct {
+	Events []WebhookSubscriptionRequestEvents `json:"events" validate:"required,min=1"`
+	URL    string                             `json:"url" validate:"required,url"`
+}
+
+// GetTripCardResponseStatus defines model for GetTripCardResponse.Status.
+type GetTripCardResponseStatus struct {
+	value string
+}
+
+func (t *GetTripCardResponseStatus) ToValue() string {
+	return t.value
+}
+func (t GetTripCardResponseStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *GetTripCardResponseStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *GetTripCardResponseStatus) FromValue(value string) error {
+	switch value {
+
+	case GetTripCardResponseStatusConfirmed.value:
+		t.value = value
+		return nil
+
+	case GetTripCardResponseStatusPending.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+// Trips are archived some time after they end.
+type GetTripDetailsResponseTripObjStatus struct {
+	value string
+}
+
+func (t *GetTripDetailsResponseTripObjStatus) ToValue() string {
+	return t.value
+}
+func (t GetTripDetailsResponseTripObjStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *GetTripDetailsResponseTripObjStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *GetTripDetailsResponseTripObjStatus) FromValue(value string) error {
+	switch value {
+
+	case GetTripDetailsResponseTripObjStatusActive.value:
+		t.value = value
+		return nil
+
+	case GetTripDetailsResponseTripObjStatusArchived.value:
+		t.value = value
+		return nil
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// GetTripEmailStatusResponseStatus defines model for GetTripEmailStatusResponse.Status.
+type GetTripEmailStatusResponseStatus struct {
+	value string
+}
+
+func (t *GetTripEmailStatusResponseStatus) ToValue() string {
+	return t.value
+}
+func (t GetTripEmailStatusResponseStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *GetTripEmailStatusResponseStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *GetTripEmailStatusResponseStatus) FromValue(value string) error {
+	switch value {
+
+	case GetTripEmailStatusResponseStatusFailed.value:
+		t.value = value
+		return nil
+
+	case GetTripEmailStatusResponseStatusPending.value:
+		t.value = value
+		return nil
+
+	case GetTripEmailStatusResponseStatusQueued.value:
+		t.value = value
+		return nil
+
+	case GetTripEmailStatusResponseStatusSent.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// ImportParticipantsResponseArrayStatus defines model for ImportParticipantsResponseArray.Status.
+type ImportParticipantsResponseArrayStatus struct {
+	value string
+}
+
+func (t *ImportParticipantsResponseArrayStatus) ToValue() string {
+	return t.value
+}
+func (t ImportParticipantsResponseArrayStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ImportParticipantsResponseArrayStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ImportParticipantsResponseArrayStatus) FromValue(value string) error {
+	switch value {
+
+	case ImportParticipantsResponseArrayStatusCreated.value:
+		t.value = value
+		return nil
+
+	case ImportParticipantsResponseArrayStatusError.value:
+		t.value = value
+		return nil
+
+	case ImportParticipantsResponseArrayStatusSkipped.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
+// ReplayWebhookDeliveryResponseStatus defines model for ReplayWebhookDeliveryResponse.Status.
+type ReplayWebhookDeliveryResponseStatus struct {
+	value string
+}
+
+func (t *ReplayWebhookDeliveryResponseStatus) ToValue() string {
+	return t.value
+}
+func (t ReplayWebhookDeliveryResponseStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ReplayWebhookDeliveryResponseStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ReplayWebhookDeliveryResponseStatus) FromValue(value string) error {
+	switch value {
+
+	case ReplayWebhookDeliveryResponseStatusDead.value:
+		t.value = value
+		return nil
+
+	case ReplayWebhookDeliveryResponseStatusDelivered.value:
+		t.value = value
+		return nil
+
+	case ReplayWebhookDeliveryResponseStatusPending.value:
+		t.value = value
+		return nil
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
 }
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// Why the token cannot be used, set when it is not valid.
+type ValidateConfirmationTokenResponseReason struct {
+	value string
+}
+
+func (t *ValidateConfirmationTokenResponseReason) ToValue() string {
+	return t.value
+}
+func (t ValidateConfirmationTokenResponseReason) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *ValidateConfirmationTokenResponseReason) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
 	}
+	return t.FromValue(value)
 }
+func (t *ValidateConfirmationTokenResponseReason) FromValue(value string) error {
+	switch value {
+
+	case ValidateConfirmationTokenResponseReasonAlreadyConfirmed.value:
+		t.value = value
+		return nil
+
+	case ValidateConfirmationTokenResponseReasonExpired.value:
+		t.value = value
+		return nil
+
+	case ValidateConfirmationTokenResponseReasonNotFound.value:
+		t.value = value
+		return nil
+
+	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// WebhookSubscriptionRequestEvents defines model for WebhookSubscriptionRequest.Events.
+type WebhookSubscriptionRequestEvents struct {
+	value string
+}
+
+func (t *WebhookSubscriptionRequestEvents) ToValue() string {
+	return t.value
+}
+func (t WebhookSubscriptionRequestEvents) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.value)
+}
+func (t *WebhookSubscriptionRequestEvents) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	return t.FromValue(value)
+}
+func (t *WebhookSubscriptionRequestEvents) FromValue(value string) error {
+	switch value {
+
+	case WebhookSubscriptionRequestEventsActivityCreated.value:
+		t.value = value
+		return nil
+
+	case WebhookSubscriptionRequestEventsParticipantConfirmed.value:
+		t.value = value
+		return nil
+
+	case WebhookSubscriptionRequestEventsTripConfirmed.value:
+		t.value = value
+		return nil
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
 	}
+	return fmt.Errorf("unknown enum value: %v", value)
+}
+
+// GetActivitiesOnDateParams defines parameters for GetActivitiesOnDate.
+type GetActivitiesOnDateParams struct {
+	OwnerEmail openapi_types.Email `json:"owner_email"`
+}
+
+// GetAdminStatsTripsPerDayParams defines parameters for GetAdminStatsTripsPerDay.
+type GetAdminStatsTripsPerDayParams struct {
+	From openapi_types.Date `json:"from"`
+	To   openapi_types.Date `json:"to"`
+}
+
+// GetParticipantsParams defines parameters for GetParticipants.
+type GetParticipantsParams struct {
+	Email openapi_types.Email `json:"email"`
+
+	// Order of the trips: starts_at, created_at or destination, prefixed with - to sort descending. Defaults to JOURNEY_DEFAULT_SORT_TRIPS.
+	Sort *string `json:"sort,omitempty"`
+
+	// Maximum number of trips to return, up to 200. Defaults to 50.
+	Limit *int `json:"limit,omitempty"`
+
+	// Number of trips to skip. Defaults to 0.
+	Offset *int `json:"offset,omitempty"`
+
+	// List the archived trips instead of the active ones. Defaults to false.
+	Archived *bool `json:"archived,omitempty"`
+}
+
+// GetParticipantsConfirmValidateParams defines parameters for GetParticipantsConfirmValidate.
+type GetParticipantsConfirmValidateParams struct {
+	Token string `json:"token"`
+}
+
+// PatchParticipantsParticipantIDGroupJSONBody defines parameters for PatchParticipantsParticipantIDGroup.
+type PatchParticipantsParticipantIDGroupJSONBody SetParticipantGroupRequest
+
+// PatchParticipantsParticipantIDOrganizerJSONBody defines parameters for PatchParticipantsParticipantIDOrganizer.
+type PatchParticipantsParticipantIDOrganizerJSONBody SetParticipantOrganizerRequest
+
+// PostParticipantsParticipantIDUnavailabilitiesJSONBody defines parameters for PostParticipantsParticipantIDUnavailabilities.
+type PostParticipantsParticipantIDUnavailabilitiesJSONBody CreateUnavailabilityRequest
+
+// GetTripsParams defines parameters for GetTrips.
+type GetTripsParams struct {
+	// Only trips where every participant has confirmed.
+	AllConfirmed *bool `json:"all_confirmed,omitempty"`
+
+	// Order of the trips: starts_at, created_at or destination, prefixed with - to sort descending. Defaults to JOURNEY_DEFAULT_SORT_TRIPS.
+	Sort *string `json:"sort,omitempty"`
+
+	// Only trips whose destination contains this text, ignoring case.
+	Destination *string `json:"destination,omitempty"`
+
+	// Only trips starting at or after this time.
+	StartsAfter *time.Time `json:"starts_after,omitempty"`
+
+	// Only trips ending at or before this time.
+	EndsBefore *time.Time `json:"ends_before,omitempty"`
+
+	// Only confirmed (true) or unconfirmed (false) trips.
+	IsConfirmed *bool `json:"is_confirmed,omitempty"`
+
+	// Count the total exactly. When false and no filter is given, the total is the planner's estimate of the number of trips, which is much cheaper on large tables.
+	Exact *bool `json:"exact,omitempty"`
+
+	// Maximum number of trips to return, up to 200. Defaults to 50.
+	Limit *int `json:"limit,omitempty"`
+
+	// Number of trips to skip. Defaults to 0.
+	Offset *int `json:"offset,omitempty"`
+
+	// List the archived trips instead of the active ones. Defaults to false.
+	Archived *bool `json:"archived,omitempty"`
+}
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// GetTripsByMonthParams defines parameters for GetTripsByMonth.
+type GetTripsByMonthParams struct {
+	OwnerEmail openapi_types.Email `json:"owner_email"`
+}
+
+// PostTripsImportValidateJSONBody defines parameters for PostTripsImportValidate.
+type PostTripsImportValidateJSONBody map[string]interface{}
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Only activities with (true) or without (false) links.
+	HasLinks *bool `json:"has_links,omitempty"`
+
+	// Order of the activities: occurs_at or title, prefixed with - to sort descending. Defaults to JOURNEY_DEFAULT_SORT_ACTIVITIES.
+	Sort *string `json:"sort,omitempty"`
+
+	// Maximum number of activities to return, up to 200. Defaults to 50.
+	Limit *int `json:"limit,omitempty"`
+
+	// Number of activities to skip. Defaults to 0.
+	Offset *int `json:"offset,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PostTripsTripIDActivitiesBatchJSONBody defines parameters for PostTripsTripIDActivitiesBatch.
+type PostTripsTripIDActivitiesBatchJSONBody []CreateBatchActivityRequest
+
+// GetTripsTripIDActivitiesRecentParams defines parameters for GetTripsTripIDActivitiesRecent.
+type GetTripsTripIDActivitiesRecentParams struct {
+	// Only activities created after this time.
+	Since time.Time `json:"since"`
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONBody defines parameters for PutTripsTripIDActivitiesActivityID.
+type PutTripsTripIDActivitiesActivityIDJSONBody UpdateActivityRequest
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// GetTripsTripIDLinksParams defines parameters for GetTripsTripIDLinks.
+type GetTripsTripIDLinksParams struct {
+	// Order of the links: created_at or title, prefixed with - to sort descending. Defaults to JOURNEY_DEFAULT_SORT_LINKS.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PutTripsTripIDLinksLinkIDJSONBody defines parameters for PutTripsTripIDLinksLinkID.
+type PutTripsTripIDLinksLinkIDJSONBody UpdateLinkRequest
+
+// PostTripsTripIDPackingItemsJSONBody defines parameters for PostTripsTripIDPackingItems.
+type PostTripsTripIDPackingItemsJSONBody CreatePackingItemRequest
+
+// PutTripsTripIDPackingItemsItemIDJSONBody defines parameters for PutTripsTripIDPackingItemsItemID.
+type PutTripsTripIDPackingItemsItemIDJSONBody UpdatePackingItemRequest
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	// Order of the participants: created_at or email, prefixed with - to sort descending. Defaults to JOURNEY_DEFAULT_SORT_PARTICIPANTS.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// GetTripsTripIDSnapshotsDiffParams defines parameters for GetTripsTripIDSnapshotsDiff.
+type GetTripsTripIDSnapshotsDiffParams struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// PostTripsTripIDWebhooksJSONBody defines parameters for PostTripsTripIDWebhooks.
+type PostTripsTripIDWebhooksJSONBody WebhookSubscriptionRequest
+
+// PutTripsTripIDWebhooksWebhookIDJSONBody defines parameters for PutTripsTripIDWebhooksWebhookID.
+type PutTripsTripIDWebhooksWebhookIDJSONBody WebhookSubscriptionRequest
+
+// PatchParticipantsParticipantIDGroupJSONRequestBody defines body for PatchParticipantsParticipantIDGroup for application/json ContentType.
+type PatchParticipantsParticipantIDGroupJSONRequestBody PatchParticipantsParticipantIDGroupJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDGroupJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchParticipantsParticipantIDOrganizerJSONRequestBody defines body for PatchParticipantsParticipantIDOrganizer for application/json ContentType.
+type PatchParticipantsParticipantIDOrganizerJSONRequestBody PatchParticipantsParticipantIDOrganizerJSONBody
+
+// Bind implements render.Binder.
+func (PatchParticipantsParticipantIDOrganizerJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostParticipantsParticipantIDUnavailabilitiesJSONRequestBody defines body for PostParticipantsParticipantIDUnavailabilities for application/json ContentType.
+type PostParticipantsParticipantIDUnavailabilitiesJSONRequestBody PostParticipantsParticipantIDUnavailabilitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostParticipantsParticipantIDUnavailabilitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsImportValidateJSONRequestBody defines body for PostTripsImportValidate for application/json ContentType.
+type PostTripsImportValidateJSONRequestBody PostTripsImportValidateJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsImportValidateJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesBatchJSONRequestBody defines body for PostTripsTripIDActivitiesBatch for application/json ContentType.
+type PostTripsTripIDActivitiesBatchJSONRequestBody PostTripsTripIDActivitiesBatchJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesBatchJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDJSONRequestBody defines body for PutTripsTripIDActivitiesActivityID for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDJSONRequestBody PutTripsTripIDActivitiesActivityIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLinksLinkIDJSONRequestBody defines body for PutTripsTripIDLinksLinkID for application/json ContentType.
+type PutTripsTripIDLinksLinkIDJSONRequestBody PutTripsTripIDLinksLinkIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLinksLinkIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDPackingItemsJSONRequestBody defines body for PostTripsTripIDPackingItems for application/json ContentType.
+type PostTripsTripIDPackingItemsJSONRequestBody PostTripsTripIDPackingItemsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDPackingItemsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDPackingItemsItemIDJSONRequestBody defines body for PutTripsTripIDPackingItemsItemID for application/json ContentType.
+type PutTripsTripIDPackingItemsItemIDJSONRequestBody PutTripsTripIDPackingItemsItemIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDPackingItemsItemIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDWebhooksJSONRequestBody defines body for PostTripsTripIDWebhooks for application/json ContentType.
+type PostTripsTripIDWebhooksJSONRequestBody PostTripsTripIDWebhooksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDWebhooksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDWebhooksWebhookIDJSONRequestBody defines body for PutTripsTripIDWebhooksWebhookID for application/json ContentType.
+type PutTripsTripIDWebhooksWebhookIDJSONRequestBody PutTripsTripIDWebhooksWebhookIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDWebhooksWebhookIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// GetActivitiesOnDateJSON200Response is a constructor method for a GetActivitiesOnDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesOnDateJSON200Response(body GetActivitiesOnDateResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetActivitiesOnDateJSON400Response is a constructor method for a GetActivitiesOnDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetActivitiesOnDateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminEagerParticipantsJSON200Response is a constructor method for a GetAdminEagerParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminEagerParticipantsJSON200Response(body GetEagerParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminEagerParticipantsJSON400Response is a constructor method for a GetAdminEagerParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminEagerParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminEagerParticipantsJSON403Response is a constructor method for a GetAdminEagerParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminEagerParticipantsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsTripsPerDayJSON200Response is a constructor method for a GetAdminStatsTripsPerDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsTripsPerDayJSON200Response(body GetTripsPerDayResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsTripsPerDayJSON400Response is a constructor method for a GetAdminStatsTripsPerDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsTripsPerDayJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsTripsPerDayJSON403Response is a constructor method for a GetAdminStatsTripsPerDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsTripsPerDayJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminWebhookDeliveriesDeliveryIDReplayJSON200Response is a constructor method for a PostAdminWebhookDeliveriesDeliveryIDReplay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminWebhookDeliveriesDeliveryIDReplayJSON200Response(body ReplayWebhookDeliveryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response is a constructor method for a PostAdminWebhookDeliveriesDeliveryIDReplay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminWebhookDeliveriesDeliveryIDReplayJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminWebhookDeliveriesDeliveryIDReplayJSON403Response is a constructor method for a PostAdminWebhookDeliveriesDeliveryIDReplay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminWebhookDeliveriesDeliveryIDReplayJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteOwnersEmailJSON204Response is a constructor method for a DeleteOwnersEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteOwnersEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteOwnersEmailJSON400Response is a constructor method for a DeleteOwnersEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteOwnersEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteOwnersEmailJSON403Response is a constructor method for a DeleteOwnersEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteOwnersEmailJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteOwnersEmailJSON500Response is a constructor method for a DeleteOwnersEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteOwnersEmailJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailConfirmationRateJSON200Response is a constructor method for a GetOwnersEmailConfirmationRate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailConfirmationRateJSON200Response(body GetOwnerConfirmationRateResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailConfirmationRateJSON400Response is a constructor method for a GetOwnersEmailConfirmationRate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailConfirmationRateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailDestinationsJSON200Response is a constructor method for a GetOwnersEmailDestinations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailDestinationsJSON200Response(body GetOwnerDestinationsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailDestinationsJSON400Response is a constructor method for a GetOwnersEmailDestinations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailDestinationsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailExportJSON400Response is a constructor method for a GetOwnersEmailExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailExportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetOwnersEmailExportJSON403Response is a constructor method for a GetOwnersEmailExport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOwnersEmailExportJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsJSON200Response is a constructor method for a GetParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsJSON200Response(body GetParticipantTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsJSON400Response is a constructor method for a GetParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsConfirmValidateJSON200Response is a constructor method for a GetParticipantsConfirmValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsConfirmValidateJSON200Response(body ValidateConfirmationTokenResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsConfirmValidateJSON400Response is a constructor method for a GetParticipantsConfirmValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsConfirmValidateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON410Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON410Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        410,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDGroupJSON204Response is a constructor method for a PatchParticipantsParticipantIDGroup response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDGroupJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDGroupJSON400Response is a constructor method for a PatchParticipantsParticipantIDGroup response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDGroupJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDGroupJSON403Response is a constructor method for a PatchParticipantsParticipantIDGroup response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDGroupJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDOrganizerJSON204Response is a constructor method for a PatchParticipantsParticipantIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDOrganizerJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDOrganizerJSON400Response is a constructor method for a PatchParticipantsParticipantIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDOrganizerJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDOrganizerJSON403Response is a constructor method for a PatchParticipantsParticipantIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDOrganizerJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDReissueInviteJSON204Response is a constructor method for a PostParticipantsParticipantIDReissueInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDReissueInviteJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDReissueInviteJSON400Response is a constructor method for a PostParticipantsParticipantIDReissueInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDReissueInviteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDReissueInviteJSON403Response is a constructor method for a PostParticipantsParticipantIDReissueInvite response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDReissueInviteJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDUnavailabilitiesJSON201Response is a constructor method for a PostParticipantsParticipantIDUnavailabilities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDUnavailabilitiesJSON201Response(body CreateUnavailabilityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDUnavailabilitiesJSON400Response is a constructor method for a PostParticipantsParticipantIDUnavailabilities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDUnavailabilitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDUnavailabilitiesJSON403Response is a constructor method for a PostParticipantsParticipantIDUnavailabilities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDUnavailabilitiesJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDUnavailabilitiesJSON422Response is a constructor method for a PostParticipantsParticipantIDUnavailabilities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDUnavailabilitiesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON200Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON200Response(body GetTripsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsJSON400Response is a constructor method for a GetTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON409Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON422Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsByMonthJSON200Response is a constructor method for a GetTripsByMonth response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsByMonthJSON200Response(body GetTripsByMonthResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsByMonthJSON400Response is a constructor method for a GetTripsByMonth response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsByMonthJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportValidateJSON200Response is a constructor method for a PostTripsImportValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportValidateJSON200Response(body ValidateTripBundleResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportValidateJSON400Response is a constructor method for a PostTripsImportValidate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportValidateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON204Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON400Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON403Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON404Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDJSON500Response is a constructor method for a DeleteTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDJSON500Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        500,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON403Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON422Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// HeadTripsTripIDActivitiesJSON400Response is a constructor method for a HeadTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func HeadTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON409Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON422Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON201Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON201Response(body CreateActivitiesBatchResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON400Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON404Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON409Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesBatchJSON422Response is a constructor method for a PostTripsTripIDActivitiesBatch response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesBatchJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesFullcalendarJSON200Response is a constructor method for a GetTripsTripIDActivitiesFullcalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesFullcalendarJSON200Response(body []FullCalendarEvent) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesFullcalendarJSON400Response is a constructor method for a GetTripsTripIDActivitiesFullcalendar response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesFullcalendarJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportIcsJSON201Response is a constructor method for a PostTripsTripIDActivitiesImportIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportIcsJSON201Response(body ImportActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesImportIcsJSON400Response is a constructor method for a PostTripsTripIDActivitiesImportIcs response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesImportIcsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesRecentJSON200Response is a constructor method for a GetTripsTripIDActivitiesRecent response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesRecentJSON200Response(body GetRecentActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesRecentJSON400Response is a constructor method for a GetTripsTripIDActivitiesRecent response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesRecentJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesRecentJSON404Response is a constructor method for a GetTripsTripIDActivitiesRecent response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesRecentJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON200Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON200Response(body GetTripActivitiesResponseInnerArray) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a GetTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a PutTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDJSON422Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDAttachmentsJSON201Response is a constructor method for a PostTripsTripIDActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDAttachmentsJSON201Response(body CreateActivityAttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response is a constructor method for a PostTripsTripIDActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDAttachmentsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDAttachmentsJSON403Response is a constructor method for a PostTripsTripIDActivitiesActivityIDAttachments response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDAttachmentsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response is a constructor method for a GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response is a constructor method for a PutTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBusiestDayJSON200Response is a constructor method for a GetTripsTripIDBusiestDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBusiestDayJSON200Response(body GetTripBusiestDayResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBusiestDayJSON204Response is a constructor method for a GetTripsTripIDBusiestDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBusiestDayJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBusiestDayJSON400Response is a constructor method for a GetTripsTripIDBusiestDay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBusiestDayJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCardJSON200Response is a constructor method for a GetTripsTripIDCard response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCardJSON200Response(body GetTripCardResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDCardJSON400Response is a constructor method for a GetTripsTripIDCard response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDCardJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationEmailJSON200Response is a constructor method for a GetTripsTripIDConfirmationEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationEmailJSON200Response(body GetTripConfirmationEmailResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationEmailJSON400Response is a constructor method for a GetTripsTripIDConfirmationEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationEmailJSON403Response is a constructor method for a GetTripsTripIDConfirmationEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationEmailJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON200Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON200Response(body GetTripConflictsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON400Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateWindowsJSON200Response is a constructor method for a GetTripsTripIDDateWindows response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateWindowsJSON200Response(body GetTripDateWindowsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateWindowsJSON400Response is a constructor method for a GetTripsTripIDDateWindows response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateWindowsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDateWindowsJSON404Response is a constructor method for a GetTripsTripIDDateWindows response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDateWindowsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDaysDateJSON200Response is a constructor method for a GetTripsTripIDDaysDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDaysDateJSON200Response(body GetTripDayResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDaysDateJSON400Response is a constructor method for a GetTripsTripIDDaysDate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDaysDateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailStatusJSON200Response is a constructor method for a GetTripsTripIDEmailStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailStatusJSON200Response(body GetTripEmailStatusResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailStatusJSON400Response is a constructor method for a GetTripsTripIDEmailStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailStatusJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDEmailStatusJSON404Response is a constructor method for a GetTripsTripIDEmailStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDEmailStatusJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDFinalizeJSON204Response is a constructor method for a PostTripsTripIDFinalize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDFinalizeJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDFinalizeJSON400Response is a constructor method for a PostTripsTripIDFinalize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDFinalizeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDFinalizeJSON403Response is a constructor method for a PostTripsTripIDFinalize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDFinalizeJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON200Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON200Response(body GetTripGapsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON400Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON409Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// HeadTripsTripIDLinksJSON400Response is a constructor method for a HeadTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func HeadTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON409Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksByDomainJSON200Response is a constructor method for a GetTripsTripIDLinksByDomain response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksByDomainJSON200Response(body GetLinksByDomainResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksByDomainJSON400Response is a constructor method for a GetTripsTripIDLinksByDomain response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksByDomainJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksByDomainJSON404Response is a constructor method for a GetTripsTripIDLinksByDomain response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksByDomainJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON403Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON404Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON204Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON400Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON403Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLinksLinkIDJSON404Response is a constructor method for a PutTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingItemsJSON200Response is a constructor method for a GetTripsTripIDPackingItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingItemsJSON200Response(body GetTripPackingItemsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPackingItemsJSON400Response is a constructor method for a GetTripsTripIDPackingItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPackingItemsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingItemsJSON201Response is a constructor method for a PostTripsTripIDPackingItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingItemsJSON201Response(body CreatePackingItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDPackingItemsJSON400Response is a constructor method for a PostTripsTripIDPackingItems response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDPackingItemsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemsItemIDJSON204Response is a constructor method for a DeleteTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemsItemIDJSON400Response is a constructor method for a DeleteTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemsItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDPackingItemsItemIDJSON403Response is a constructor method for a DeleteTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDPackingItemsItemIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemsItemIDJSON204Response is a constructor method for a PutTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemsItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemsItemIDJSON400Response is a constructor method for a PutTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemsItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDPackingItemsItemIDJSON403Response is a constructor method for a PutTripsTripIDPackingItemsItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDPackingItemsItemIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDPackingItemsItemIDToggleJSON204Response is a constructor method for a PatchTripsTripIDPackingItemsItemIDToggle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDPackingItemsItemIDToggleJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDPackingItemsItemIDToggleJSON400Response is a constructor method for a PatchTripsTripIDPackingItemsItemIDToggle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDPackingItemsItemIDToggleJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDPackingItemsItemIDToggleJSON403Response is a constructor method for a PatchTripsTripIDPackingItemsItemIDToggle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDPackingItemsItemIDToggleJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// HeadTripsTripIDParticipantsJSON400Response is a constructor method for a HeadTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func HeadTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsAnalyticsJSON200Response is a constructor method for a GetTripsTripIDParticipantsAnalytics response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsAnalyticsJSON200Response(body GetTripParticipantsAnalyticsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsAnalyticsJSON400Response is a constructor method for a GetTripsTripIDParticipantsAnalytics response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsAnalyticsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsGroupedJSON200Response is a constructor method for a GetTripsTripIDParticipantsGrouped response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsGroupedJSON200Response(body GetTripParticipantGroupsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsGroupedJSON400Response is a constructor method for a GetTripsTripIDParticipantsGrouped response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsGroupedJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsImportCsvJSON201Response is a constructor method for a PostTripsTripIDParticipantsImportCsv response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsImportCsvJSON201Response(body ImportParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsImportCsvJSON400Response is a constructor method for a PostTripsTripIDParticipantsImportCsv response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsImportCsvJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsImportCsvJSON409Response is a constructor method for a PostTripsTripIDParticipantsImportCsv response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsImportCsvJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsNotEmailedJSON200Response is a constructor method for a GetTripsTripIDParticipantsNotEmailed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsNotEmailedJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsNotEmailedJSON400Response is a constructor method for a GetTripsTripIDParticipantsNotEmailed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsNotEmailedJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON403Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDParticipantsParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDParticipantsParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDParticipantsParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDRestoreJSON204Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDRestoreJSON403Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDRestoreJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsParticipantIDRestoreJSON409Response is a constructor method for a PostTripsTripIDParticipantsParticipantIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsParticipantIDRestoreJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPrintJSON400Response is a constructor method for a GetTripsTripIDPrint response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPrintJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRequestReconfirmationJSON204Response is a constructor method for a PostTripsTripIDRequestReconfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRequestReconfirmationJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRequestReconfirmationJSON400Response is a constructor method for a PostTripsTripIDRequestReconfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRequestReconfirmationJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRequestReconfirmationJSON403Response is a constructor method for a PostTripsTripIDRequestReconfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRequestReconfirmationJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDResendConfirmationJSON202Response is a constructor method for a PostTripsTripIDResendConfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDResendConfirmationJSON202Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        202,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDResendConfirmationJSON400Response is a constructor method for a PostTripsTripIDResendConfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDResendConfirmationJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDResendConfirmationJSON404Response is a constructor method for a PostTripsTripIDResendConfirmation response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDResendConfirmationJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSnapshotsJSON200Response is a constructor method for a GetTripsTripIDSnapshots response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSnapshotsJSON200Response(body GetTripSnapshotsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSnapshotsJSON400Response is a constructor method for a GetTripsTripIDSnapshots response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSnapshotsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsJSON201Response is a constructor method for a PostTripsTripIDSnapshots response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsJSON201Response(body CreateTripSnapshotResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsJSON400Response is a constructor method for a PostTripsTripIDSnapshots response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsJSON403Response is a constructor method for a PostTripsTripIDSnapshots response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSnapshotsDiffJSON200Response is a constructor method for a GetTripsTripIDSnapshotsDiff response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSnapshotsDiffJSON200Response(body GetSnapshotDiffResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSnapshotsDiffJSON400Response is a constructor method for a GetTripsTripIDSnapshotsDiff response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSnapshotsDiffJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsSnapshotIDRestoreJSON204Response is a constructor method for a PostTripsTripIDSnapshotsSnapshotIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsSnapshotIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response is a constructor method for a PostTripsTripIDSnapshotsSnapshotIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsSnapshotIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSnapshotsSnapshotIDRestoreJSON403Response is a constructor method for a PostTripsTripIDSnapshotsSnapshotIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSnapshotsSnapshotIDRestoreJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON200Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON200Response(body GetTripWebhooksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON400Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWebhooksJSON403Response is a constructor method for a GetTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWebhooksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON201Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON201Response(body CreateWebhookSubscriptionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON400Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDWebhooksJSON403Response is a constructor method for a PostTripsTripIDWebhooks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDWebhooksJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDWebhooksWebhookIDJSON204Response is a constructor method for a DeleteTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDWebhooksWebhookIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDWebhooksWebhookIDJSON400Response is a constructor method for a DeleteTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDWebhooksWebhookIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDWebhooksWebhookIDJSON403Response is a constructor method for a DeleteTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDWebhooksWebhookIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDWebhooksWebhookIDJSON204Response is a constructor method for a PutTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDWebhooksWebhookIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDWebhooksWebhookIDJSON400Response is a constructor method for a PutTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDWebhooksWebhookIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDWebhooksWebhookIDJSON403Response is a constructor method for a PutTripsTripIDWebhooksWebhookID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDWebhooksWebhookIDJSON403Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        403,
+		contentType: "application/json",
+	}
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List an owner's activities on a date across all their trips.
+	// (GET /activities/on/{date})
+	GetActivitiesOnDate(w http.ResponseWriter, r *http.Request, date string, params GetActivitiesOnDateParams) *Response
+	// List the confirmed participants of trips not confirmed yet.
+	// (GET /admin/eager-participants)
+	GetAdminEagerParticipants(w http.ResponseWriter, r *http.Request) *Response
+	// Count the trips created per day.
+	// (GET /admin/stats/trips-per-day)
+	GetAdminStatsTripsPerDay(w http.ResponseWriter, r *http.Request, params GetAdminStatsTripsPerDayParams) *Response
+	// Attempt a failed webhook delivery again.
+	// (POST /admin/webhook-deliveries/{deliveryId}/replay)
+	PostAdminWebhookDeliveriesDeliveryIDReplay(w http.ResponseWriter, r *http.Request, deliveryID string) *Response
+	// Erase all of an owner's data.
+	// (DELETE /owners/{email})
+	DeleteOwnersEmail(w http.ResponseWriter, r *http.Request, email openapi_types.Email) *Response
+	// Get the share of invited participants who confirmed across an owner's trips.
+	// (GET /owners/{email}/confirmation-rate)
+	GetOwnersEmailConfirmationRate(w http.ResponseWriter, r *http.Request, email openapi_types.Email) *Response
+	// List the distinct destinations of an owner's trips.
+	// (GET /owners/{email}/destinations)
+	GetOwnersEmailDestinations(w http.ResponseWriter, r *http.Request, email openapi_types.Email) *Response
+	// Export all of an owner's trips as a zip archive.
+	// (GET /owners/{email}/export)
+	GetOwnersEmailExport(w http.ResponseWriter, r *http.Request, email openapi_types.Email) *Response
+	// List the trips an email is a participant of.
+	// (GET /participants)
+	GetParticipants(w http.ResponseWriter, r *http.Request, params GetParticipantsParams) *Response
+	// Check a participant confirmation token without confirming.
+	// (GET /participants/confirm/validate)
+	GetParticipantsConfirmValidate(w http.ResponseWriter, r *http.Request, params GetParticipantsConfirmValidateParams) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Put a participant in a group, or take them out of it.
+	// (PATCH /participants/{participantId}/group)
+	PatchParticipantsParticipantIDGroup(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Promote or demote a participant as a trip organizer.
+	// (PATCH /participants/{participantId}/organizer)
+	PatchParticipantsParticipantIDOrganizer(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Reissue a participant invite, extending its expiration.
+	// (POST /participants/{participantId}/reissue-invite)
+	PostParticipantsParticipantIDReissueInvite(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Mark a period when a participant is unavailable.
+	// (POST /participants/{participantId}/unavailabilities)
+	PostParticipantsParticipantIDUnavailabilities(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// List trips.
+	// (GET /trips)
+	GetTrips(w http.ResponseWriter, r *http.Request, params GetTripsParams) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request) *Response
+	// List an owner's trips grouped by the month they start.
+	// (GET /trips/by-month)
+	GetTripsByMonth(w http.ResponseWriter, r *http.Request, params GetTripsByMonthParams) *Response
+	// Validate a trip export bundle without importing it.
+	// (POST /trips/import/validate)
+	PostTripsImportValidate(w http.ResponseWriter, r *http.Request) *Response
+	// Delete a trip with its activities, links and participants.
+	// (DELETE /trips/{tripId})
+	DeleteTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Count a trip activities.
+	// (HEAD /trips/{tripId}/activities)
+	HeadTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create many trip activities at once.
+	// (POST /trips/{tripId}/activities/batch)
+	PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip activities as FullCalendar events.
+	// (GET /trips/{tripId}/activities/fullcalendar)
+	GetTripsTripIDActivitiesFullcalendar(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Import trip activities from an .ics file.
+	// (POST /trips/{tripId}/activities/import-ics)
+	PostTripsTripIDActivitiesImportIcs(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the activities recently added to a trip.
+	// (GET /trips/{tripId}/activities/recent)
+	GetTripsTripIDActivitiesRecent(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesRecentParams) *Response
+	// Delete a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Get a trip activity.
+	// (GET /trips/{tripId}/activities/{activityId})
+	GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Update a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId})
+	PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Attach a file to a trip activity.
+	// (POST /trips/{tripId}/activities/{activityId}/attachments)
+	PostTripsTripIDActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Download a trip activity attachment.
+	// (GET /trips/{tripId}/activities/{activityId}/attachments/{attachmentId})
+	GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, attachmentID string) *Response
+	// Unassign a participant from a trip activity.
+	// (DELETE /trips/{tripId}/activities/{activityId}/participants/{participantId})
+	DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *Response
+	// Assign a participant to a trip activity.
+	// (PUT /trips/{tripId}/activities/{activityId}/participants/{participantId})
+	PutTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, activityID string, participantID string) *Response
+	// Get the trip day with the most activities.
+	// (GET /trips/{tripId}/busiest-day)
+	GetTripsTripIDBusiestDay(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a minimal trip card for embedding.
+	// (GET /trips/{tripId}/card)
+	GetTripsTripIDCard(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the rendered trip confirmation email, for sending it elsewhere.
+	// (GET /trips/{tripId}/confirmation-email)
+	GetTripsTripIDConfirmationEmail(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the activities that happen while a participant is unavailable.
+	// (GET /trips/{tripId}/conflicts)
+	GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// List the days of a trip.
+	// (GET /trips/{tripId}/date-windows)
+	GetTripsTripIDDateWindows(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the schedule of a trip day.
+	// (GET /trips/{tripId}/days/{date})
+	GetTripsTripIDDaysDate(w http.ResponseWriter, r *http.Request, tripID string, date string) *Response
+	// Get the status of the trip confirmation email.
+	// (GET /trips/{tripId}/email-status)
+	GetTripsTripIDEmailStatus(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a trip and all of its participants at once.
+	// (POST /trips/{tripId}/finalize)
+	PostTripsTripIDFinalize(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the free time between consecutive activities of each trip day.
+	// (GET /trips/{tripId}/gaps)
+	GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDLinksParams) *Response
+	// Count a trip links.
+	// (HEAD /trips/{tripId}/links)
+	HeadTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links grouped by domain.
+	// (GET /trips/{tripId}/links/by-domain)
+	GetTripsTripIDLinksByDomain(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip link.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Update a trip link.
+	// (PUT /trips/{tripId}/links/{linkId})
+	PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Get a trip packing list.
+	// (GET /trips/{tripId}/packing-items)
+	GetTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add an item to a trip packing list.
+	// (POST /trips/{tripId}/packing-items)
+	PostTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a packing item.
+	// (DELETE /trips/{tripId}/packing-items/{itemId})
+	DeleteTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Update a packing item.
+	// (PUT /trips/{tripId}/packing-items/{itemId})
+	PutTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Toggle whether a packing item is packed.
+	// (PATCH /trips/{tripId}/packing-items/{itemId}/toggle)
+	PatchTripsTripIDPackingItemsItemIDToggle(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+	// Count a trip participants.
+	// (HEAD /trips/{tripId}/participants)
+	HeadTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get how long each trip participant took to confirm.
+	// (GET /trips/{tripId}/participants/analytics)
+	GetTripsTripIDParticipantsAnalytics(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip participants grouped by their group.
+	// (GET /trips/{tripId}/participants/grouped)
+	GetTripsTripIDParticipantsGrouped(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Invite participants from a .csv file.
+	// (POST /trips/{tripId}/participants/import-csv)
+	PostTripsTripIDParticipantsImportCsv(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the trip participants whose invite was never emailed.
+	// (GET /trips/{tripId}/participants/not-emailed)
+	GetTripsTripIDParticipantsNotEmailed(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a participant from a trip, restorable for a while.
+	// (DELETE /trips/{tripId}/participants/{participantId})
+	DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Restore a recently removed trip participant.
+	// (POST /trips/{tripId}/participants/{participantId}/restore)
+	PostTripsTripIDParticipantsParticipantIDRestore(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Get a printable itinerary of a trip.
+	// (GET /trips/{tripId}/print)
+	GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Ask every participant to confirm the trip again.
+	// (POST /trips/{tripId}/request-reconfirmation)
+	PostTripsTripIDRequestReconfirmation(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Send the trip confirmation email to the owner again.
+	// (POST /trips/{tripId}/resend-confirmation)
+	PostTripsTripIDResendConfirmation(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip snapshots.
+	// (GET /trips/{tripId}/snapshots)
+	GetTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Snapshot the current state of a trip.
+	// (POST /trips/{tripId}/snapshots)
+	PostTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get what changed in a trip between two of its snapshots.
+	// (GET /trips/{tripId}/snapshots/diff)
+	GetTripsTripIDSnapshotsDiff(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDSnapshotsDiffParams) *Response
+	// Restore a trip to the state of a snapshot.
+	// (POST /trips/{tripId}/snapshots/{snapshotId}/restore)
+	PostTripsTripIDSnapshotsSnapshotIDRestore(w http.ResponseWriter, r *http.Request, tripID string, snapshotID string) *Response
+	// List the trip webhook subscriptions.
+	// (GET /trips/{tripId}/webhooks)
+	GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Subscribe a URL to trip events.
+	// (POST /trips/{tripId}/webhooks)
+	PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a trip webhook subscription.
+	// (DELETE /trips/{tripId}/webhooks/{webhookId})
+	DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *Response
+	// Update a trip webhook subscription.
+	// (PUT /trips/{tripId}/webhooks/{webhookId})
+	PutTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request, tripID string, webhookID string) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// GetActivitiesOnDate operation middleware
+func (siw *ServerInterfaceWrapper) GetActivitiesOnDate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "date" -------------
+	var date string
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetActivitiesOnDateParams
+
+	// ------------- Required query parameter "owner_email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "owner_email", r.URL.Query(), &params.OwnerEmail); err != nil {
+		err = fmt.Errorf("invalid format for parameter owner_email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "owner_email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetActivitiesOnDate(w, r, date, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminEagerParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminEagerParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminEagerParticipants(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminStatsTripsPerDay operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStatsTripsPerDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminStatsTripsPerDayParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From); err != nil {
+		err = fmt.Errorf("invalid format for parameter from: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "from"})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To); err != nil {
+		err = fmt.Errorf("invalid format for parameter to: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "to"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminStatsTripsPerDay(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminWebhookDeliveriesDeliveryIDReplay operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWebhookDeliveriesDeliveryIDReplay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryID string
+
+	if err := runtime.BindStyledParameter("simple", false, "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "deliveryId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminWebhookDeliveriesDeliveryIDReplay(w, r, deliveryID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteOwnersEmail operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOwnersEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "email" -------------
+	var email openapi_types.Email
+
+	if err := runtime.BindStyledParameter("simple", false, "email", chi.URLParam(r, "email"), &email); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteOwnersEmail(w, r, email)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOwnersEmailConfirmationRate operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnersEmailConfirmationRate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "email" -------------
+	var email openapi_types.Email
+
+	if err := runtime.BindStyledParameter("simple", false, "email", chi.URLParam(r, "email"), &email); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetOwnersEmailConfirmationRate(w, r, email)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOwnersEmailDestinations operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnersEmailDestinations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "email" -------------
+	var email openapi_types.Email
+
+	if err := runtime.BindStyledParameter("simple", false, "email", chi.URLParam(r, "email"), &email); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetOwnersEmailDestinations(w, r, email)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOwnersEmailExport operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnersEmailExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "email" -------------
+	var email openapi_types.Email
+
+	if err := runtime.BindStyledParameter("simple", false, "email", chi.URLParam(r, "email"), &email); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetOwnersEmailExport(w, r, email)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsParams
+
+	// ------------- Required query parameter "email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "email", r.URL.Query(), &params.Email); err != nil {
+		err = fmt.Errorf("invalid format for parameter email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "email"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset); err != nil {
+		err = fmt.Errorf("invalid format for parameter offset: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "offset"})
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived); err != nil {
+		err = fmt.Errorf("invalid format for parameter archived: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "archived"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipants(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsConfirmValidate operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsConfirmValidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetParticipantsConfirmValidateParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token); err != nil {
+		err = fmt.Errorf("invalid format for parameter token: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsConfirmValidate(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDGroup operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDGroup(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDOrganizer operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDOrganizer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDOrganizer(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDReissueInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDReissueInvite(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDReissueInvite(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDUnavailabilities operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDUnavailabilities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDUnavailabilities(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTrips operation middleware
+func (siw *ServerInterfaceWrapper) GetTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsParams
+
+	// ------------- Optional query parameter "all_confirmed" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "all_confirmed", r.URL.Query(), &params.AllConfirmed); err != nil {
+		err = fmt.Errorf("invalid format for parameter all_confirmed: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "all_confirmed"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "destination" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "destination", r.URL.Query(), &params.Destination); err != nil {
+		err = fmt.Errorf("invalid format for parameter destination: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "destination"})
+		return
+	}
+
+	// ------------- Optional query parameter "starts_after" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "starts_after", r.URL.Query(), &params.StartsAfter); err != nil {
+		err = fmt.Errorf("invalid format for parameter starts_after: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "starts_after"})
+		return
+	}
+
+	// ------------- Optional query parameter "ends_before" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "ends_before", r.URL.Query(), &params.EndsBefore); err != nil {
+		err = fmt.Errorf("invalid format for parameter ends_before: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "ends_before"})
+		return
+	}
+
+	// ------------- Optional query parameter "is_confirmed" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "is_confirmed", r.URL.Query(), &params.IsConfirmed); err != nil {
+		err = fmt.Errorf("invalid format for parameter is_confirmed: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "is_confirmed"})
+		return
+	}
+
+	// ------------- Optional query parameter "exact" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "exact", r.URL.Query(), &params.Exact); err != nil {
+		err = fmt.Errorf("invalid format for parameter exact: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "exact"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset); err != nil {
+		err = fmt.Errorf("invalid format for parameter offset: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "offset"})
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "archived", r.URL.Query(), &params.Archived); err != nil {
+		err = fmt.Errorf("invalid format for parameter archived: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "archived"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsByMonth operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsByMonth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsByMonthParams
+
+	// ------------- Required query parameter "owner_email" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "owner_email", r.URL.Query(), &params.OwnerEmail); err != nil {
+		err = fmt.Errorf("invalid format for parameter owner_email: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "owner_email"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsByMonth(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsImportValidate operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsImportValidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsImportValidate(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "has_links" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "has_links", r.URL.Query(), &params.HasLinks); err != nil {
+		err = fmt.Errorf("invalid format for parameter has_links: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "has_links"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset); err != nil {
+		err = fmt.Errorf("invalid format for parameter offset: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "offset"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// HeadTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) HeadTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.HeadTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesBatch(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesFullcalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesFullcalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesFullcalendar(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesImportIcs operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesImportIcs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesImportIcs(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesRecent operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesRecent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesRecentParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since); err != nil {
+		err = fmt.Errorf("invalid format for parameter since: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "since"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesRecent(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesActivityIDAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesActivityIDAttachments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesActivityIDAttachments(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID(w, r, tripID, activityID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID(w, r, tripID, activityID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityIDParticipantsParticipantID(w, r, tripID, activityID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBusiestDay operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBusiestDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBusiestDay(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDCard operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDCard(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirmationEmail operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirmationEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirmationEmail(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConflicts operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConflicts(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDateWindows operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDateWindows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDateWindows(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDaysDate operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDaysDate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date string
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDaysDate(w, r, tripID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDEmailStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDEmailStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDEmailStatus(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDFinalize operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDFinalize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDFinalize(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDGaps operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDGaps(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDLinksParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// HeadTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) HeadTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.HeadTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinksByDomain operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinksByDomain(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinksByDomain(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPackingItems operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPackingItems(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDPackingItems operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDPackingItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDPackingItems(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDPackingItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDPackingItemsItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDPackingItemsItemID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDPackingItemsItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDPackingItemsItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDPackingItemsItemIDToggle operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDPackingItemsItemIDToggle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDPackingItemsItemIDToggle(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// HeadTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) HeadTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.HeadTripsTripIDParticipants(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDParticipantsAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsAnalytics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipantsAnalytics(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
-}
+// GetTripsTripIDParticipantsGrouped operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsGrouped(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipantsGrouped(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// PostTripsTripIDParticipantsImportCsv operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsImportCsv(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDParticipantsImportCsv(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// GetTripsTripIDParticipantsNotEmailed operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipantsNotEmailed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipantsNotEmailed(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// DeleteTripsTripIDParticipantsParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDParticipantsParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDParticipantsParticipantID(w, r, tripID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDParticipantsParticipantIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsParticipantIDRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
 	// ------------- Path parameter "participantId" -------------
 	var participantID string
 
@@ -506,7 +5657,7 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.PostTripsTripIDParticipantsParticipantIDRestore(w, r, tripID, participantID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -519,12 +5670,20 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDPrint operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.GetTripsTripIDPrint(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -537,8 +5696,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDRequestReconfirmation operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDRequestReconfirmation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -550,7 +5709,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDRequestReconfirmation(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -563,8 +5722,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDResendConfirmation operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDResendConfirmation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -576,7 +5735,7 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDResendConfirmation(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -589,8 +5748,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -602,7 +5761,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDSnapshots(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -615,8 +5774,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDSnapshots(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -628,7 +5787,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDSnapshots(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +5800,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDSnapshotsDiff operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSnapshotsDiff(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -653,8 +5812,27 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDSnapshotsDiffParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "from", r.URL.Query(), &params.From); err != nil {
+		err = fmt.Errorf("invalid format for parameter from: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "from"})
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "to", r.URL.Query(), &params.To); err != nil {
+		err = fmt.Errorf("invalid format for parameter to: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "to"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDSnapshotsDiff(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -667,8 +5845,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDSnapshotsSnapshotIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDSnapshotsSnapshotIDRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -679,8 +5857,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotID string
+
+	if err := runtime.BindStyledParameter("simple", false, "snapshotId", chi.URLParam(r, "snapshotId"), &snapshotID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "snapshotId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDSnapshotsSnapshotIDRestore(w, r, tripID, snapshotID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -693,8 +5879,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -706,7 +5892,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWebhooks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +5905,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDWebhooks(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -732,7 +5918,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDWebhooks(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -745,8 +5931,42 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// DeleteTripsTripIDWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDWebhooksWebhookID(w, r, tripID, webhookID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDWebhooksWebhookID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -757,8 +5977,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "webhookId" -------------
+	var webhookID string
+
+	if err := runtime.BindStyledParameter("simple", false, "webhookId", chi.URLParam(r, "webhookId"), &webhookID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "webhookId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.PutTripsTripIDWebhooksWebhookID(w, r, tripID, webhookID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -886,17 +6114,83 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/activities/on/{date}", wrapper.GetActivitiesOnDate)
+		r.Get("/admin/eager-participants", wrapper.GetAdminEagerParticipants)
+		r.Get("/admin/stats/trips-per-day", wrapper.GetAdminStatsTripsPerDay)
+		r.Post("/admin/webhook-deliveries/{deliveryId}/replay", wrapper.PostAdminWebhookDeliveriesDeliveryIDReplay)
+		r.Delete("/owners/{email}", wrapper.DeleteOwnersEmail)
+		r.Get("/owners/{email}/confirmation-rate", wrapper.GetOwnersEmailConfirmationRate)
+		r.Get("/owners/{email}/destinations", wrapper.GetOwnersEmailDestinations)
+		r.Get("/owners/{email}/export", wrapper.GetOwnersEmailExport)
+		r.Get("/participants", wrapper.GetParticipants)
+		r.Get("/participants/confirm/validate", wrapper.GetParticipantsConfirmValidate)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Patch("/participants/{participantId}/group", wrapper.PatchParticipantsParticipantIDGroup)
+		r.Patch("/participants/{participantId}/organizer", wrapper.PatchParticipantsParticipantIDOrganizer)
+		r.Post("/participants/{participantId}/reissue-invite", wrapper.PostParticipantsParticipantIDReissueInvite)
+		r.Post("/participants/{participantId}/unavailabilities", wrapper.PostParticipantsParticipantIDUnavailabilities)
+		r.Get("/trips", wrapper.GetTrips)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Get("/trips/by-month", wrapper.GetTripsByMonth)
+		r.Post("/trips/import/validate", wrapper.PostTripsImportValidate)
+		r.Delete("/trips/{tripId}", wrapper.DeleteTripsTripID)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
+		r.Head("/trips/{tripId}/activities", wrapper.HeadTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Post("/trips/{tripId}/activities/batch", wrapper.PostTripsTripIDActivitiesBatch)
+		r.Get("/trips/{tripId}/activities/fullcalendar", wrapper.GetTripsTripIDActivitiesFullcalendar)
+		r.Post("/trips/{tripId}/activities/import-ics", wrapper.PostTripsTripIDActivitiesImportIcs)
+		r.Get("/trips/{tripId}/activities/recent", wrapper.GetTripsTripIDActivitiesRecent)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Get("/trips/{tripId}/activities/{activityId}", wrapper.GetTripsTripIDActivitiesActivityID)
+		r.Put("/trips/{tripId}/activities/{activityId}", wrapper.PutTripsTripIDActivitiesActivityID)
+		r.Post("/trips/{tripId}/activities/{activityId}/attachments", wrapper.PostTripsTripIDActivitiesActivityIDAttachments)
+		r.Get("/trips/{tripId}/activities/{activityId}/attachments/{attachmentId}", wrapper.GetTripsTripIDActivitiesActivityIDAttachmentsAttachmentID)
+		r.Delete("/trips/{tripId}/activities/{activityId}/participants/{participantId}", wrapper.DeleteTripsTripIDActivitiesActivityIDParticipantsParticipantID)
+		r.Put("/trips/{tripId}/activities/{activityId}/participants/{participantId}", wrapper.PutTripsTripIDActivitiesActivityIDParticipantsParticipantID)
+		r.Get("/trips/{tripId}/busiest-day", wrapper.GetTripsTripIDBusiestDay)
+		r.Get("/trips/{tripId}/card", wrapper.GetTripsTripIDCard)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/confirmation-email", wrapper.GetTripsTripIDConfirmationEmail)
+		r.Get("/trips/{tripId}/conflicts", wrapper.GetTripsTripIDConflicts)
+		r.Get("/trips/{tripId}/date-windows", wrapper.GetTripsTripIDDateWindows)
+		r.Get("/trips/{tripId}/days/{date}", wrapper.GetTripsTripIDDaysDate)
+		r.Get("/trips/{tripId}/email-status", wrapper.GetTripsTripIDEmailStatus)
+		r.Post("/trips/{tripId}/finalize", wrapper.PostTripsTripIDFinalize)
+		r.Get("/trips/{tripId}/gaps", wrapper.GetTripsTripIDGaps)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
+		r.Head("/trips/{tripId}/links", wrapper.HeadTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Get("/trips/{tripId}/links/by-domain", wrapper.GetTripsTripIDLinksByDomain)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Put("/trips/{tripId}/links/{linkId}", wrapper.PutTripsTripIDLinksLinkID)
+		r.Get("/trips/{tripId}/packing-items", wrapper.GetTripsTripIDPackingItems)
+		r.Post("/trips/{tripId}/packing-items", wrapper.PostTripsTripIDPackingItems)
+		r.Delete("/trips/{tripId}/packing-items/{itemId}", wrapper.DeleteTripsTripIDPackingItemsItemID)
+		r.Put("/trips/{tripId}/packing-items/{itemId}", wrapper.PutTripsTripIDPackingItemsItemID)
+		r.Patch("/trips/{tripId}/packing-items/{itemId}/toggle", wrapper.PatchTripsTripIDPackingItemsItemIDToggle)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Head("/trips/{tripId}/participants", wrapper.HeadTripsTripIDParticipants)
+		r.Get("/trips/{tripId}/participants/analytics", wrapper.GetTripsTripIDParticipantsAnalytics)
+		r.Get("/trips/{tripId}/participants/grouped", wrapper.GetTripsTripIDParticipantsGrouped)
+		r.Post("/trips/{tripId}/participants/import-csv", wrapper.PostTripsTripIDParticipantsImportCsv)
+		r.Get("/trips/{tripId}/participants/not-emailed", wrapper.GetTripsTripIDParticipantsNotEmailed)
+		r.Delete("/trips/{tripId}/participants/{participantId}", wrapper.DeleteTripsTripIDParticipantsParticipantID)
+		r.Post("/trips/{tripId}/participants/{participantId}/restore", wrapper.PostTripsTripIDParticipantsParticipantIDRestore)
+		r.Get("/trips/{tripId}/print", wrapper.GetTripsTripIDPrint)
+		r.Post("/trips/{tripId}/request-reconfirmation", wrapper.PostTripsTripIDRequestReconfirmation)
+		r.Post("/trips/{tripId}/resend-confirmation", wrapper.PostTripsTripIDResendConfirmation)
+		r.Get("/trips/{tripId}/snapshots", wrapper.GetTripsTripIDSnapshots)
+		r.Post("/trips/{tripId}/snapshots", wrapper.PostTripsTripIDSnapshots)
+		r.Get("/trips/{tripId}/snapshots/diff", wrapper.GetTripsTripIDSnapshotsDiff)
+		r.Post("/trips/{tripId}/snapshots/{snapshotId}/restore", wrapper.PostTripsTripIDSnapshotsSnapshotIDRestore)
+		r.Get("/trips/{tripId}/webhooks", wrapper.GetTripsTripIDWebhooks)
+		r.Post("/trips/{tripId}/webhooks", wrapper.PostTripsTripIDWebhooks)
+		r.Delete("/trips/{tripId}/webhooks/{webhookId}", wrapper.DeleteTripsTripIDWebhooksWebhookID)
+		r.Put("/trips/{tripId}/webhooks/{webhookId}", wrapper.PutTripsTripIDWebhooksWebhookID)
 	})
 	return r
 }
@@ -922,30 +6216,139 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Raz27bOBN/FYLfd5TjdDcnAXtom6LwItgGRRd7KIqAlsY2E4lUyZFTw/DT7GFPe9wn",
-	"yIstSMo2Jcs2rcSbOr20tiJy/vxmfjNDek4TmRdSgEBN4znVyQRyZj++VcAQXifIpxxnH+FrCRrNH1ia",
-	"cuRSsOxayQIUctA0HrFMQ0QL79GcyiQplb5hdt1Iqtx8oilD6CHPgUYUZwXQmGpUXIxpRL/1xrIH31Cx",
-	"HrKx3WTKMm6W0Jgq+FpyBSldLCKKHDMwL3TeYxGtv8WfPW2Xm39ZKSiHt5AgXUQbftGFFBoOdAyrlg/S",
-	"mmfKkqcbTmmq6a3drt8VF3fdMHu8WyNaqqxul+KdsY7MZhtYOS2dpH1e6IRQxsVdF3Sqddt1+qR40Q2Z",
-	"FDRywczb5mvOxRWIMU5ofNHZuTkXv1xYIyBnPNM3KG+4mHK0/uIIua75wL616YTVA6YUm4WLT/kUIren",
-	"1UGkx2ILeS9A3ThR+w0KNmCtuxMgWP7Y5NHIFB7HDY1Y9QPKl7sGoiUsapbW/bov6DslIipedEnEal2b",
-	"Tu+UkmqvGinoRPHCpRt9w1KiqrRtqpiD1mzcgntTp+WLbUq9BzR0pR/BV7qWs/9XMKIx/V9/XeL7VX3v",
-	"N4W9tmnbTOM2btNByrv9DrOAh4C8tewHVp2mSU7GnmLyHtAEcFXzOejHVf2lvYFAtYv+UCKoMNg8sQdZ",
-	"NxBiKeIoSB7aHe4AfxeqazEHWe85+PlQ9iDYQDmijuDDfNekfmapPCw0LgFNEXgEgQc6oCHIPPowvG2l",
-	"9gP0XW5ztG7r4M5lEYXmCNc3iRQjrnJIvbgfSpkBE7RDu9CaKyGdQE2VHd6/Zgp5wgsmsGvIFN4WhyZR",
-	"m/gwnqxJPdDALkQR2oyuoqVDdCz7UVFmGRsa7kRVQlBMVA3eUqe98A9sf+g5p9uUc7QWvWHj9pb19yL9",
-	"nue0481I39PksQmM2YOLkaxc7PXm73QBCR/xhD389fAPaJIy8vp6QAqmGJFkyJK7HojUPGZF5l77U5Ii",
-	"Y0KcgSKJFBpV+fB3ykhaKiYQiCS/Xf1BfpWlEjAzKz/K5A5QA8OzVXMR0+UeNKJTUNrp8+rs/OzcdjgF",
-	"CFZwGtOf7aOIFgwn1k19n236c+/bIF30q0xzXIjJxB6mFaCsx8w0RK/NY5+JvM+Dy7fVeiNQsRwQlKbx",
-	"5znlRj+jxDLBY1oTTX2cHFU4fg0ZwL6YxY4PrY0/nV+Y/xIpEITLosL631jRv9UuP9b7gyhzEx2GrEwA",
-	"1EnLBkAd+EsYsTJDsiozi4henJ8fJHRXSXGDYotgfxq0mVPmOVMzGtPK85ow4jmWSEEYMX2LDR6bKs2C",
-	"Y/bpm1dcCZSOdxqoS21LkK5wAo1vZDp7MoM3j6gaqWuB2ID51VEUWGJ6GrhbxQkjAu4t0B7ODlQP4P7c",
-	"nU4sjCJjaAG6ajW0+WdwGZTH1YHH0ybw0/l0yyxxGui+B6zyl6TOgLMWfCNalG1JWz4blk/PEJvNURBD",
-	"/HiFwDmqhfW3s0G/fnRQEUNd4KcJ10TJEoHc8ywjCrBUgrAsIzgBYmRqMgS8BxD2iQ3aVYdFmEhJ1WO5",
-	"lyMCU/uq1GZLnMgSyVoRo/kualqfWbwgkmo56Ts5nqpDuAw+/8DH8NXuLuNZIT5Wd9O80n6WDmfj/vjE",
-	"uhw/xGZbA6yF4rzJJqDxOWSOOQq1/LADzApjkRJthmfo5YxnxN4CWlV0YFFz94YhQ43DfFC9f9pcs/U0",
-	"7Ah08xLCzvmLaJmDFEBQrpqXkIl5HW2re9AAdrFXli+kbanfHZ9ct2Jh85Gu7ppDe5T/HspjtSf+L7ee",
-	"pTWp/WjqFNsSEzptodTCFs2LpgDS8M9cX9DI03prd3I04uO5q24sFv8GAAD//6I5Fyf6KgAA",
+	"H4sIAAAAAAAC/+x9227jOJr/qxD+/4GdAZSkuqZrgc1iLlKVdHVm6hCkUt3TmGkYtETbnMikm6SSuIM8",
+	"zV7s1V7uE8yLLT6SkihZB0q249jlmyrHlnj8fR+/Mx8HIZ/NOSNMycHp40CGUzLD+uNZqOgdVYsrLBQN",
+	"6RwzBV/jKKKKcobjK8HnRChK5OB0jGNJgsHc+epxQGaYxvBhzMUMq8Gp/SYYqMWcDE4HUgnKJoOnYECj",
+	"wnNJQqOqxxieEXiQJXGMRzEZnCqRkKUHn4KBIL8lVJBocPr3gW7LdP1r9iwf/ZOEChp9JwhWxE6XEvkW",
+	"q3B6TeScM0k6ThnbRRvSSP8dERkKOoe3B6eDmylBNJKIj5GaEhTqjiOEs64DRJn+iYuICPi0QPdEECQJ",
+	"U8eDYEAVmUmvpbJfYCHwYmlFCsNsXZPFmVI4nM4IU+6ylGaePXPps5flAblvtw/omvyWENkVj1EiMDw6",
+	"nFGWKFKxQT/yexRzNtF7kC4SirFUElZ/RhmdJbPB6XfZCClTZELEIBg8HE34EXlQAh8pPNGN3+GYRljp",
+	"mcxg6+ZqEcwo+/N3egFiym7hsf8vyHhwOvh/JzktnlhCPDHz/kDZbTrnp2DAwzARcohVYaGhpyNFZ2Rp",
+	"tdsGl+2E2ZYZZdFwRMZckPqlugB6QiFnYypmJELznE1IpKZUohlmC2TfR6a5wrpuYEUVVbHGZu/5l3CZ",
+	"r3TauA84V2Idl36sEMDTi9LybuqnolngasS2UYxudZ9dYuy2JqsPOxgkonikJoIOgrWtgxmh6aVtBXqh",
+	"vC9s7Xv1Y7rC4S1lk0tFZv02B0tJJ4xEQ8WrhtcscvgzK92cxjB5UOuEsG7Pc316bd08b6HPDhZfrx/o",
+	"jaDznuc7kYoybA6oRzhbPhA2UdPB6fd9KUSfLd/ruWgBUg4VH1J2R5VevWVxrFbCLchj3t1H9I4Epk09",
+	"BhZtiqXye0bE0Fdk955APnbTQSrBrzBSqbBQm1mGEmRdQLn95htRAYvCTIvr2gb6XmSpBJ33oUf7XvOY",
+	"vjA8l1Oueo5N2tf7jM95t36MXxm+wzTGIxr3llQ2SFTPB9UqcPovW6/NTQqN9NnipRbqR/wzGU05v/2S",
+	"jDIlpC8mSSiIWlZo/koWqWL+48ezd0dffjx7/ebfEQgFWCVWBwf9/G9Hf+GJYGRx9CX97RhdKkQl4ixe",
+	"IDnl9wxxFpLjqoPg3sykz3rlrwbpNKpW7EIILlpXpTj7tzhCwpJPecXGlMSRLJx3TSrrD/C4GcOSHSIY",
+	"zIiUeFJxBpQnmz5YNUOni26br6dS0XeHcZkmgubxJXH8DseERVhc3BHWgyUtA/SzBheAcMyFYzRC91RN",
+	"EUapeQNQ58Fr/K1umrd4crB65azSKpfqG6aLqqV8T1RumvvMzrEiDUao7ElvtDa0f5Zi1seWBn12HL9p",
+	"f2kSJTG27651VcAbdi7QwsKQ9mBZ5hH7dlCSqNLdz4das4IXeEKEY4iWvVWYvIkuCKnt3w8ihW67TjFD",
+	"SX9NaGk7t2aZXxVJ2TArEVWztGCukG8X53yGaV+pIdIv+4MG+jQdtqIjbbpp9CtYWzoBvdCZH7hNHz6D",
+	"74NkT/jVcy4fe1nj0VRnCntP1GfQ7d4ZE7hG4HXpdOow0cyS7swiNYUDGWrlsuZHgRVZlhd+EHA0cZZK",
+	"tbaJoqX+fspzI36AXqH7KWGI8RGPFugey/QtR5RlyWwE/ZYXzY4wcKZih9a0fOc5/cr6g92h8iKeu/m9",
+	"Cs3UjMrhwaAB9yU9xRWOlzflk148vSXQuN4YzdSM+hAgHAouJcJxjOZ4QqSz8M6O65e7EDZM5ZwoTONs",
+	"QvDV59E/WxfN9BXYGdWs2jUJCXMkndWcIB0FOJjJcteXjBGxLhkuNYSc0/F4LXNrmpLbWerr6fxSWdbx",
+	"f7d+cdKhBF4iTfW2bBERnxNVi4ignWIdlWsGDjJqHcVjGisipB/tNi1uI4W1grzjeWOCDzrpBxFeDC33",
+	"X1qmc5yZT4BhFD3oRsDX/E1rerByWKHvEDfhDhFe5G/qJ2r4XpULv0bkdN7anMbUS6GoiqypwGOtI759",
+	"wjDcIR8PI7xY3qkbOiOV24NVgLBEP/54+vFjGoiidyTmIY4RNHq8LoXfdbgWFrGAsuJMAhe0najEIfyX",
+	"eR4Fg8jKcD64K0s18GrgcYjBuN4mkhKpzvFiLQy5gkSrJrLeObzDIvKSFauV3072/h5WfPOKSqwpL5nB",
+	"BOeERZRNCuLxr62r4uGAsl01LZejoeiwnZ47P1WzeAiKQeWyysT0W/Ub+Kbr3yw53GtMEWWplA/yPt0e",
+	"AmecLYsS07C3KSlM3+/KGJY69hNR8/66TGoFnrcYeh6bHYxJq52wwxWNAfVBkO0H07AliDRVscBVRVnE",
+	"7/viKsILf0gVu2xXf6HtxtEvXrje5n28BM9i+1o+tYJme1iFGt7D3b+ivl+h33cYb9rMxmJz+hzRnpyB",
+	"ymGVnW3EeUywttR2C4NpCWsB9hXjkPiQ8ZV+cFVpoyTpaxMTFgRhEU7pHYmQ5CD8gwaAxyoNMSdM2/dS",
+	"UUXDWePavtUuqFQ4d6qllcIONITK+Mg1Wpb5oh/ry7aUjsrrqAaT1PG89IsgIZ1T6+/tJBT+lpCEGL8+",
+	"g3UaYxr7LHz7Kr3H82c5iyq6O8eLlc+kcntdZ+B5WkzwfKWpvsdz39NCd+U5Y2h2k9FMTzoEPzUsLCtz",
+	"nZmRR1hS3mXDIjjhqnK1eNVhtqVd9raqf19nr9ttxyn2ktdXCVzudHbC1OoOzupw5mobEDzqNhgU5tC4",
+	"ZJlA/l7wpDdjm+iXu2Oipnc/YNhO+0yvDyx0d0Nvv3zf0IjSuPusSXr++/oU3O7OGI4XiobPHRHSOo71",
+	"RYZ4dtVVYQvJXGEWkuGUJ6IyFw5swiOi7glhjgMZYRbpP0PHrBQgABi6n9KYoIRlIt5xPQNK3ciOQazp",
+	"nGkF8PpjWsx8u8mGbRpGU1BLSTZ2ui+tUbC8fZ7Y2TKVbJ44VqGJDSCoVd+kcsjFBDP6OxHVT6yQ82z5",
+	"ah2+3K4bljd1DssVkwA6I2epYz/Y5P11mdRz+VB7h775+b5snHzfvbIR5p23qtyt305lvXWY0HPtE7kj",
+	"ZWbXEmvkzRV6h6TBi9nIfBEh3y4+cqam9Q6zGfzcecvL7fptue2rw3hroqR1Q8tSi34VvNi//PLLL0cf",
+	"P1Y7rJ8xesqMM+2zad5XRJQM8KtbY0qN+lq1F11GWrNBNvag1fKSbUZLpIyJAGhfxp6cj8ohkYrOKkMp",
+	"f54SNSUC6QAdiNTDDKVPI4HNb1PM9PcPOFQo5Imp2FGhKftFBvYPMdpmeGBQWMiqfbqczblYW6Tg4rKU",
+	"mdSxMArMBUZGokIrTctVN4Fr21CnkEM9fmcQXVas2OG6yzO0KlyCYFkTYuHpga3yudpW69dhDeqM4Pey",
+	"43b312J0Z92ms5Ly0sOD3mGr2x/l97Wm5JL3w8ovg2Agb+l8rj8Z30qr2wN6yRUb23SFw74JTVq9dpa/",
+	"Z/bw5jLmU81vuUYRHAnOXOFEMlmnjDwopDg8QIUJLNdldvBD6m59/eZN/+IVM/zw59dv3iynH9cHRjiJ",
+	"MH0ybioR/SyufdN9kz//msxjvLAKyjmJ6R0Ri9U8kTVumMzj2Ep+TT7GyAyRGF8trvIupg0MQx4Rn1jT",
+	"andkkM+nauG+EFU2dfcjvi427jWg3OmufVqfU9tKv6m1GYZKQ2s15hRC/DtiM4o6iEduR+DbqpK5wilm",
+	"kzW3KciM3621zbLEppch7yifRtt6v9PPrS1VfSz4zC/Xknc3HKbp7boT3UTb7PTidTTI6AWRvfbKLmZ/",
+	"80v1sQq1GbU8qjM9mBMcLxAcAQF8yLO14CH3CD4e+Ftkqxa0FMm3kSiHqnCEurFcpXFLHWpI/DwltsSf",
+	"EwmkkwgnhMOBEiHFAzSjUoJemzAFaW8gvRwv1ZyIsaIqiUrT4skoJoMKFxIUbezyfE28VmmFrP08G4vb",
+	"T9XCfZ1HKxep3K26eX4Z/GZdvuW6eWYFDjXq6mrUmfV5saXf9qFCVHt0ZtXG/GSbdnNIbvgt6VtHgTzM",
+	"qSDdItRy60P5vLFZgzAeFGLGuEIjghIJqeySKJPMrk8YBL/pdXIjXRlXwzFPGBCPGRl8wrEgOFoMmxJ1",
+	"ArPoHpK5ea5pbU1aFovi/rUDEo8k3/ru3un3rbGXSpmQjo6vbmsRpAPOOuu2Ovlw15u5lpkVln8qB0C0",
+	"qMGFx73zAyprnPWySS37LlPEK0Hnx24UgCvDut9nVZpTK92vaytqmddrXuehHEBjS1yv4DJdXvQnHeYz",
+	"5hX1reWchHRMQ/yv//7X/xKJIozOri5B5seIoxEOb48Ii+BrPI/NY//F0TzGjB0TAdFRUonkX/8Tmcpc",
+	"TBHE0acPPyNbPQ7evObhLVGSYKM/GDFnkLYxCAZ3REgznu+OXx2/gjXnc8LwnA5OB3/SX8EWWvftSQ60",
+	"E85OHmGFnuCHiSl4BxjRHByKz1VVpjJ4wDOiiJCD078/Dij0DR2kykumXOSrbIQNw2YqhWrbzG8JEYu8",
+	"nWK4f31zrYmBv8LbhjXoZXj96pXN0FNp6bW53iKY+sk/7VmSd9CzPJhBTykLnoxxEiuUPxMMvl/jcGxZ",
+	"vaenpvp9TzobczbDYjE4HXygUoFCq5f736RbxYAzKBuHFXHdisZ0rL16GpSa4oolMqCDExzNKDsheELE",
+	"UZk91uIN3lmqdDXY7P7VFw97sRsIff5p833+wMWIRhFhVZBxQjzLRYMyHzUIVfkzC6IKiIHNLoBFKqzk",
+	"iX71aE7EkQ0RaEQL5PdIJ+qghkWVeIu1XnkwlTpTSXW7iq/U6oY5VVUYyQHj1RjXQmRW4kJml7HMiYBy",
+	"JM1AtoFrR9ajAufto/28uIyeToT2DMGY51xWgPuKS4PuovOIEnmetnJuvEt+J3LWtR86a4ION4nOZl/Z",
+	"AaTVID0zXjSEkckHRBZ4KN1xhCeYsjqw6iNfnjxqwenJyLgxqQpwOtffS0R0o7roC7wcodHCMXpD5D9m",
+	"nC1m9HciEVWy4IUWJOQi0mIFNyFRqRBRRL/pTFd/kxdWpmsH+UYExe877W1mNkhibeYr2tsOIM5AHAze",
+	"PMcsL5kiguEYSSLuiEDEPuiS0IXAkmjB1vh1UjE4wgr70c2Jm+hylNZarBNaHFiXK0NuD+ZrlTKaq17u",
+	"hlL0npizX06x0B6/9uKYmY6UY2hJSSpmrFRhqVzI0gNGboXMfYJQZeXPHdKptaOTwhxC5Xo8ZYnTLKHE",
+	"xjBXwYM8zLlQnsC4MA+/SEj8bqqUVLQ/ogyLRUUHh9OzSgQ0m1xxgBm1BUuE0e90nlb4qMOZr4WmZJfx",
+	"ULXXAK1g6aoDff2lU05SnqLMYxWgPPEFgjIc0gvQXJAxfSCRuRThCGIhJawftG8C4Y6RxZWEH//y+ev1",
+	"p4tfhucXP5x9/XAz/PL5+mZ4c3159eV4EFTOVxqaazR3lnJS8ANccohYufYvR4KoRLAAJXP46/WrV8XR",
+	"vXlVN4qYzmjlMByXRGvtYY4g8LbYZW2PfDyWpGuXGaPMCtCYrimTiuAo3WJTcQZxRmRxNNq9UTeitM2q",
+	"MeUeqA0fZbXlonfsJLPshOXVqAvxTYiPWwQd96tUZD7J/TQZy6kIu9L+WyqXw5ojFGIhaK6HuqK4Dss6",
+	"RjfLsdAxGatyFnojp7PSbOpy9GN8etidHCGbhGK7o343APluSsLbEvYKu27QAuydJ9lPwNc7wPPR+Qvs",
+	"dbYVk+quwmmFxQ6+diHjfL48t4vuJYoVul6zve5bMWd89yzGBV3tIg0LKVuP9YYvMUlwqGkX+wpg1FHm",
+	"vaGoo+m3AUS9O29tjde1bE1DmkDJz6/xejDubUE9uUpUiQYo0IDGsAmZxrc6JniGgF2DpUWtQhyFnIhe",
+	"BJLlZewjkSwlnRwI5aUQiuAzrohRWPWnItlgaY8OlEF8FToRRIfWHeV3Fdd7ImtJ5do0Yk7Cg3DzDaDU",
+	"7vgSSwcABIg8KGND0a4/LRllN072BWrhDti03FR3qH4tN7MXzL3pkmMvzv7dhody8N8vuz6/f/168z1+",
+	"ZXPBQyIl8DREmKJqUaLlj1hoRZoIyiMTCo/LlpKM+mLSQsRZSZE6y7G2fi2TXcUdtsbUdK+zxUzAgTuq",
+	"KZaoYLmpNP3FcaGWWYP9b8+Ny4UV5bKYewcYxJSBZQ3Ma+RBBYhOGIfGUIjrTaulZJFew3FveuIiqzdO",
+	"ZXaTUOUK2J2Apwe1sXW11YcbxmOPLjMac6tS+3B0box5eF2jyT3KfwCm/UcYjmOsRH/QRu8/5n7DqnGV",
+	"avl1wb8T+abLGemCRfHiGP0MLEJ3riN9GLdlh4BRTOgdYYHzVmquhVh17Y/K6iFZyip5OwIoDhpO4b1Z",
+	"Ek5ROCV4Dr8zFGMxIUgBE6qdrx5kYaKRIbRSrlvDvA9umD12w+yu76UuOiBoEIPTs3ZzMqebirkVSdMM",
+	"YBfky//YfJ/pvU4vSbw024QwYuReo7gm8kB/PhktjrKKjY0SpK3+6OeC25UUoso6nLuZPmQODm3fzd2y",
+	"emvNXTJafjtuxALVhc8KvuEWVmdKpTnu2b6MryL9r42xrd9FW5HvuxtYSCeQWipNtBoa6blkLlmzvcZK",
+	"1AyER/jvMipFplcFi2sUwD+X516WHdPwwQC5IxaTV99vvsdPXCGT6/+SwtMNvFOC0nYFsK3m2ZaBDnOR",
+	"WiNzzTLVImPj0bpt+ln7kVq+SW93Ys/tfkdmAjXif1J1JCZb28v1qxrLVV8O7sqD5frUVgOqCKWpFyFO",
+	"isU9amINqUSCJwpklTi2Vp806V0nwhcvvHGuowdzMLBgWyvHPByA+RoeBbtrKv7kA6kMO3SoNy8s8Fx0",
+	"XG2QzEdsDqDcLJnOKTVJ6qOozg40xXKoH1jFHp+P5RRlRcVgKLo4xppM8Gfvbi5/ury5vNhokLezrNsz",
+	"MRYHsWY74zMc8RV14nfulC9yhMqCGsFgSnC0fNz/SHC0VY5Rs8Uty2+mQ8y1Yn87ugGPwZH2OzTde6CL",
+	"FWku8P7iJmXUPIkjSzzHzWh8egHBy9q10mXbWwwfW932TdmWywUyt2JfzgdxsDG/fBuzS1CLxrJEtWLh",
+	"ySiNFk1prlTwi+qaBSYgIe0K3JVpZRAuAhNFwVnq5JzB75RpS2aAGPxApUlJ0XWUZSrW2GfydrEgCMg1",
+	"sn5xUPopi8hDgCS4R7FE/xj8/fWvx5kQ9I/BsjxZyyfe6rm+fGbhVf3ZoEBPaYl16MuEL00r3y0V8N4e",
+	"X0n3YDfYy3Ob3751hjbDbFEWEnRgCgtJT+42TuI4xDFhERatTrYyu/jBfXlH7INerAMm9s5O7OIO2n2q",
+	"YhK7qU3AIeFOEJkylz0BZNw2RzSUDWckDqfop4ufLj7doBEJ+YzIQq18raS7R9qXrx8/nl3/ok0n+iQT",
+	"NgQLfjy/+XJzdn1zjPTOSEgQkTQiuenFWGTgqEzvx+pwBBqf4WX4MmTmWRIrOsdCnUAzRxFWuAiY8uUP",
+	"MfGrmlC+vSEmNWVOn+8grL3hbTdozQx/idigpCCA/ZiGEgLj+jJqQUI7q0ojJfjbZckUlomghrAkZSEJ",
+	"IO6CSIXGVEgV6CgtHsf2cih7wQZ8m1tR/c6CazO+l2KRLE69PXwU1sa/RmNd5OaGLVtmjXeJQp5fSqys",
+	"1+QAw9ARgCUyN4tU+As6kOVjfiFjt3iEfBfP0hbOn5F6KhrOZ3IIgDgEQLTSVjEEodXIEnRTLfaeKJ7B",
+	"5XHJGBFnL1tdeQEHhD+CPUIr9hvBm4rl6GXaPxwrexNX9yIjSFZ1HLii4QlWCofTWXrBSrWd5CwMyVxJ",
+	"dHX+Q4CuPr3X9o+/XF2814qj9gqYOIA36OPbDjaNnBWdOcPYe670LRlPit7JfJsPudbttdLBOok1ieXq",
+	"4KaI/+Qx/8OqjD3lYoeU8497ImzUNO6s3DNK4zxURB1JJQie7Ulx2qIix+9ZzHFURj7K13sdRNBUzmNl",
+	"y0ltgY89JodD0ZyDbN1N2/3KzE2wpYIexkWxaS34QKIHEj2QaBuJnlUR6GpS6SiRlEhVujatlONArcd8",
+	"JHSd3PT+HixieDe92qpJRn1rujn3vHxqN1LF8kl1VaS+EQKtdLfZKIyFybYwmd5S+UQ2V8A3xCLyVJPe",
+	"waP7Az6Yzk6mL8woozMcGxzA/qExF4jMRiSKSiW3G1KznOraPnvfoZb2Ic17TWkLesmzs4lFSBJIdjsy",
+	"dwFA+Uc9FNltx/U7R6bmRqfN1z/7Xw23I1ygPLeDSa/FpJceQ4KwiAhbtap4G4AGV6DZksxqkyISS6Lr",
+	"C3bAK4QKyw4wNc/vFzz1nHb0Kjc303GKoY7kfA4XRUzBHOxb+LJNhtExY/eURfy+Ps/4M9NeKahrSQRK",
+	"46q1HKU1dJNsvCinGIN2kP4EGGaRDNCIQ0UIFsZJVHOPiQPLc6zIz3Zwe1TlIZ/VIU6uRevML6bDC3MP",
+	"XZccenjp5BEw7uvPOMcLee57teXGTD9OUajtX8SToXaxo4wUmoiSmOTwWboQu4VL6kP5SCqsEtl481N2",
+	"15Qk5uYI6H+Ew1uoL8byoF/LJ/NkvABJDkySSjS3x37CFDWFHGKsCGip9vJkuBCKTyYkQth2xIW9T1nf",
+	"UIEl+i0hCYnsWaEHZYwoc5zIdrarhbkvZrb7w3adWR3Yrmd4soG8W1u6Qlr1ZMZjynBMf/cpj2dg+EP6",
+	"wkFz3mOdqEJXt3eDlq5jr0wobMDbBM991Z/3eL5PnA6ms6Nn9VgQovNhsrpFIYwwTHQhZ0cl4mNEIDym",
+	"z3Furv+Q3ozo0j6/27UqzCwcB+cGy1XsMCt81kTuYp6euaBP8hnhjKTas8fteyV4m5pVfpzvg352a7lx",
+	"bqUsPezT0nUV66yS9eHy01/7F8jaMN/WG7G7JaGyQmopTPUX/oWgnhWHhxpQvWtANeyz11H6/Pu8qaJP",
+	"MJOtFnwyAzgUe2o6Uov1lvT92hXYrTtDoch/xGeYslqjj77EVebnVxqfMoWwAnOwUYG+Xn8o3VMU5BEI",
+	"E9MEF8YlNFog06dWhfKGU4FXP667gaPxGGmKslclfb3+oK+bYtwMAAuSVbZPGJy1+j70uUr7aLME6cbf",
+	"Ls71w3uiIRXmdLAE+echGhw6NyXkGPKmqEf4r2tctd4x+GfbIZlm8Acj0yFcsmMSet3J4xWzvHfw31Sy",
+	"bmeR7EB6e5lMUMiV7SD0zXF4S9nkKKvE5mFAuTLvmIqN+2NCdqe1u1YJu6EoprJ0Sb/+3l9t3doub0p7",
+	"dSa0VSW2MI6dgtlZFIE2BbzCSYNoR1wb3zl5hP+6isguQOGfbYsKZg4HSXkHL8yf8TsTX2iQDDtZyzvb",
+	"hdd9BeamZNi+jPlAG5unjUywbKcNby5/ovhkYsp7zNN67iV6gq8bKerGNHFg+AdQdwe1AQ9cBKCvCyiC",
+	"W8cj4vCWRB1g7vinfXUo55UX4Yt2J1F2SdsMjbW4pK/Orm8u311enX26ebGeaaMO5uuxy+pg9XWTpZAK",
+	"T5f1NlB78Fz39lx7bn4LRzvBDMcLRcM+vO0se3efDEUV89tBFjHl9yjmbOJEFRYLHfBb4OI2+nkFAFn/",
+	"WQ/4vLdv7iV49OT25Gwp3SZPhfliBdDYuyxCeddyl4Xg92jK4aImbKPzIYwgQFw/heN4ESCsL2o6Rvr2",
+	"c3jD5IaYqFgoPD7RouB/mp907qH9Xb/ABcKxIDhaZK9gfaMFDBEmjcPb1hKQLq7N/QTv5N3hYoutXGyx",
+	"W8Ldi4jRLVC7rVJ1HMq7pXs0OtI548qUF+h1QHzi6sK+vJ8Cxo4mNSwfECZcy7BPdI8lYkRfqG92bwUA",
+	"rVK+8IXWQTuUKjsEAHQLAHB8GJW1BAMkiFRcwN7pShvYZMquj+5OTAf+uY61tHdtGzqQ4IEEu5Lg9sQk",
+	"i1qE81uNhCbKaOkw7Eh0ghbuGmsUjfSzL0YSUuRBnUzVLC4u+y4WZjZ6r94LzUOpoowILBadK3TY9o8E",
+	"cfPK65Xcj0Tzci7KpRTM1Yrmmjh9ASNUq3HY/4QTqVVTsOIkzPYGFRO0/R5D5qmcWpEsyGLvzd9uCQVd",
+	"cAFPKoPnS0zdOnCvi3M7ZLPvc9F+eYvIEvhyw2EO2AxC/sxPEElYdFRHKS1YhHffvQggvv5WgLhFGfgL",
+	"sRyspmhHml7M7xkRy2BsYNmS4bmccm/v7pfs+f0xSmRz2l2DdbaN7rZnX/qHxr6A7V13UKq7w4eali0n",
+	"XrpQ5preRAiQj6TCilQLgy7EmrjLSUTH464s5hzeeWZVuRSuAXaGTbSr+EtmiukOwAbsIE+8ByeXUR4i",
+	"KNtmeWRag0bd87QgUSvbbMT0Y/qxj40oQ3n64YVYh/I5HRSWHYz3Ti01GvFWKnT4d7q7HfB+T0ZTzr1r",
+	"0PycPr4/8mE6pYP00AK/rL6rRp8FDpLJKHu5wGgzZHmLp1sB1/qTA+w0vjgLs9W8rcrxHLDeLCmbxRoB",
+	"s4VKHYob1JM7wlQdzBvY68mj/dTV0ZvShP1/2w7ebBYH8WF308Vq+Xc9+25PHttfoL68E+JALc+YQNaV",
+	"Wp6env5vADoxjwraPgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"journey/internal/api/spec"
+	"strings"
+	"testing"
+)
+
+// TestSpecIDsAreUUIDs guards that every id in the spec is a string UUID, so
+// that JavaScript clients never get an id as a number they can lose precision
+// on.
+func TestSpecIDsAreUUIDs(t *testing.T) {
+	swagger, err := spec.GetSwagger()
+	if err != nil {
+		t.Fatalf("GetSwagger: %v", err)
+	}
+
+	seen := make(map[*openapi3.Schema]bool)
+	var check func(path string, schema *openapi3.Schema)
+	check = func(path string, schema *openapi3.Schema) {
+		if schema == nil || seen[schema] {
+			return
+		}
+		seen[schema] = true
+
+		for name, property := range schema.Properties {
+			if property.Value == nil {
+				continue
+			}
+			if name == "id" || strings.HasSuffix(name, "_id") {
+				if !property.Value.Type.Is("string") || property.Value.Format != "uuid" {
+					t.Errorf("%s.%s is %v %q, want a string uuid", path, name, property.Value.Type.Slice(), property.Value.Format)
+				}
+			}
+			check(path+"."+name, property.Value)
+		}
+		if schema.Items != nil {
+			check(path+"[]", schema.Items.Value)
+		}
+	}
+	for name, schema := range swagger.Components.Schemas {
+		check(name, schema.Value)
+	}
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"errors"
+	"github.com/go-playground/validator/v10"
+	"journey/internal/api/spec"
+	"reflect"
+	"strings"
+)
+
+// jsonFieldName names struct fields in validation errors as they are sent in
+// request bodies.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// validationError is the response to a request body failing validation, with
+// one entry per invalid field.
+func validationError(err error) spec.Error {
+	return spec.Error{Message: "invalid input", Fields: fieldErrors(err)}
+}
+
+// fieldErrors translates the errors of api.validator into a human readable
+// message per field. Fields of nested objects are named by their path, such as
+// "link.url", and array items by their index, such as "emails[0]".
+func fieldErrors(err error) []spec.FieldError {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return nil
+	}
+
+	fields := make([]spec.FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		// The namespace starts with the name of the validated struct.
+		_, field, _ := strings.Cut(fe.Namespace(), ".")
+		fields = append(fields, spec.FieldError{Field: field, Message: fieldErrorMessage(fe)})
+	}
+	return fields
+}
+
+func fieldErrorMessage(fe validator.FieldError) string {
+	unit := ""
+	if fe.Kind() == reflect.String {
+		unit = " characters"
+	}
+
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email"
+	case "url":
+		return "must be a valid URL"
+	case "uuid":
+		return "must be a valid UUID"
+	case "min":
+		return "must be at least " + fe.Param() + unit
+	case "max":
+		return "must be at most " + fe.Param() + unit
+	default:
+		return "is invalid"
+	}
+}
@@ -0,0 +1,72 @@
+// Package archiver archives trips some time after they end, so they stop
+// showing up in the trip listings.
+package archiver
+
+import (
+	"context"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"time"
+)
+
+type store interface {
+	ArchiveTripsEndedBefore(context.Context, pgtype.Timestamp) (int64, error)
+}
+
+// Archiver periodically archives the trips that ended more than retention
+// ago. Archived trips are still reachable by id and through the archived
+// filter of the listings.
+type Archiver struct {
+	store     store
+	logger    *zap.Logger
+	interval  time.Duration
+	retention time.Duration
+	now       func() time.Time
+}
+
+func NewArchiver(pool *pgxpool.Pool, logger *zap.Logger, interval, retention time.Duration) Archiver {
+	return Archiver{
+		store:     pgstore.New(pool),
+		logger:    logger,
+		interval:  interval,
+		retention: retention,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run archives past trips every interval until ctx is done.
+func (a Archiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.ArchivePastTrips(ctx); err != nil {
+			a.logger.Error("failed to archive past trips", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ArchivePastTrips archives every trip that ended more than retention before
+// the archiver's current time.
+func (a Archiver) ArchivePastTrips(ctx context.Context) error {
+	endedBefore := a.now().Add(-a.retention)
+	archived, err := a.store.ArchiveTripsEndedBefore(ctx, pgtype.Timestamp{Valid: true, Time: endedBefore})
+	if err != nil {
+		return fmt.Errorf("archiver: failed to archive trips for ArchivePastTrips: %w", err)
+	}
+
+	if archived > 0 {
+		a.logger.Info("archived past trips", zap.Int64("trips", archived), zap.Time("ended_before", endedBefore))
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package archiver
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"testing"
+	"time"
+)
+
+// fakeStore archives trips the way ArchiveTripsEndedBefore does.
+type fakeStore struct {
+	trips []pgstore.Trip
+	calls int
+	err   error
+}
+
+func (s *fakeStore) ArchiveTripsEndedBefore(_ context.Context, endedBefore pgtype.Timestamp) (int64, error) {
+	s.calls++
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	var archived int64
+	for i := range s.trips {
+		if s.trips[i].Status == "active" && s.trips[i].EndsAt.Time.Before(endedBefore.Time) {
+			s.trips[i].Status = "archived"
+			archived++
+		}
+	}
+	return archived, nil
+}
+
+func TestArchivePastTrips(t *testing.T) {
+	now := time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC)
+	trip := func(destination string, endedAgo time.Duration) pgstore.Trip {
+		return pgstore.Trip{
+			ID:          uuid.New(),
+			Destination: destination,
+			EndsAt:      pgtype.Timestamp{Valid: true, Time: now.Add(-endedAgo)},
+			Status:      "active",
+		}
+	}
+
+	s := &fakeStore{trips: []pgstore.Trip{
+		trip("Salvador", 31*24*time.Hour),
+		trip("Recife", 29*24*time.Hour),
+		trip("Florianópolis", -24*time.Hour),
+	}}
+	a := Archiver{store: s, logger: zap.NewNop(), retention: 30 * 24 * time.Hour, now: func() time.Time { return now }}
+
+	if err := a.ArchivePastTrips(context.Background()); err != nil {
+		t.Fatalf("ArchivePastTrips: %v", err)
+	}
+	want := map[string]string{"Salvador": "archived", "Recife": "active", "Florianópolis": "active"}
+	for _, trip := range s.trips {
+		if trip.Status != want[trip.Destination] {
+			t.Errorf("%s is %s, want %s", trip.Destination, trip.Status, want[trip.Destination])
+		}
+	}
+
+	// Two days later the trip that ended 29 days ago is past the retention.
+	now = now.Add(48 * time.Hour)
+	if err := a.ArchivePastTrips(context.Background()); err != nil {
+		t.Fatalf("ArchivePastTrips: %v", err)
+	}
+	if s.trips[1].Status != "archived" || s.trips[2].Status != "active" {
+		t.Errorf("two days later: statuses = %s, %s, want archived, active", s.trips[1].Status, s.trips[2].Status)
+	}
+}
+
+func TestArchivePastTripsError(t *testing.T) {
+	s := &fakeStore{err: errors.New("connection refused")}
+	a := Archiver{store: s, logger: zap.NewNop(), now: time.Now}
+
+	if err := a.ArchivePastTrips(context.Background()); !errors.Is(err, s.err) {
+		t.Errorf("err = %v, want it to wrap %v", err, s.err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	s := &fakeStore{err: errors.New("connection refused")}
+	a := Archiver{store: s, logger: zap.NewNop(), interval: time.Hour, now: time.Now}
+
+	// Run archives right away, keeps going when that fails, and stops with
+	// its context.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		a.Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not stop when its context was done")
+	}
+	if s.calls != 1 {
+		t.Errorf("archived %d times, want 1", s.calls)
+	}
+}
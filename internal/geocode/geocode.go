@@ -0,0 +1,98 @@
+// Package geocode resolves free text destinations to a canonical place name
+// and coordinates.
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Place is where a destination was found.
+type Place struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+}
+
+// Geocoder finds the place a destination names, reporting false when it
+// finds none.
+type Geocoder interface {
+	Geocode(ctx context.Context, destination string) (Place, bool, error)
+}
+
+// Noop finds no place for any destination, leaving trips as they were
+// created. It is what the app uses unless geocoding is turned on.
+type Noop struct{}
+
+func (Noop) Geocode(context.Context, string) (Place, bool, error) {
+	return Place{}, false, nil
+}
+
+// Nominatim looks destinations up on a Nominatim server, the OpenStreetMap
+// geocoder.
+type Nominatim struct {
+	client    *http.Client
+	baseURL   string
+	userAgent string
+}
+
+// NewNominatim geocodes with the Nominatim API served at baseURL. Nominatim
+// asks its users to identify themselves with userAgent.
+func NewNominatim(baseURL, userAgent string) Nominatim {
+	return Nominatim{
+		client:    &http.Client{Timeout: 5 * time.Second},
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		userAgent: userAgent,
+	}
+}
+
+// Geocode returns the best match for destination, reporting false when there
+// is none.
+func (n Nominatim) Geocode(ctx context.Context, destination string) (Place, bool, error) {
+	query := url.Values{"q": {destination}, "format": {"jsonv2"}, "limit": {"1"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return Place{}, false, fmt.Errorf("geocode: failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", n.userAgent)
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return Place{}, false, fmt.Errorf("geocode: failed to search %q: %w", destination, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Place{}, false, fmt.Errorf("geocode: search for %q answered %s", destination, res.Status)
+	}
+
+	// Nominatim sends the coordinates as strings.
+	var results []struct {
+		DisplayName string `json:"display_name"`
+		Lat         string `json:"lat"`
+		Lon         string `json:"lon"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&results); err != nil {
+		return Place{}, false, fmt.Errorf("geocode: failed to decode search for %q: %w", destination, err)
+	}
+	if len(results) == 0 {
+		return Place{}, false, nil
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return Place{}, false, fmt.Errorf("geocode: invalid latitude %q: %w", results[0].Lat, err)
+	}
+	lon, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return Place{}, false, fmt.Errorf("geocode: invalid longitude %q: %w", results[0].Lon, err)
+	}
+
+	return Place{Name: results[0].DisplayName, Latitude: lat, Longitude: lon}, true, nil
+}
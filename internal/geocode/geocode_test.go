@@ -0,0 +1,58 @@
+package geocode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNominatimGeocode(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		want      Place
+		wantFound bool
+		wantErr   bool
+	}{
+		{
+			name:      "found",
+			status:    http.StatusOK,
+			body:      `[{"display_name": "Florianópolis, Santa Catarina, Brasil", "lat": "-27.5954", "lon": "-48.548"}]`,
+			want:      Place{Name: "Florianópolis, Santa Catarina, Brasil", Latitude: -27.5954, Longitude: -48.548},
+			wantFound: true,
+		},
+		{name: "not found", status: http.StatusOK, body: `[]`},
+		{name: "server error", status: http.StatusServiceUnavailable, body: `[]`, wantErr: true},
+		{name: "invalid json", status: http.StatusOK, body: `{`, wantErr: true},
+		{name: "invalid latitude", status: http.StatusOK, body: `[{"display_name": "X", "lat": "norte", "lon": "0"}]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var query, userAgent string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/search" {
+					http.NotFound(w, r)
+					return
+				}
+				query = r.URL.Query().Get("q")
+				userAgent = r.UserAgent()
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			place, found, err := NewNominatim(server.URL+"/", "journey-test").Geocode(context.Background(), "Florianópolis")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, want error %v", err, tt.wantErr)
+			}
+			if found != tt.wantFound || place != tt.want {
+				t.Errorf("Geocode = %+v, %v, want %+v, %v", place, found, tt.want, tt.wantFound)
+			}
+			if query != "Florianópolis" || userAgent != "journey-test" {
+				t.Errorf("searched %q as %q, want Florianópolis as journey-test", query, userAgent)
+			}
+		})
+	}
+}
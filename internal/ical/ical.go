@@ -0,0 +1,182 @@
+// Package ical reads the subset of iCalendar (RFC 5545) needed to turn the
+// VEVENTs of an .ics file into trip activities.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnterminatedEvent = errors.New("ical: VEVENT without END:VEVENT")
+	ErrNoCalendar        = errors.New("ical: missing BEGIN:VCALENDAR")
+)
+
+// Event is a VEVENT reduced to the fields an activity needs.
+// Start is the zero time when the event has no DTSTART.
+type Event struct {
+	Summary string
+	Start   time.Time
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads every VEVENT from r. Start times are returned in UTC; floating
+// times (no Z suffix and no TZID) are taken as UTC wall clock.
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events     []Event
+		current    *Event
+		inCalendar bool
+	)
+	for n, line := range lines {
+		if line == "" {
+			continue
+		}
+
+		prop := parseProperty(line)
+		switch {
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VCALENDAR"):
+			inCalendar = true
+		case !inCalendar:
+			return nil, ErrNoCalendar
+		case prop.name == "BEGIN" && strings.EqualFold(prop.value, "VEVENT"):
+			if current != nil {
+				return nil, ErrUnterminatedEvent
+			}
+			current = &Event{}
+		case prop.name == "END" && strings.EqualFold(prop.value, "VEVENT"):
+			if current == nil {
+				return nil, fmt.Errorf("ical: line %d: END:VEVENT without BEGIN:VEVENT", n+1)
+			}
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			// Calendar level properties and other components are ignored.
+		case prop.name == "SUMMARY":
+			current.Summary = unescapeText(prop.value)
+		case prop.name == "DTSTART":
+			start, err := parseDateTime(prop)
+			if err != nil {
+				return nil, fmt.Errorf("ical: line %d: %w", n+1, err)
+			}
+			current.Start = start
+		}
+	}
+
+	if current != nil {
+		return nil, ErrUnterminatedEvent
+	}
+	if !inCalendar {
+		return nil, ErrNoCalendar
+	}
+
+	return events, nil
+}
+
+// unfold joins continuation lines (those starting with a space or a tab)
+// to the line before them.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("ical: failed to read: %w", err)
+	}
+
+	return lines, nil
+}
+
+func parseProperty(line string) property {
+	inQuotes := false
+	sep := -1
+	for i, c := range line {
+		if c == '"' {
+			inQuotes = !inQuotes
+		}
+		if c == ':' && !inQuotes {
+			sep = i
+			break
+		}
+	}
+
+	head, value := line, ""
+	if sep >= 0 {
+		head, value = line[:sep], line[sep+1:]
+	}
+
+	parts := strings.Split(head, ";")
+	prop := property{
+		name:   strings.ToUpper(parts[0]),
+		params: make(map[string]string, len(parts)-1),
+		value:  value,
+	}
+	for _, param := range parts[1:] {
+		key, val, _ := strings.Cut(param, "=")
+		prop.params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+	}
+
+	return prop
+}
+
+func parseDateTime(prop property) (time.Time, error) {
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(prop.value) == len("20060102") {
+		t, err := time.Parse("20060102", prop.value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTSTART date %q", prop.value)
+		}
+		return t, nil
+	}
+
+	if strings.HasSuffix(prop.value, "Z") {
+		t, err := time.Parse("20060102T150405Z", prop.value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid DTSTART %q", prop.value)
+		}
+		return t, nil
+	}
+
+	loc := time.UTC
+	if tzid := prop.params["TZID"]; tzid != "" {
+		l, err := time.LoadLocation(tzid)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		loc = l
+	}
+
+	t, err := time.ParseInLocation("20060102T150405", prop.value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid DTSTART %q", prop.value)
+	}
+
+	return t.UTC(), nil
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,95 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VEVENT",
+		"SUMMARY:Voo para Salvador\\, ida",
+		"DTSTART:20240610T123000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Passeio no ",
+		" Pelourinho",
+		"DTSTART;TZID=America/Sao_Paulo:20240611T090000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Dia livre",
+		"DTSTART;VALUE=DATE:20240612",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"SUMMARY:Jantar",
+		"DTSTART:20240612T200000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	events, err := Parse(strings.NewReader(ics))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := []Event{
+		{Summary: "Voo para Salvador, ida", Start: time.Date(2024, 6, 10, 12, 30, 0, 0, time.UTC)},
+		{Summary: "Passeio no Pelourinho", Start: time.Date(2024, 6, 11, 12, 0, 0, 0, time.UTC)},
+		{Summary: "Dia livre", Start: time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC)},
+		{Summary: "Jantar", Start: time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC)},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i := range want {
+		if events[i].Summary != want[i].Summary || !events[i].Start.Equal(want[i].Start) {
+			t.Errorf("event %d = %+v, want %+v", i, events[i], want[i])
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ics  string
+		want error
+	}{
+		{
+			name: "no calendar",
+			ics:  "BEGIN:VEVENT\nSUMMARY:Jantar\nEND:VEVENT\n",
+			want: ErrNoCalendar,
+		},
+		{
+			name: "unterminated event",
+			ics:  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nSUMMARY:Jantar\nEND:VCALENDAR\n",
+			want: ErrUnterminatedEvent,
+		},
+		{
+			name: "nested event",
+			ics:  "BEGIN:VCALENDAR\nBEGIN:VEVENT\nBEGIN:VEVENT\nEND:VEVENT\nEND:VCALENDAR\n",
+			want: ErrUnterminatedEvent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.ics)); !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	invalid := []string{
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:amanhã\nEND:VEVENT\nEND:VCALENDAR\n",
+		"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;TZID=Nowhere/Land:20240611T090000\nEND:VEVENT\nEND:VCALENDAR\n",
+		"BEGIN:VCALENDAR\nEND:VEVENT\nEND:VCALENDAR\n",
+	}
+	for _, ics := range invalid {
+		if _, err := Parse(strings.NewReader(ics)); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", ics)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package mailpit
+
+import (
+	"fmt"
+	"github.com/wneessen/go-mail"
+	"os"
+	"strconv"
+)
+
+// SMTPConfig is how Mailpit connects to the SMTP server. The defaults match
+// the local Mailpit server, while a real relay usually needs STARTTLS and
+// credentials.
+type SMTPConfig struct {
+	Host      string
+	Port      int
+	TLSPolicy mail.TLSPolicy
+	// Username and Password authenticate with SMTP PLAIN auth. No auth is
+	// attempted when Username is empty.
+	Username string
+	Password string
+}
+
+// SMTPConfigFromEnv reads the SMTP connection details from MAILPIT_HOST,
+// MAILPIT_PORT (1025 by default), MAILPIT_TLS_POLICY ("none" by default,
+// "opportunistic" or "mandatory"), MAILPIT_USERNAME and MAILPIT_PASSWORD.
+func SMTPConfigFromEnv() (SMTPConfig, error) {
+	cfg := SMTPConfig{
+		Host:      os.Getenv("MAILPIT_HOST"),
+		Port:      1025,
+		TLSPolicy: mail.NoTLS,
+		Username:  os.Getenv("MAILPIT_USERNAME"),
+		Password:  os.Getenv("MAILPIT_PASSWORD"),
+	}
+
+	if v := os.Getenv("MAILPIT_PORT"); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil || port < 1 || port > 65535 {
+			return SMTPConfig{}, fmt.Errorf("mailpit: invalid MAILPIT_PORT %q", v)
+		}
+		cfg.Port = port
+	}
+
+	switch v := os.Getenv("MAILPIT_TLS_POLICY"); v {
+	case "", "none":
+		cfg.TLSPolicy = mail.NoTLS
+	case "opportunistic":
+		cfg.TLSPolicy = mail.TLSOpportunistic
+	case "mandatory":
+		cfg.TLSPolicy = mail.TLSMandatory
+	default:
+		return SMTPConfig{}, fmt.Errorf("mailpit: invalid MAILPIT_TLS_POLICY %q", v)
+	}
+
+	return cfg, nil
+}
+
+// clientOptions are the go-mail options connecting as cfg describes.
+func (cfg SMTPConfig) clientOptions() []mail.Option {
+	opts := []mail.Option{mail.WithPort(cfg.Port), mail.WithTLSPolicy(cfg.TLSPolicy)}
+	if cfg.Username != "" {
+		opts = append(opts,
+			mail.WithSMTPAuth(mail.SMTPAuthPlain),
+			mail.WithUsername(cfg.Username),
+			mail.WithPassword(cfg.Password),
+		)
+	}
+	return opts
+}
@@ -0,0 +1,191 @@
+package mailpit
+
+import (
+	"bufio"
+	"encoding/base64"
+	"github.com/wneessen/go-mail"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSMTPConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    SMTPConfig
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{"MAILPIT_HOST": "mailpit"},
+			want: SMTPConfig{Host: "mailpit", Port: 1025, TLSPolicy: mail.NoTLS},
+		},
+		{
+			name: "relay",
+			env: map[string]string{
+				"MAILPIT_HOST":       "smtp.example.com",
+				"MAILPIT_PORT":       "587",
+				"MAILPIT_TLS_POLICY": "mandatory",
+				"MAILPIT_USERNAME":   "journey",
+				"MAILPIT_PASSWORD":   "segredo",
+			},
+			want: SMTPConfig{Host: "smtp.example.com", Port: 587, TLSPolicy: mail.TLSMandatory, Username: "journey", Password: "segredo"},
+		},
+		{
+			name: "opportunistic",
+			env:  map[string]string{"MAILPIT_TLS_POLICY": "opportunistic"},
+			want: SMTPConfig{Port: 1025, TLSPolicy: mail.TLSOpportunistic},
+		},
+		{name: "port not a number", env: map[string]string{"MAILPIT_PORT": "smtp"}, wantErr: true},
+		{name: "port out of range", env: map[string]string{"MAILPIT_PORT": "70000"}, wantErr: true},
+		{name: "unknown TLS policy", env: map[string]string{"MAILPIT_TLS_POLICY": "starttls"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"MAILPIT_HOST", "MAILPIT_PORT", "MAILPIT_TLS_POLICY", "MAILPIT_USERNAME", "MAILPIT_PASSWORD"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			got, err := SMTPConfigFromEnv()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("SMTPConfigFromEnv = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SMTPConfigFromEnv: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SMTPConfigFromEnv = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// smtpServer accepts emails on a local port, recording the credentials of
+// the AUTH PLAIN command and who each email is for.
+type smtpServer struct {
+	mu         sync.Mutex
+	auth       []string
+	recipients []string
+}
+
+func newSMTPServer(t *testing.T) (SMTPConfig, *smtpServer) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	s := &smtpServer{}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+
+	addr := l.Addr().(*net.TCPAddr)
+	return SMTPConfig{Host: addr.IP.String(), Port: addr.Port, TLSPolicy: mail.NoTLS}, s
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			_, credentials, _ := strings.Cut(arg, " ")
+			if credentials == "" {
+				reply("334 ")
+				credentials, _ = r.ReadString('\n')
+				credentials = strings.TrimRight(credentials, "\r\n")
+			}
+			decoded, _ := base64.StdEncoding.DecodeString(credentials)
+			s.mu.Lock()
+			s.auth = append(s.auth, string(decoded))
+			s.mu.Unlock()
+			reply("235 2.7.0 Authentication successful")
+		case "RCPT":
+			s.mu.Lock()
+			s.recipients = append(s.recipients, strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>"))
+			s.mu.Unlock()
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+			}
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSendWithSMTPConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		username, password string
+		wantAuth           []string
+	}{
+		{"without auth", "", "", nil},
+		{"with auth", "journey", "segredo", []string{"\x00journey\x00segredo"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newFakeStore()
+			cfg, server := newSMTPServer(t)
+			cfg.Username, cfg.Password = tt.username, tt.password
+
+			mp := newPausedMailpit(s)
+			mp.paused = false
+			mp.smtp = cfg
+			if err := mp.SendConfirmTripEmailToTripOwner(s.trip.ID); err != nil {
+				t.Fatalf("SendConfirmTripEmailToTripOwner: %v", err)
+			}
+
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			if strings.Join(server.auth, ",") != strings.Join(tt.wantAuth, ",") {
+				t.Errorf("authenticated with %q, want %q", server.auth, tt.wantAuth)
+			}
+			if len(server.recipients) != 1 || server.recipients[0] != s.trip.OwnerEmail {
+				t.Errorf("sent to %v, want %s", server.recipients, s.trip.OwnerEmail)
+			}
+			if len(s.emailLog) != 1 || s.emailLog[0].Status != "sent" {
+				t.Errorf("email log = %+v, want the email sent", s.emailLog)
+			}
+		})
+	}
+}
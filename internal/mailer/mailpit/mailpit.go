@@ -2,12 +2,18 @@ package mailpit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+	"journey/internal/mailer/message"
 	"journey/internal/pgstore"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	_ "github.com/joho/godotenv/autoload"
@@ -15,23 +21,91 @@ import (
 
 type store interface {
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
+	GetActivity(context.Context, uuid.UUID) (pgstore.Activity, error)
+	GetParticipants(context.Context, uuid.UUID) ([]pgstore.Participant, error)
+	GetTripParticipantsToInvite(context.Context, uuid.UUID) ([]pgstore.Participant, error)
+	GetParticipantWithTrip(context.Context, uuid.UUID) (pgstore.GetParticipantWithTripRow, error)
+	InsertEmailLog(context.Context, pgstore.InsertEmailLogParams) error
+	InsertOutboxEmail(context.Context, pgstore.InsertOutboxEmailParams) error
+	GetOutboxEmails(context.Context) ([]pgstore.EmailOutbox, error)
+	DeleteOutboxEmail(context.Context, uuid.UUID) error
 }
 
 type Mailpit struct {
-	store store
+	store  store
+	logger *zap.Logger
+	smtp   SMTPConfig
+	// baseURL is where the API is reachable from the recipients, used to
+	// build the links in the emails.
+	baseURL string
+	// participantConfirmURL is the page, in the frontend, where invited
+	// participants confirm their presence.
+	participantConfirmURL string
+	// paused holds every email in the outbox instead of sending it, so
+	// operators can stop outbound email during an incident. FlushOutbox sends
+	// the held emails once unpaused.
+	paused bool
+	// dialAttempts is how many times connecting to the SMTP server is tried
+	// before giving up, waiting dialBaseDelay after the first failure and
+	// twice as long after each one that follows.
+	dialAttempts  int
+	dialBaseDelay time.Duration
+	// queryTimeout bounds the database lookups of each send, so a degraded
+	// database cannot leave the goroutines sending emails hanging.
+	queryTimeout time.Duration
 }
 
-func NewMailpit(pool *pgxpool.Pool) Mailpit {
+func NewMailpit(pool *pgxpool.Pool, logger *zap.Logger, smtp SMTPConfig) Mailpit {
+	paused, _ := strconv.ParseBool(os.Getenv("EMAIL_PAUSED"))
+	if paused {
+		logger.Warn("EMAIL_PAUSED is set, emails are queued in the outbox instead of sent")
+	}
+
+	dialAttempts := 3
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_EMAIL_DIAL_ATTEMPTS")); err == nil && v > 0 {
+		dialAttempts = v
+	}
+
+	dialBaseDelay := 200 * time.Millisecond
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_EMAIL_DIAL_BASE_DELAY_MS")); err == nil && v >= 0 {
+		dialBaseDelay = time.Duration(v) * time.Millisecond
+	}
+
+	queryTimeout := 5 * time.Second
+	if v, err := strconv.Atoi(os.Getenv("JOURNEY_EMAIL_QUERY_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		queryTimeout = time.Duration(v) * time.Second
+	}
+
 	return Mailpit{
-		store: pgstore.New(pool),
+		store:                 pgstore.New(pool),
+		logger:                logger,
+		smtp:                  smtp,
+		baseURL:               os.Getenv("JOURNEY_API_BASE_URL"),
+		participantConfirmURL: os.Getenv("JOURNEY_PARTICIPANT_CONFIRM_URL"),
+		paused:                paused,
+		dialAttempts:          dialAttempts,
+		dialBaseDelay:         dialBaseDelay,
+		queryTimeout:          queryTimeout,
 	}
 }
 
+// queryError wraps err, returned by method while getting what from the
+// database, telling apart when queryTimeout ran out.
+func (mp Mailpit) queryError(method, what string, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("mailpit: timed out after %s getting %s for %s: %w", mp.queryTimeout, what, method, err)
+	}
+	return fmt.Errorf("mailpit: failed to get %s for %s: %w", what, method, err)
+}
+
 func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 	ctx := context.Background()
-	trip, err := mp.store.GetTrip(ctx, tripID)
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	defer cancel()
+
+	trip, err := mp.store.GetTrip(queryCtx, tripID)
 	if err != nil {
-		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
+		return mp.queryError("SendConfirmTripEmailToTripOwner", "trip", err)
 	}
 
 	msg := mail.NewMsg()
@@ -43,24 +117,275 @@ func (mp Mailpit) SendConfirmTripEmailToTripOwner(tripID uuid.UUID) error {
 		return fmt.Errorf("mailpit: failed to set To in email for SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	msg.Subject("Confirme sua viagem")
-	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
-		Olá, %s!
+	confirmURL := message.ConfirmTripURL(mp.baseURL, trip.ID)
+	if confirmURL == "" {
+		mp.logger.Warn(
+			"JOURNEY_API_BASE_URL is not set, sending the confirmation email without a link",
+			zap.String("trip_id", tripID.String()),
+		)
+	}
+
+	content, err := message.ConfirmTrip(trip, confirmURL)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to render email for SendConfirmTripEmailToTripOwner: %w", err)
+	}
+
+	msg.Subject(content.Subject)
+	msg.SetBodyString(mail.TypeTextPlain, content.Text)
+	msg.AddAlternativeString(mail.TypeTextHTML, content.HTML)
+
+	sendErr := mp.send(ctx, msg)
+
+	entry := pgstore.InsertEmailLogParams{
+		TripID:    trip.ID,
+		Recipient: trip.OwnerEmail,
+		Kind:      "confirm_trip",
+		Status:    mp.sentStatus(),
+	}
+	if sendErr != nil {
+		sendErr = fmt.Errorf("mailpit: failed to send email for SendConfirmTripEmailToTripOwner: %w", sendErr)
+		entry.Status = "failed"
+		entry.Error = pgtype.Text{Valid: true, String: sendErr.Error()}
+	}
+	if err := mp.store.InsertEmailLog(ctx, entry); err != nil {
+		return errors.Join(sendErr, fmt.Errorf("mailpit: failed to log email for SendConfirmTripEmailToTripOwner: %w", err))
+	}
+
+	return sendErr
+}
+
+func (mp Mailpit) SendActivityReminderEmail(activityID uuid.UUID) error {
+	ctx := context.Background()
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	defer cancel()
+
+	activity, err := mp.store.GetActivity(queryCtx, activityID)
+	if err != nil {
+		return mp.queryError("SendActivityReminderEmail", "activity", err)
+	}
+
+	trip, err := mp.store.GetTrip(queryCtx, activity.TripID)
+	if err != nil {
+		return mp.queryError("SendActivityReminderEmail", "trip", err)
+	}
+
+	participants, err := mp.store.GetParticipants(queryCtx, activity.TripID)
+	if err != nil {
+		return mp.queryError("SendActivityReminderEmail", "participants", err)
+	}
+
+	var msgs []*mail.Msg
+	for _, participant := range participants {
+		if !participant.IsConfirmed {
+			continue
+		}
+
+		msg := mail.NewMsg()
+		if err := msg.From("mailpit@journey.com"); err != nil {
+			return fmt.Errorf("mailpit: failed to set From in email for SendActivityReminderEmail: %w", err)
+		}
+
+		if err := msg.To(participant.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set To in email for SendActivityReminderEmail: %w", err)
+		}
+
+		msg.Subject("Lembrete de atividade")
+		msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
 		
-		A sua viagem para %s que começa no dia %s precisa ser confirmada.
-		Clique no botão abaixo para confirmar.
+		A atividade %s da sua viagem para %s acontece em %s.
 		`,
-		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
-	))
+			activity.Title, trip.Destination, activity.OccursAt.Time.Format("02/01/2006 15:04"),
+		))
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := mp.send(ctx, msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed to send email for SendActivityReminderEmail: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripFinalizedEmails tells the owner and every participant of the trip
+// that it is confirmed, emailing each address once.
+func (mp Mailpit) SendTripFinalizedEmails(tripID uuid.UUID) error {
+	ctx := context.Background()
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	defer cancel()
+
+	trip, err := mp.store.GetTrip(queryCtx, tripID)
+	if err != nil {
+		return mp.queryError("SendTripFinalizedEmails", "trip", err)
+	}
+
+	participants, err := mp.store.GetParticipants(queryCtx, tripID)
+	if err != nil {
+		return mp.queryError("SendTripFinalizedEmails", "participants", err)
+	}
+
+	content, err := message.TripFinalized(trip)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to render email for SendTripFinalizedEmails: %w", err)
+	}
+
+	recipients := []string{trip.OwnerEmail}
+	for _, participant := range participants {
+		recipients = append(recipients, participant.Email)
+	}
+
+	seen := make(map[string]bool, len(recipients))
+	var msgs []*mail.Msg
+	for _, recipient := range recipients {
+		if seen[strings.ToLower(recipient)] {
+			continue
+		}
+		seen[strings.ToLower(recipient)] = true
+
+		msg := mail.NewMsg()
+		if err := msg.From("mailpit@journey.com"); err != nil {
+			return fmt.Errorf("mailpit: failed to set From in email for SendTripFinalizedEmails: %w", err)
+		}
+
+		if err := msg.To(recipient); err != nil {
+			return fmt.Errorf("mailpit: failed to set To in email for SendTripFinalizedEmails: %w", err)
+		}
+
+		msg.Subject(content.Subject)
+		msg.SetBodyString(mail.TypeTextPlain, content.Text)
+		msg.AddAlternativeString(mail.TypeTextHTML, content.HTML)
+		msgs = append(msgs, msg)
+	}
+
+	if err := mp.send(ctx, msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed to send email for SendTripFinalizedEmails: %w", err)
+	}
+
+	return nil
+}
+
+// SendInviteEmailsToParticipants invites every participant of the trip who has
+// not confirmed yet and was not invited already, as when they were invited
+// after the trip was created. A failed email does not stop the others from
+// being sent; every attempt is recorded in the email log and the failures are
+// returned together.
+func (mp Mailpit) SendInviteEmailsToParticipants(tripID uuid.UUID) error {
+	return mp.sendInviteEmails("SendInviteEmailsToParticipants", tripID, mp.store.GetTripParticipantsToInvite)
+}
 
-	client, err := mail.NewClient(os.Getenv("MAILPIT_HOST"), mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
+// ResendInviteEmailsToParticipants invites again every participant of the
+// trip who has not confirmed yet, whether they were invited before or not.
+func (mp Mailpit) ResendInviteEmailsToParticipants(tripID uuid.UUID) error {
+	return mp.sendInviteEmails("ResendInviteEmailsToParticipants", tripID, mp.store.GetParticipants)
+}
+
+// sendInviteEmails invites the unconfirmed participants of the trip returned
+// by getParticipants, on behalf of method.
+func (mp Mailpit) sendInviteEmails(method string, tripID uuid.UUID, getParticipants func(context.Context, uuid.UUID) ([]pgstore.Participant, error)) error {
+	ctx := context.Background()
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	defer cancel()
+
+	trip, err := mp.store.GetTrip(queryCtx, tripID)
+	if err != nil {
+		return mp.queryError(method, "trip", err)
+	}
+
+	participants, err := getParticipants(queryCtx, tripID)
 	if err != nil {
-		return fmt.Errorf("mailpit: failed to create email client in email for SendConfirmTripEmailToTripOwner: %w", err)
+		return mp.queryError(method, "participants", err)
 	}
 
-	if err := client.DialAndSend(msg); err != nil {
-		return fmt.Errorf("mailpit: failed to send email for SendConfirmTripEmailToTripOwner: %w", err)
+	client, err := mp.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to connect for %s: %w", method, err)
+	}
+	defer func() { _ = client.Close() }()
+
+	var errs []error
+	for _, participant := range participants {
+		if participant.IsConfirmed {
+			continue
+		}
+
+		if err := mp.sendInviteEmail(ctx, client, trip, participant); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to invite %s for %s: %w", participant.Email, method, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SendInviteEmailToParticipant invites a single participant to their trip,
+// recording the attempt in the email log.
+func (mp Mailpit) SendInviteEmailToParticipant(participantID uuid.UUID) error {
+	ctx := context.Background()
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	defer cancel()
+
+	row, err := mp.store.GetParticipantWithTrip(queryCtx, participantID)
+	if err != nil {
+		return mp.queryError("SendInviteEmailToParticipant", "participant", err)
+	}
+
+	client, err := mp.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to connect for SendInviteEmailToParticipant: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if err := mp.sendInviteEmail(ctx, client, row.Trip, row.Participant); err != nil {
+		return fmt.Errorf("mailpit: failed to invite participant for SendInviteEmailToParticipant: %w", err)
 	}
 
 	return nil
 }
+
+// sendInviteEmail sends the invite of participant through client and
+// records the attempt in the email log.
+func (mp Mailpit) sendInviteEmail(ctx context.Context, client sender, trip pgstore.Trip, participant pgstore.Participant) error {
+	sendErr := sendInvite(client, trip, participant, message.ConfirmParticipantURL(mp.participantConfirmURL, participant.ID))
+
+	entry := pgstore.InsertEmailLogParams{
+		TripID:        trip.ID,
+		ParticipantID: pgtype.UUID{Valid: true, Bytes: participant.ID},
+		Recipient:     participant.Email,
+		Kind:          "invite",
+		Status:        mp.sentStatus(),
+	}
+	if sendErr != nil {
+		entry.Status = "failed"
+		entry.Error = pgtype.Text{Valid: true, String: sendErr.Error()}
+	}
+	if err := mp.store.InsertEmailLog(ctx, entry); err != nil {
+		return errors.Join(sendErr, fmt.Errorf("failed to log email: %w", err))
+	}
+
+	return sendErr
+}
+
+func sendInvite(client sender, trip pgstore.Trip, participant pgstore.Participant, confirmURL string) error {
+	msg := mail.NewMsg()
+	if err := msg.From("mailpit@journey.com"); err != nil {
+		return fmt.Errorf("failed to set From: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return fmt.Errorf("failed to set To: %w", err)
+	}
+
+	content, err := message.InviteParticipant(trip, confirmURL)
+	if err != nil {
+		return err
+	}
+
+	msg.Subject(content.Subject)
+	msg.SetBodyString(mail.TypeTextPlain, content.Text)
+	msg.AddAlternativeString(mail.TypeTextHTML, content.HTML)
+
+	return client.Send(msg)
+}
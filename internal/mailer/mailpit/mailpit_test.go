@@ -0,0 +1,363 @@
+package mailpit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"net"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeStore serves a single trip and records the email log and the outbox.
+type fakeStore struct {
+	trip         pgstore.Trip
+	participants []pgstore.Participant
+	emailLog     []pgstore.InsertEmailLogParams
+	outbox       []pgstore.EmailOutbox
+}
+
+func newFakeStore(participantEmails ...string) *fakeStore {
+	s := &fakeStore{trip: pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Florianópolis",
+		OwnerEmail:  "owner@example.com",
+		OwnerName:   "Ana",
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC)},
+		EndsAt:      pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 15, 0, 0, 0, 0, time.UTC)},
+	}}
+	for _, email := range participantEmails {
+		s.participants = append(s.participants, pgstore.Participant{ID: uuid.New(), TripID: s.trip.ID, Email: email})
+	}
+	return s
+}
+
+func (s *fakeStore) GetTrip(_ context.Context, id uuid.UUID) (pgstore.Trip, error) {
+	if id != s.trip.ID {
+		return pgstore.Trip{}, pgx.ErrNoRows
+	}
+	return s.trip, nil
+}
+
+func (s *fakeStore) GetActivity(context.Context, uuid.UUID) (pgstore.Activity, error) {
+	return pgstore.Activity{}, pgx.ErrNoRows
+}
+
+func (s *fakeStore) GetParticipants(_ context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	if tripID != s.trip.ID {
+		return nil, nil
+	}
+	return s.participants, nil
+}
+
+// GetTripParticipantsToInvite leaves out who confirmed and who the email log
+// says was invited.
+func (s *fakeStore) GetTripParticipantsToInvite(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error) {
+	participants, _ := s.GetParticipants(ctx, tripID)
+	var toInvite []pgstore.Participant
+	for _, p := range participants {
+		invited := slices.ContainsFunc(s.emailLog, func(entry pgstore.InsertEmailLogParams) bool {
+			return entry.Kind == "invite" && entry.ParticipantID.Bytes == p.ID && entry.Status != "failed"
+		})
+		if !p.IsConfirmed && !invited {
+			toInvite = append(toInvite, p)
+		}
+	}
+	return toInvite, nil
+}
+
+func (s *fakeStore) GetParticipantWithTrip(_ context.Context, id uuid.UUID) (pgstore.GetParticipantWithTripRow, error) {
+	for _, p := range s.participants {
+		if p.ID == id {
+			return pgstore.GetParticipantWithTripRow{Participant: p, Trip: s.trip}, nil
+		}
+	}
+	return pgstore.GetParticipantWithTripRow{}, pgx.ErrNoRows
+}
+
+func (s *fakeStore) InsertEmailLog(_ context.Context, arg pgstore.InsertEmailLogParams) error {
+	s.emailLog = append(s.emailLog, arg)
+	return nil
+}
+
+func (s *fakeStore) InsertOutboxEmail(_ context.Context, arg pgstore.InsertOutboxEmailParams) error {
+	s.outbox = append(s.outbox, pgstore.EmailOutbox{ID: uuid.New(), Recipient: arg.Recipient, Message: arg.Message})
+	return nil
+}
+
+func (s *fakeStore) GetOutboxEmails(context.Context) ([]pgstore.EmailOutbox, error) {
+	return slices.Clone(s.outbox), nil
+}
+
+func (s *fakeStore) DeleteOutboxEmail(_ context.Context, id uuid.UUID) error {
+	s.outbox = slices.DeleteFunc(s.outbox, func(email pgstore.EmailOutbox) bool { return email.ID == id })
+	return nil
+}
+
+// recipients lists who the emails in the outbox are for.
+func (s *fakeStore) recipients() []string {
+	var recipients []string
+	for _, email := range s.outbox {
+		recipients = append(recipients, email.Recipient)
+	}
+	return recipients
+}
+
+// newPausedMailpit queues every email in the outbox of s. Its SMTP host does
+// not resolve, so any attempt to connect fails.
+func newPausedMailpit(s *fakeStore) Mailpit {
+	return Mailpit{
+		store:                 s,
+		logger:                zap.NewNop(),
+		smtp:                  SMTPConfig{Host: "smtp.invalid", Port: 1025},
+		baseURL:               "https://api.example.com",
+		participantConfirmURL: "https://app.example.com/confirm",
+		paused:                true,
+		dialAttempts:          1,
+		queryTimeout:          time.Second,
+	}
+}
+
+func TestSendTripFinalizedEmails(t *testing.T) {
+	// The owner is also listed as a participant, in another case.
+	s := newFakeStore("ana@example.com", "Owner@Example.com", "bia@example.com")
+	mp := newPausedMailpit(s)
+
+	if err := mp.SendTripFinalizedEmails(s.trip.ID); err != nil {
+		t.Fatalf("SendTripFinalizedEmails: %v", err)
+	}
+
+	want := []string{"owner@example.com", "ana@example.com", "bia@example.com"}
+	if got := s.recipients(); !slices.Equal(got, want) {
+		t.Errorf("emailed %q, want %q once each", got, want)
+	}
+}
+
+func TestSendInviteEmailToParticipant(t *testing.T) {
+	s := newFakeStore("ana@example.com", "bia@example.com")
+	mp := newPausedMailpit(s)
+	ana := s.participants[0]
+
+	if err := mp.SendInviteEmailToParticipant(ana.ID); err != nil {
+		t.Fatalf("SendInviteEmailToParticipant: %v", err)
+	}
+
+	if got := s.recipients(); !slices.Equal(got, []string{ana.Email}) {
+		t.Errorf("emailed %q, want only %s", got, ana.Email)
+	}
+	if len(s.emailLog) != 1 || s.emailLog[0].ParticipantID.Bytes != ana.ID || s.emailLog[0].Kind != "invite" {
+		t.Errorf("email log = %+v, want the invite of %s", s.emailLog, ana.Email)
+	}
+
+	if err := mp.SendInviteEmailToParticipant(uuid.New()); err == nil {
+		t.Error("inviting a missing participant succeeded")
+	}
+}
+
+func TestSendInviteEmailsToParticipants(t *testing.T) {
+	s := newFakeStore("ana@example.com", "bia@example.com", "caio@example.com")
+	s.participants[2].IsConfirmed = true
+	mp := newPausedMailpit(s)
+	ana := s.participants[0]
+
+	// Ana was invited on her own after the trip was created.
+	if err := mp.SendInviteEmailToParticipant(ana.ID); err != nil {
+		t.Fatalf("SendInviteEmailToParticipant: %v", err)
+	}
+	s.outbox = nil
+
+	if err := mp.SendInviteEmailsToParticipants(s.trip.ID); err != nil {
+		t.Fatalf("SendInviteEmailsToParticipants: %v", err)
+	}
+	if got := s.recipients(); !slices.Equal(got, []string{"bia@example.com"}) {
+		t.Errorf("invited %q, want only bia@example.com", got)
+	}
+
+	// Resending reaches everyone not confirmed, invited before or not.
+	s.outbox = nil
+	if err := mp.ResendInviteEmailsToParticipants(s.trip.ID); err != nil {
+		t.Fatalf("ResendInviteEmailsToParticipants: %v", err)
+	}
+	if got := s.recipients(); !slices.Equal(got, []string{"ana@example.com", "bia@example.com"}) {
+		t.Errorf("reinvited %q, want ana@example.com and bia@example.com", got)
+	}
+}
+
+func TestSendConfirmTripEmailToTripOwner(t *testing.T) {
+	s := newFakeStore()
+	mp := newPausedMailpit(s)
+
+	if err := mp.SendConfirmTripEmailToTripOwner(s.trip.ID); err != nil {
+		t.Fatalf("SendConfirmTripEmailToTripOwner: %v", err)
+	}
+	if got := s.recipients(); !slices.Equal(got, []string{s.trip.OwnerEmail}) {
+		t.Fatalf("emailed %q, want only the owner", got)
+	}
+	// Undo the soft line breaks of the quoted-printable body.
+	body := strings.ReplaceAll(string(s.outbox[0].Message), "=\r\n", "")
+	if confirmURL := "https://api.example.com/trips/" + s.trip.ID.String() + "/confirm"; !strings.Contains(body, confirmURL) {
+		t.Errorf("email does not link to %s:\n%s", confirmURL, body)
+	}
+
+	// Without a base URL the email still goes, with no link.
+	s.outbox = nil
+	mp.baseURL = ""
+	if err := mp.SendConfirmTripEmailToTripOwner(s.trip.ID); err != nil {
+		t.Fatalf("SendConfirmTripEmailToTripOwner without a base URL: %v", err)
+	}
+	if len(s.outbox) != 1 || strings.Contains(string(s.outbox[0].Message), "/confirm") {
+		t.Errorf("outbox = %d emails, want one with no confirmation link", len(s.outbox))
+	}
+}
+
+// smtpListener counts the connections made to a local port, closing each
+// right away.
+func smtpListener(t *testing.T) (SMTPConfig, *atomic.Int32) {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	var dials atomic.Int32
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			dials.Add(1)
+			_ = conn.Close()
+		}
+	}()
+
+	addr := l.Addr().(*net.TCPAddr)
+	return SMTPConfig{Host: addr.IP.String(), Port: addr.Port, TLSPolicy: mail.NoTLS}, &dials
+}
+
+func TestPausedEmailsAreQueued(t *testing.T) {
+	s := newFakeStore("ana@example.com", "bia@example.com")
+	mp := newPausedMailpit(s)
+	var dials *atomic.Int32
+	mp.smtp, dials = smtpListener(t)
+
+	if err := mp.SendConfirmTripEmailToTripOwner(s.trip.ID); err != nil {
+		t.Fatalf("SendConfirmTripEmailToTripOwner: %v", err)
+	}
+	if err := mp.SendInviteEmailsToParticipants(s.trip.ID); err != nil {
+		t.Fatalf("SendInviteEmailsToParticipants: %v", err)
+	}
+
+	if n := dials.Load(); n != 0 {
+		t.Errorf("dialed the SMTP server %d times while paused", n)
+	}
+	want := []string{"owner@example.com", "ana@example.com", "bia@example.com"}
+	if got := s.recipients(); !slices.Equal(got, want) {
+		t.Errorf("outbox recipients = %v, want %v", got, want)
+	}
+	for _, email := range s.outbox {
+		if !strings.Contains(string(email.Message), "Subject: ") {
+			t.Errorf("queued email for %s is not rendered:\n%s", email.Recipient, email.Message)
+		}
+	}
+	for _, entry := range s.emailLog {
+		if entry.Status != "queued" {
+			t.Errorf("%s email to %s logged as %s, want queued", entry.Kind, entry.Recipient, entry.Status)
+		}
+	}
+
+	// Once resumed, emails go to the SMTP server again.
+	mp.paused = false
+	if err := mp.SendConfirmTripEmailToTripOwner(s.trip.ID); err == nil {
+		t.Error("sending to a server that hangs up succeeded")
+	}
+	if n := dials.Load(); n != 1 {
+		t.Errorf("dialed the SMTP server %d times once resumed, want 1", n)
+	}
+	if len(s.outbox) != 3 {
+		t.Errorf("outbox has %d emails once resumed, want 3", len(s.outbox))
+	}
+}
+
+// fakeSender records the emails it sends, failing those to refuse.
+type fakeSender struct {
+	sent   []*mail.Msg
+	refuse string
+}
+
+func (f *fakeSender) Send(msgs ...*mail.Msg) error {
+	for _, msg := range msgs {
+		recipients, err := msg.GetRecipients()
+		if err != nil {
+			return err
+		}
+		if slices.Contains(recipients, f.refuse) {
+			return errors.New("recipient refused")
+		}
+		f.sent = append(f.sent, msg)
+	}
+	return nil
+}
+
+func (*fakeSender) Close() error {
+	return nil
+}
+
+func TestFlushOutbox(t *testing.T) {
+	s := newFakeStore("ana@example.com", "bia@example.com")
+	mp := newPausedMailpit(s)
+	if err := mp.SendInviteEmailsToParticipants(s.trip.ID); err != nil {
+		t.Fatalf("SendInviteEmailsToParticipants: %v", err)
+	}
+	queued := slices.Clone(s.outbox)
+
+	// Nothing leaves the outbox while sends are still paused.
+	if err := mp.FlushOutbox(context.Background()); err != nil {
+		t.Fatalf("FlushOutbox while paused: %v", err)
+	}
+	if len(s.outbox) != 2 {
+		t.Fatalf("outbox has %d emails after flushing while paused, want 2", len(s.outbox))
+	}
+
+	mp.paused = false
+	client := &fakeSender{refuse: "bia@example.com"}
+	if err := mp.flush(context.Background(), client, queued); err == nil {
+		t.Error("flushing an email the server refuses succeeded")
+	}
+	if len(client.sent) != 1 {
+		t.Fatalf("sent %d emails, want the one to ana@example.com", len(client.sent))
+	}
+	if to, _ := client.sent[0].GetRecipients(); !slices.Equal(to, []string{"ana@example.com"}) {
+		t.Errorf("sent the email to %v, want ana@example.com", to)
+	}
+	if got := s.recipients(); !slices.Equal(got, []string{"bia@example.com"}) {
+		t.Errorf("outbox recipients = %v, want the refused email only", got)
+	}
+
+	var body bytes.Buffer
+	if _, err := client.sent[0].WriteTo(&body); err != nil {
+		t.Fatalf("failed to render the sent email: %v", err)
+	}
+	if !strings.Contains(body.String(), "Subject: ") || !strings.Contains(body.String(), "text/html") {
+		t.Errorf("sent email lost its subject or HTML part:\n%s", body.String())
+	}
+
+	client.refuse = ""
+	if err := mp.flush(context.Background(), client, s.outbox); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+	if len(s.outbox) != 0 {
+		t.Errorf("outbox has %d emails once flushed, want none", len(s.outbox))
+	}
+}
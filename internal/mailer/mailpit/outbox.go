@@ -0,0 +1,162 @@
+package mailpit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wneessen/go-mail"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"strings"
+	"time"
+)
+
+// sender delivers rendered emails.
+type sender interface {
+	Send(msgs ...*mail.Msg) error
+	Close() error
+}
+
+// dial connects to the SMTP server, making up to dialAttempts attempts with a
+// delay doubling from dialBaseDelay in between. While sends are paused no
+// connection is made and the emails are queued in the outbox instead.
+func (mp Mailpit) dial(ctx context.Context) (sender, error) {
+	if mp.paused {
+		return outbox{ctx: ctx, store: mp.store}, nil
+	}
+
+	client, err := mail.NewClient(mp.smtp.Host, mp.smtp.clientOptions()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email client: %w", err)
+	}
+
+	// Only connecting is retried: once connected, errors such as a rejected
+	// recipient would fail the same way again.
+	delay := mp.dialBaseDelay
+	for attempt := 1; ; attempt++ {
+		err := client.DialWithContext(ctx)
+		if err == nil {
+			return client, nil
+		}
+		if attempt >= mp.dialAttempts {
+			return nil, fmt.Errorf("failed to connect after %d attempts: %w", attempt, err)
+		}
+
+		mp.logger.Warn("failed to connect to the SMTP server, retrying", zap.Error(err), zap.Int("attempt", attempt), zap.Duration("delay", delay))
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to connect: %w", errors.Join(err, ctx.Err()))
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// send delivers msgs over a connection of their own.
+func (mp Mailpit) send(ctx context.Context, msgs ...*mail.Msg) error {
+	client, err := mp.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	return client.Send(msgs...)
+}
+
+// sentStatus is how a successful send is recorded in the email log.
+func (mp Mailpit) sentStatus() string {
+	if mp.paused {
+		return "queued"
+	}
+	return "sent"
+}
+
+// outbox stores the emails it is given, fully rendered, for FlushOutbox to
+// send once sending resumes.
+type outbox struct {
+	ctx   context.Context
+	store store
+}
+
+func (o outbox) Send(msgs ...*mail.Msg) error {
+	for _, msg := range msgs {
+		recipients, err := msg.GetRecipients()
+		if err != nil {
+			return fmt.Errorf("failed to get recipients: %w", err)
+		}
+
+		var raw bytes.Buffer
+		if _, err := msg.WriteTo(&raw); err != nil {
+			return fmt.Errorf("failed to render email: %w", err)
+		}
+
+		if err := o.store.InsertOutboxEmail(o.ctx, pgstore.InsertOutboxEmailParams{
+			Recipient: strings.Join(recipients, ", "),
+			Message:   raw.Bytes(),
+		}); err != nil {
+			return fmt.Errorf("failed to queue email: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (outbox) Close() error {
+	return nil
+}
+
+// FlushOutbox sends the emails queued while sends were paused, oldest first,
+// removing each one from the outbox once delivered. Emails that fail to send
+// stay queued for the next flush. It does nothing while sends are paused.
+func (mp Mailpit) FlushOutbox(ctx context.Context) error {
+	if mp.paused {
+		return nil
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, mp.queryTimeout)
+	emails, err := mp.store.GetOutboxEmails(queryCtx)
+	cancel()
+	if err != nil {
+		return mp.queryError("FlushOutbox", "outbox emails", err)
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+
+	client, err := mp.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to connect for FlushOutbox: %w", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	return mp.flush(ctx, client, emails)
+}
+
+// flush sends emails through client, deleting each one from the outbox once
+// sent.
+func (mp Mailpit) flush(ctx context.Context, client sender, emails []pgstore.EmailOutbox) error {
+	var errs []error
+	sent := 0
+	for _, email := range emails {
+		msg, err := mail.EMLToMsgFromReader(bytes.NewReader(email.Message))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to parse queued email %s for FlushOutbox: %w", email.ID, err))
+			continue
+		}
+
+		if err := client.Send(msg); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to send queued email %s for FlushOutbox: %w", email.ID, err))
+			continue
+		}
+
+		if err := mp.store.DeleteOutboxEmail(ctx, email.ID); err != nil {
+			errs = append(errs, fmt.Errorf("mailpit: failed to delete queued email %s for FlushOutbox: %w", email.ID, err))
+			continue
+		}
+		sent++
+	}
+
+	mp.logger.Info("flushed the email outbox", zap.Int("sent", sent), zap.Int("failed", len(errs)))
+	return errors.Join(errs...)
+}
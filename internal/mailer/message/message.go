@@ -0,0 +1,168 @@
+// Package message renders the emails the app sends, so they can be delivered
+// over SMTP or handed to whoever sends them instead.
+package message
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/google/uuid"
+	"html/template"
+	"journey/internal/pgstore"
+	"net/url"
+	"strings"
+	"time"
+)
+
+//go:embed templates/*.html
+var templatesFS embed.FS
+
+var (
+	confirmTripTemplate       = template.Must(template.ParseFS(templatesFS, "templates/confirm_trip.html"))
+	tripFinalizedTemplate     = template.Must(template.ParseFS(templatesFS, "templates/trip_finalized.html"))
+	inviteParticipantTemplate = template.Must(template.ParseFS(templatesFS, "templates/invite_participant.html"))
+)
+
+// Message is a rendered email, with both a plain text and an HTML body.
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// ConfirmTripData is what the trip confirmation email is rendered with.
+type ConfirmTripData struct {
+	OwnerName   string
+	Destination string
+	StartsAt    string
+	// ConfirmURL is left out of the email when empty.
+	ConfirmURL string
+}
+
+// ConfirmTrip renders the email asking the owner of trip to confirm it at
+// confirmURL.
+func ConfirmTrip(trip pgstore.Trip, confirmURL string) (Message, error) {
+	data := ConfirmTripData{
+		OwnerName:   trip.OwnerName,
+		Destination: trip.Destination,
+		StartsAt:    trip.StartsAt.Time.Format(time.DateOnly),
+		ConfirmURL:  confirmURL,
+	}
+
+	var html bytes.Buffer
+	if err := confirmTripTemplate.Execute(&html, data); err != nil {
+		return Message{}, fmt.Errorf("message: failed to render html for ConfirmTrip: %w", err)
+	}
+
+	text := fmt.Sprintf(`
+		Olá, %s!
+
+		A sua viagem para %s que começa no dia %s precisa ser confirmada.
+		`,
+		data.OwnerName, data.Destination, data.StartsAt,
+	)
+	if data.ConfirmURL != "" {
+		text += "Confirme em " + data.ConfirmURL + "\n"
+	}
+
+	return Message{
+		Subject: "Confirme sua viagem",
+		Text:    text,
+		HTML:    html.String(),
+	}, nil
+}
+
+// ConfirmTripURL is the link confirming a trip on the API served at baseURL,
+// or empty when baseURL is.
+func ConfirmTripURL(baseURL string, tripID uuid.UUID) string {
+	if baseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/trips/" + tripID.String() + "/confirm"
+}
+
+// ConfirmParticipantURL is the link to the page at confirmPageURL where a
+// participant confirms their presence, or empty when confirmPageURL is. The
+// page gets the participant as the token query param, to check it with
+// GET /participants/confirm/validate and confirm it with
+// PATCH /participants/{participantId}/confirm: a link in an email can only be
+// opened with a GET, so it cannot confirm on the API by itself.
+func ConfirmParticipantURL(confirmPageURL string, participantID uuid.UUID) string {
+	if confirmPageURL == "" {
+		return ""
+	}
+
+	u, err := url.Parse(confirmPageURL)
+	if err != nil {
+		return ""
+	}
+	query := u.Query()
+	query.Set("token", participantID.String())
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// TripFinalized renders the email telling everyone on trip that it is confirmed.
+func TripFinalized(trip pgstore.Trip) (Message, error) {
+	startsAt := trip.StartsAt.Time.Format(time.DateOnly)
+	endsAt := trip.EndsAt.Time.Format(time.DateOnly)
+
+	var html bytes.Buffer
+	err := tripFinalizedTemplate.Execute(&html, struct {
+		Destination string
+		StartsAt    string
+		EndsAt      string
+	}{trip.Destination, startsAt, endsAt})
+	if err != nil {
+		return Message{}, fmt.Errorf("message: failed to render html for TripFinalized: %w", err)
+	}
+
+	return Message{
+		Subject: "Viagem confirmada",
+		Text: fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s está confirmada, de %s a %s.
+		Boa viagem!
+		`,
+			trip.Destination, startsAt, endsAt,
+		),
+		HTML: html.String(),
+	}, nil
+}
+
+// InviteParticipant renders the email inviting someone to trip, linking to
+// confirmURL when it is not empty.
+func InviteParticipant(trip pgstore.Trip, confirmURL string) (Message, error) {
+	startsAt := trip.StartsAt.Time.Format(time.DateOnly)
+	endsAt := trip.EndsAt.Time.Format(time.DateOnly)
+
+	var html bytes.Buffer
+	err := inviteParticipantTemplate.Execute(&html, struct {
+		OwnerName   string
+		Destination string
+		StartsAt    string
+		EndsAt      string
+		ConfirmURL  string
+	}{trip.OwnerName, trip.Destination, startsAt, endsAt, confirmURL})
+	if err != nil {
+		return Message{}, fmt.Errorf("message: failed to render html for InviteParticipant: %w", err)
+	}
+
+	text := fmt.Sprintf(`
+		Olá!
+
+		%s convidou você para uma viagem para %s, de %s a %s.
+		`,
+		trip.OwnerName, trip.Destination, startsAt, endsAt,
+	)
+	if confirmURL != "" {
+		text += "Confirme sua presença em " + confirmURL + "\n"
+	}
+
+	return Message{
+		Subject: "Convite de viagem",
+		Text:    text,
+		HTML:    html.String(),
+	}, nil
+}
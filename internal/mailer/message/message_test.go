@@ -0,0 +1,52 @@
+package message
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"journey/internal/pgstore"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConfirmTripURL(t *testing.T) {
+	tripID := uuid.New()
+
+	for _, baseURL := range []string{"https://api.example.com", "https://api.example.com/"} {
+		if got, want := ConfirmTripURL(baseURL, tripID), "https://api.example.com/trips/"+tripID.String()+"/confirm"; got != want {
+			t.Errorf("ConfirmTripURL(%q) = %q, want %q", baseURL, got, want)
+		}
+	}
+	if got := ConfirmTripURL("", tripID); got != "" {
+		t.Errorf("ConfirmTripURL without a base URL = %q, want it empty", got)
+	}
+}
+
+func TestConfirmTrip(t *testing.T) {
+	trip := pgstore.Trip{
+		ID:          uuid.New(),
+		Destination: "Florianópolis",
+		OwnerName:   "Ana",
+		StartsAt:    pgtype.Timestamp{Valid: true, Time: time.Date(2030, 6, 10, 0, 0, 0, 0, time.UTC)},
+	}
+	confirmURL := ConfirmTripURL("https://api.example.com", trip.ID)
+
+	msg, err := ConfirmTrip(trip, confirmURL)
+	if err != nil {
+		t.Fatalf("ConfirmTrip: %v", err)
+	}
+	if !strings.Contains(msg.Text, confirmURL) {
+		t.Errorf("text body does not link to %s: %s", confirmURL, msg.Text)
+	}
+	if !strings.Contains(msg.HTML, `href="`+confirmURL+`"`) {
+		t.Errorf("html body does not link to %s: %s", confirmURL, msg.HTML)
+	}
+
+	msg, err = ConfirmTrip(trip, "")
+	if err != nil {
+		t.Fatalf("ConfirmTrip: %v", err)
+	}
+	if strings.Contains(msg.Text, "Confirme em") || strings.Contains(msg.HTML, "href") {
+		t.Errorf("email without a confirmation URL links somewhere: %s %s", msg.Text, msg.HTML)
+	}
+}
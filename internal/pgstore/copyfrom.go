@@ -31,6 +31,7 @@ func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].InviteExpiresAt,
 	}, nil
 }
 
@@ -39,5 +40,76 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email", "invite_expires_at"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+}
+
+// iteratorForRestoreActivityParticipants implements pgx.CopyFromSource.
+type iteratorForRestoreActivityParticipants struct {
+	rows                 []RestoreActivityParticipantsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForRestoreActivityParticipants) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForRestoreActivityParticipants) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ActivityID,
+		r.rows[0].ParticipantID,
+		r.rows[0].CreatedAt,
+	}, nil
+}
+
+func (r iteratorForRestoreActivityParticipants) Err() error {
+	return nil
+}
+
+func (q *Queries) RestoreActivityParticipants(ctx context.Context, arg []RestoreActivityParticipantsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"activity_participants"}, []string{"activity_id", "participant_id", "created_at"}, &iteratorForRestoreActivityParticipants{rows: arg})
+}
+
+// iteratorForRestoreLinks implements pgx.CopyFromSource.
+type iteratorForRestoreLinks struct {
+	rows                 []RestoreLinksParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForRestoreLinks) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForRestoreLinks) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].Url,
+		r.rows[0].ActivityID,
+		r.rows[0].CreatedAt,
+	}, nil
+}
+
+func (r iteratorForRestoreLinks) Err() error {
+	return nil
+}
+
+func (q *Queries) RestoreLinks(ctx context.Context, arg []RestoreLinksParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"links"}, []string{"id", "trip_id", "title", "url", "activity_id", "created_at"}, &iteratorForRestoreLinks{rows: arg})
 }
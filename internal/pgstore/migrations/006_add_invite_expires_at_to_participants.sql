@@ -0,0 +1,7 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "invite_expires_at" TIMESTAMP NOT NULL DEFAULT NOW() + INTERVAL '7 days';
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invite_expires_at";
\ No newline at end of file
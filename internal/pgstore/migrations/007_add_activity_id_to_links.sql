@@ -0,0 +1,10 @@
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "activity_id" uuid NULL
+        REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "activity_id";
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "remind_before_minutes" INTEGER NULL,
+    ADD COLUMN IF NOT EXISTS "reminder_sent_at"      TIMESTAMP NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "remind_before_minutes",
+    DROP COLUMN IF EXISTS "reminder_sent_at";
\ No newline at end of file
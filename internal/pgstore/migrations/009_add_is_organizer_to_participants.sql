@@ -0,0 +1,7 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "is_organizer" BOOLEAN NOT NULL DEFAULT FALSE;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "is_organizer";
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS participant_unavailabilities (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "participant_id"    uuid                        NOT NULL,
+    "starts_at"         TIMESTAMP                   NOT NULL,
+    "ends_at"           TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS participant_unavailabilities;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS packing_items (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "text"          VARCHAR(255)                NOT NULL,
+    "is_packed"     BOOLEAN                     NOT NULL    DEFAULT FALSE,
+    "assigned_to"   uuid                        NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (assigned_to) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS packing_items;
\ No newline at end of file
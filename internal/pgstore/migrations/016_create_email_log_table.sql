@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS email_log (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "participant_id"    uuid                        NULL,
+    "recipient"         VARCHAR(255)                NOT NULL,
+    "kind"              VARCHAR(50)                 NOT NULL,
+    "status"            VARCHAR(20)                 NOT NULL,
+    "error"             TEXT                        NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_log;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS activity_attachments (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "activity_id"   uuid                        NOT NULL,
+    "file_name"     VARCHAR(255)                NOT NULL,
+    "content_type"  VARCHAR(100)                NOT NULL,
+    "size"          INTEGER                     NOT NULL,
+    "content"       BYTEA                       NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS activity_attachments;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "url"           VARCHAR(255)                NOT NULL,
+    "events"        TEXT[]                      NOT NULL,
+    "secret"        VARCHAR(64)                 NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS webhook_subscriptions;
\ No newline at end of file
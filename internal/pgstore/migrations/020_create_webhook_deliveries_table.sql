@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "subscription_id"   uuid                        NOT NULL,
+    "event"             VARCHAR(50)                 NOT NULL,
+    "payload"           JSONB                       NOT NULL,
+    "status"            VARCHAR(20)                 NOT NULL    DEFAULT 'pending',
+    "attempts"          INTEGER                     NOT NULL    DEFAULT 0,
+    "last_status_code"  INTEGER                     NULL,
+    "last_error"        TEXT                        NULL,
+    "next_attempt_at"   TIMESTAMP                   NOT NULL    DEFAULT now(),
+    "delivered_at"      TIMESTAMP                   NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT now(),
+
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx
+    ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS webhook_deliveries;
\ No newline at end of file
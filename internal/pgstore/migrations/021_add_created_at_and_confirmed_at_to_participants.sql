@@ -0,0 +1,9 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMP NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS "confirmed_at" TIMESTAMP NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "created_at",
+    DROP COLUMN IF EXISTS "confirmed_at";
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS activity_participants (
+    "activity_id"       uuid                        NOT NULL,
+    "participant_id"    uuid                        NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT now(),
+
+    PRIMARY KEY (activity_id, participant_id),
+
+    FOREIGN KEY (activity_id) REFERENCES activities(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS activity_participants;
\ No newline at end of file
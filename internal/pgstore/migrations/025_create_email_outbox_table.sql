@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS email_outbox (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "recipient"         TEXT                        NOT NULL,
+    "message"           BYTEA                       NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT now()
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS email_outbox;
\ No newline at end of file
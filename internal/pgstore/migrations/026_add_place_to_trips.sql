@@ -0,0 +1,11 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "place_name" TEXT NULL,
+    ADD COLUMN IF NOT EXISTS "latitude" DOUBLE PRECISION NULL,
+    ADD COLUMN IF NOT EXISTS "longitude" DOUBLE PRECISION NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "place_name",
+    DROP COLUMN IF EXISTS "latitude",
+    DROP COLUMN IF EXISTS "longitude";
\ No newline at end of file
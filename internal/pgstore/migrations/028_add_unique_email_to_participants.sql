@@ -0,0 +1,18 @@
+UPDATE participants AS p
+SET deleted_at = now()
+WHERE p.deleted_at IS NULL AND EXISTS (
+    SELECT 1
+    FROM participants AS o
+    WHERE o.trip_id = p.trip_id
+        AND lower(o.email) = lower(p.email)
+        AND o.deleted_at IS NULL
+        AND (o.created_at, o.id) < (p.created_at, p.id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS participants_trip_id_email_key
+    ON participants (trip_id, lower(email))
+    WHERE deleted_at IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS participants_trip_id_email_key;
\ No newline at end of file
@@ -10,24 +10,86 @@ import (
 )
 
 type Activity struct {
-	ID       uuid.UUID        `db:"id" json:"id"`
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID                  uuid.UUID        `db:"id" json:"id"`
+	TripID              uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title               string           `db:"title" json:"title"`
+	OccursAt            pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	RemindBeforeMinutes pgtype.Int4      `db:"remind_before_minutes" json:"remind_before_minutes"`
+	ReminderSentAt      pgtype.Timestamp `db:"reminder_sent_at" json:"reminder_sent_at"`
+	DurationMinutes     pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+	CreatedAt           pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type ActivityAttachment struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	ActivityID  uuid.UUID        `db:"activity_id" json:"activity_id"`
+	FileName    string           `db:"file_name" json:"file_name"`
+	ContentType string           `db:"content_type" json:"content_type"`
+	Size        int32            `db:"size" json:"size"`
+	Content     []byte           `db:"content" json:"content"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type ActivityParticipant struct {
+	ActivityID    uuid.UUID        `db:"activity_id" json:"activity_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type EmailLog struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID      `db:"participant_id" json:"participant_id"`
+	Recipient     string           `db:"recipient" json:"recipient"`
+	Kind          string           `db:"kind" json:"kind"`
+	Status        string           `db:"status" json:"status"`
+	Error         pgtype.Text      `db:"error" json:"error"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type EmailOutbox struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Recipient string           `db:"recipient" json:"recipient"`
+	Message   []byte           `db:"message" json:"message"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
 
 type Link struct {
-	ID     uuid.UUID `db:"id" json:"id"`
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+	ID         uuid.UUID        `db:"id" json:"id"`
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title      string           `db:"title" json:"title"`
+	Url        string           `db:"url" json:"url"`
+	ActivityID pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type PackingItem struct {
+	ID         uuid.UUID   `db:"id" json:"id"`
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Text       string      `db:"text" json:"text"`
+	IsPacked   bool        `db:"is_packed" json:"is_packed"`
+	AssignedTo pgtype.UUID `db:"assigned_to" json:"assigned_to"`
 }
 
 type Participant struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	TripID      uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email       string    `db:"email" json:"email"`
-	IsConfirmed bool      `db:"is_confirmed" json:"is_confirmed"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email           string           `db:"email" json:"email"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+	IsOrganizer     bool             `db:"is_organizer" json:"is_organizer"`
+	GroupName       pgtype.Text      `db:"group_name" json:"group_name"`
+	Name            pgtype.Text      `db:"name" json:"name"`
+	CreatedAt       pgtype.Timestamp `db:"created_at" json:"created_at"`
+	ConfirmedAt     pgtype.Timestamp `db:"confirmed_at" json:"confirmed_at"`
+	DeletedAt       pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+type ParticipantUnavailability struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	StartsAt      pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt        pgtype.Timestamp `db:"ends_at" json:"ends_at"`
 }
 
 type Trip struct {
@@ -38,4 +100,40 @@ type Trip struct {
 	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
 	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
 	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+	Version     int32            `db:"version" json:"version"`
+	PlaceName   pgtype.Text      `db:"place_name" json:"place_name"`
+	Latitude    pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude   pgtype.Float8    `db:"longitude" json:"longitude"`
+	Status      string           `db:"status" json:"status"`
+}
+
+type TripSnapshot struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Data      []byte           `db:"data" json:"data"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID        `db:"id" json:"id"`
+	SubscriptionID uuid.UUID        `db:"subscription_id" json:"subscription_id"`
+	Event          string           `db:"event" json:"event"`
+	Payload        []byte           `db:"payload" json:"payload"`
+	Status         string           `db:"status" json:"status"`
+	Attempts       int32            `db:"attempts" json:"attempts"`
+	LastStatusCode pgtype.Int4      `db:"last_status_code" json:"last_status_code"`
+	LastError      pgtype.Text      `db:"last_error" json:"last_error"`
+	NextAttemptAt  pgtype.Timestamp `db:"next_attempt_at" json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamp `db:"delivered_at" json:"delivered_at"`
+	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Url       string           `db:"url" json:"url"`
+	Events    []string         `db:"events" json:"events"`
+	Secret    string           `db:"secret" json:"secret"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
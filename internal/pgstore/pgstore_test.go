@@ -0,0 +1,173 @@
+package pgstore
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testDatabaseURLEnv names the database the store tests run against. They are
+// skipped when it is unset.
+const testDatabaseURLEnv = "JOURNEY_TEST_DATABASE_URL"
+
+// migrationSeparator splits a migration into its up and down parts.
+const migrationSeparator = "---- create above / drop below ----"
+
+// newTestStore connects to the test database and migrates a schema of its own,
+// dropped when the test ends, so tests never see each other's rows.
+func newTestStore(t *testing.T) (*pgxpool.Pool, *Queries) {
+	t.Helper()
+
+	databaseURL := os.Getenv(testDatabaseURLEnv)
+	if databaseURL == "" {
+		t.Skipf("%s is not set", testDatabaseURLEnv)
+	}
+
+	ctx := context.Background()
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+
+	admin, err := pgx.Connect(ctx, databaseURL)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer func() { _ = admin.Close(ctx) }()
+
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(context.Background(), databaseURL)
+		if err != nil {
+			t.Errorf("failed to connect: %v", err)
+			return
+		}
+		defer func() { _ = conn.Close(context.Background()) }()
+		if _, err := conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("failed to drop schema: %v", err)
+		}
+	})
+
+	cfg, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		t.Fatalf("failed to parse %s: %v", testDatabaseURLEnv, err)
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	migrate(t, pool)
+
+	return pool, New(pool)
+}
+
+// migrate applies the up part of every migration, in order.
+func migrate(t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("migrations", "*.sql"))
+	if err != nil {
+		t.Fatalf("failed to list migrations: %v", err)
+	}
+
+	for _, file := range files {
+		raw, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		up, _, _ := strings.Cut(string(raw), migrationSeparator)
+		if _, err := pool.Exec(context.Background(), up); err != nil {
+			t.Fatalf("failed to apply %s: %v", file, err)
+		}
+	}
+}
+
+// testTime is a timestamp in the future, truncated to what the database keeps.
+func testTime(days int) pgtype.Timestamp {
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, days)
+	return pgtype.Timestamp{Valid: true, Time: day}
+}
+
+func insertTestTrip(t *testing.T, q *Queries, ownerEmail string) uuid.UUID {
+	t.Helper()
+
+	tripID, err := q.InsertTrip(context.Background(), InsertTripParams{
+		Destination: "Florianópolis",
+		OwnerEmail:  ownerEmail,
+		OwnerName:   "Dono",
+		StartsAt:    testTime(10),
+		EndsAt:      testTime(15),
+	})
+	if err != nil {
+		t.Fatalf("failed to insert trip: %v", err)
+	}
+	return tripID
+}
+
+func inviteTestParticipant(t *testing.T, q *Queries, tripID uuid.UUID, email string) uuid.UUID {
+	t.Helper()
+
+	participantID, err := q.InviteParticipant(context.Background(), InviteParticipantToTripParams{
+		TripID:          tripID,
+		Email:           email,
+		InviteExpiresAt: testTime(7),
+	})
+	if err != nil {
+		t.Fatalf("failed to invite %s: %v", email, err)
+	}
+	return participantID
+}
+
+func createTestActivity(t *testing.T, q *Queries, tripID uuid.UUID, title string, day int) uuid.UUID {
+	t.Helper()
+
+	activityID, err := q.CreateActivity(context.Background(), CreateActivityParams{
+		TripID:   tripID,
+		Title:    title,
+		OccursAt: testTime(day),
+	})
+	if err != nil {
+		t.Fatalf("failed to create activity %s: %v", title, err)
+	}
+	return activityID
+}
+
+// participantIDs lists the ids of the live participants of the trip.
+func participantIDs(t *testing.T, q *Queries, tripID uuid.UUID) []uuid.UUID {
+	t.Helper()
+
+	participants, err := q.GetParticipants(context.Background(), tripID)
+	if err != nil {
+		t.Fatalf("failed to get participants: %v", err)
+	}
+	ids := make([]uuid.UUID, len(participants))
+	for i, p := range participants {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func sameIDs(got, want []uuid.UUID) bool {
+	return fmt.Sprint(sortedIDs(got)) == fmt.Sprint(sortedIDs(want))
+}
+
+func sortedIDs(ids []uuid.UUID) []string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = id.String()
+	}
+	sort.Strings(s)
+	return s
+}
@@ -12,9 +12,86 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const activityExists = `-- name: ActivityExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM activities
+    WHERE trip_id = $1 AND lower(btrim(title)) = lower(btrim($2)) AND occurs_at = $3
+)
+`
+
+type ActivityExistsParams struct {
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title    string           `db:"title" json:"title"`
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+}
+
+func (q *Queries) ActivityExists(ctx context.Context, arg ActivityExistsParams) (bool, error) {
+	row := q.db.QueryRow(ctx, activityExists, arg.TripID, arg.Title, arg.OccursAt)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const anonymizeEmailLogRecipient = `-- name: AnonymizeEmailLogRecipient :exec
+UPDATE email_log
+SET recipient = 'deleted-' || id || '@invalid'
+WHERE lower(recipient) = lower($1)
+`
+
+func (q *Queries) AnonymizeEmailLogRecipient(ctx context.Context, recipient string) error {
+	_, err := q.db.Exec(ctx, anonymizeEmailLogRecipient, recipient)
+	return err
+}
+
+const anonymizeParticipantsByEmail = `-- name: AnonymizeParticipantsByEmail :execrows
+UPDATE participants
+SET email = 'deleted-' || id || '@invalid', name = NULL
+WHERE lower(email) = lower($1)
+`
+
+func (q *Queries) AnonymizeParticipantsByEmail(ctx context.Context, email string) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeParticipantsByEmail, email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const archiveTripsEndedBefore = `-- name: ArchiveTripsEndedBefore :execrows
+UPDATE trips
+SET status = 'archived'
+WHERE status = 'active' AND ends_at < $1
+`
+
+func (q *Queries) ArchiveTripsEndedBefore(ctx context.Context, endedBefore pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, archiveTripsEndedBefore, endedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const assignActivityParticipant = `-- name: AssignActivityParticipant :exec
+INSERT INTO activity_participants
+    (activity_id, participant_id) VALUES
+    ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type AssignActivityParticipantParams struct {
+	ActivityID    uuid.UUID `db:"activity_id" json:"activity_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) AssignActivityParticipant(ctx context.Context, arg AssignActivityParticipantParams) error {
+	_, err := q.db.Exec(ctx, assignActivityParticipant, arg.ActivityID, arg.ParticipantID)
+	return err
+}
+
 const confirmParticipant = `-- name: ConfirmParticipant :exec
 UPDATE participants
-SET is_confirmed = true
+SET is_confirmed = true, confirmed_at = now()
 WHERE id = $1
 `
 
@@ -23,95 +100,2371 @@ func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-const confirmTrip = `-- name: ConfirmTrip :exec
-UPDATE trips
-SET is_confirmed = true
-WHERE id = $1
+const confirmTrip = `-- name: ConfirmTrip :exec
+UPDATE trips
+SET is_confirmed = true
+WHERE id = $1
+`
+
+func (q *Queries) ConfirmTrip(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, confirmTrip, id)
+	return err
+}
+
+const confirmTripParticipants = `-- name: ConfirmTripParticipants :execrows
+UPDATE participants
+SET is_confirmed = true, confirmed_at = now()
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT is_confirmed
+`
+
+func (q *Queries) ConfirmTripParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmTripParticipants, tripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countSearchTrips = `-- name: CountSearchTrips :one
+SELECT COUNT(*)
+FROM trips
+WHERE (NOT $1::boolean OR NOT EXISTS (
+        SELECT 1
+        FROM participants
+        WHERE participants.trip_id = trips.id AND participants.deleted_at IS NULL AND NOT participants.is_confirmed
+    ))
+    AND ($2::text IS NULL OR destination ILIKE '%' || $2::text || '%')
+    AND ($3::timestamp IS NULL OR starts_at >= $3::timestamp)
+    AND ($4::timestamp IS NULL OR ends_at <= $4::timestamp)
+    AND ($5::boolean IS NULL OR is_confirmed = $5::boolean)
+    AND (status = 'archived') = $6::boolean
+`
+
+type CountSearchTripsParams struct {
+	AllConfirmed bool             `db:"all_confirmed" json:"all_confirmed"`
+	Destination  pgtype.Text      `db:"destination" json:"destination"`
+	StartsAfter  pgtype.Timestamp `db:"starts_after" json:"starts_after"`
+	EndsBefore   pgtype.Timestamp `db:"ends_before" json:"ends_before"`
+	IsConfirmed  pgtype.Bool      `db:"is_confirmed" json:"is_confirmed"`
+	Archived     bool             `db:"archived" json:"archived"`
+}
+
+func (q *Queries) CountSearchTrips(ctx context.Context, arg CountSearchTripsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countSearchTrips,
+		arg.AllConfirmed,
+		arg.Destination,
+		arg.StartsAfter,
+		arg.EndsBefore,
+		arg.IsConfirmed,
+		arg.Archived,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripActivities = `-- name: CountTripActivities :one
+SELECT COUNT(*)
+FROM activities
+WHERE trip_id = $1
+`
+
+func (q *Queries) CountTripActivities(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripActivities, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripActivitiesByLinks = `-- name: CountTripActivitiesByLinks :one
+SELECT COUNT(*)
+FROM activities
+WHERE trip_id = $1 AND (
+    $2::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM links WHERE links.activity_id = activities.id) = $2::boolean
+)
+`
+
+type CountTripActivitiesByLinksParams struct {
+	TripID   uuid.UUID   `db:"trip_id" json:"trip_id"`
+	HasLinks pgtype.Bool `db:"has_links" json:"has_links"`
+}
+
+func (q *Queries) CountTripActivitiesByLinks(ctx context.Context, arg CountTripActivitiesByLinksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripActivitiesByLinks, arg.TripID, arg.HasLinks)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripLinks = `-- name: CountTripLinks :one
+SELECT COUNT(*)
+FROM links
+WHERE trip_id = $1
+`
+
+func (q *Queries) CountTripLinks(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripLinks, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripParticipants = `-- name: CountTripParticipants :one
+SELECT COUNT(*)
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) CountTripParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripParticipants, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countTripsByParticipantEmail = `-- name: CountTripsByParticipantEmail :one
+SELECT COUNT(*)
+FROM trips
+WHERE EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE participants.trip_id = trips.id AND lower(participants.email) = lower($1) AND participants.deleted_at IS NULL
+)
+    AND (status = 'archived') = $2::boolean
+`
+
+type CountTripsByParticipantEmailParams struct {
+	Email    string `db:"email" json:"email"`
+	Archived bool   `db:"archived" json:"archived"`
+}
+
+func (q *Queries) CountTripsByParticipantEmail(ctx context.Context, arg CountTripsByParticipantEmailParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countTripsByParticipantEmail, arg.Email, arg.Archived)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createActivity = `-- name: CreateActivity :one
+INSERT INTO activities
+    (trip_id, title, occurs_at, remind_before_minutes, duration_minutes) VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type CreateActivityParams struct {
+	TripID              uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title               string           `db:"title" json:"title"`
+	OccursAt            pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	RemindBeforeMinutes pgtype.Int4      `db:"remind_before_minutes" json:"remind_before_minutes"`
+	DurationMinutes     pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+}
+
+func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.RemindBeforeMinutes,
+		arg.DurationMinutes,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createActivityAttachment = `-- name: CreateActivityAttachment :one
+INSERT INTO activity_attachments
+    (activity_id, file_name, content_type, size, content) VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type CreateActivityAttachmentParams struct {
+	ActivityID  uuid.UUID `db:"activity_id" json:"activity_id"`
+	FileName    string    `db:"file_name" json:"file_name"`
+	ContentType string    `db:"content_type" json:"content_type"`
+	Size        int32     `db:"size" json:"size"`
+	Content     []byte    `db:"content" json:"content"`
+}
+
+func (q *Queries) CreateActivityAttachment(ctx context.Context, arg CreateActivityAttachmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivityAttachment,
+		arg.ActivityID,
+		arg.FileName,
+		arg.ContentType,
+		arg.Size,
+		arg.Content,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createActivityLink = `-- name: CreateActivityLink :one
+INSERT INTO links
+    (trip_id, title, url, activity_id) VALUES
+    ($1, $2, $3, $4)
+RETURNING id
+`
+
+type CreateActivityLinkParams struct {
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Title      string      `db:"title" json:"title"`
+	Url        string      `db:"url" json:"url"`
+	ActivityID pgtype.UUID `db:"activity_id" json:"activity_id"`
+}
+
+func (q *Queries) CreateActivityLink(ctx context.Context, arg CreateActivityLinkParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivityLink,
+		arg.TripID,
+		arg.Title,
+		arg.Url,
+		arg.ActivityID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPackingItem = `-- name: CreatePackingItem :one
+INSERT INTO packing_items
+    (trip_id, text, assigned_to) VALUES
+    ($1, $2, $3)
+RETURNING id
+`
+
+type CreatePackingItemParams struct {
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Text       string      `db:"text" json:"text"`
+	AssignedTo pgtype.UUID `db:"assigned_to" json:"assigned_to"`
+}
+
+func (q *Queries) CreatePackingItem(ctx context.Context, arg CreatePackingItemParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createPackingItem, arg.TripID, arg.Text, arg.AssignedTo)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createParticipantUnavailability = `-- name: CreateParticipantUnavailability :one
+INSERT INTO participant_unavailabilities
+    (participant_id, starts_at, ends_at) VALUES
+    ($1, $2, $3)
+RETURNING id
+`
+
+type CreateParticipantUnavailabilityParams struct {
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	StartsAt      pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt        pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) CreateParticipantUnavailability(ctx context.Context, arg CreateParticipantUnavailabilityParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createParticipantUnavailability, arg.ParticipantID, arg.StartsAt, arg.EndsAt)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTripLink = `-- name: CreateTripLink :one
+INSERT INTO links
+    (trip_id, title, url) VALUES
+    ($1, $2, $3)
+RETURNING id
+`
+
+type CreateTripLinkParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Title  string    `db:"title" json:"title"`
+	Url    string    `db:"url" json:"url"`
+}
+
+func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createWebhookSubscription = `-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions
+    (trip_id, url, events, secret) VALUES
+    ($1, $2, $3, $4)
+RETURNING id
+`
+
+type CreateWebhookSubscriptionParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Url    string    `db:"url" json:"url"`
+	Events []string  `db:"events" json:"events"`
+	Secret string    `db:"secret" json:"secret"`
+}
+
+func (q *Queries) CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createWebhookSubscription,
+		arg.TripID,
+		arg.Url,
+		arg.Events,
+		arg.Secret,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteActivity = `-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE id = $1 AND trip_id = $2
+`
+
+type DeleteActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteActivity(ctx context.Context, arg DeleteActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteActivity, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteLink = `-- name: DeleteLink :execrows
+DELETE FROM links
+WHERE id = $1 AND trip_id = $2
+`
+
+type DeleteLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteLink(ctx context.Context, arg DeleteLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLink, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOutboxEmail = `-- name: DeleteOutboxEmail :exec
+DELETE FROM email_outbox
+WHERE id = $1
+`
+
+func (q *Queries) DeleteOutboxEmail(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteOutboxEmail, id)
+	return err
+}
+
+const deleteOutboxEmailsByRecipient = `-- name: DeleteOutboxEmailsByRecipient :exec
+DELETE FROM email_outbox
+WHERE lower(recipient) = lower($1)
+`
+
+func (q *Queries) DeleteOutboxEmailsByRecipient(ctx context.Context, recipient string) error {
+	_, err := q.db.Exec(ctx, deleteOutboxEmailsByRecipient, recipient)
+	return err
+}
+
+const deleteOwnerTrips = `-- name: DeleteOwnerTrips :execrows
+DELETE FROM trips
+WHERE lower(owner_email) = lower($1)
+`
+
+func (q *Queries) DeleteOwnerTrips(ctx context.Context, ownerEmail string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOwnerTrips, ownerEmail)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePackingItem = `-- name: DeletePackingItem :execrows
+DELETE FROM packing_items
+WHERE id = $1 AND trip_id = $2
+`
+
+type DeletePackingItemParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeletePackingItem(ctx context.Context, arg DeletePackingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deletePackingItem, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteParticipant = `-- name: DeleteParticipant :execrows
+UPDATE participants
+SET deleted_at = now()
+WHERE id = $1 AND trip_id = $2 AND deleted_at IS NULL
+`
+
+type DeleteParticipantParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteParticipant(ctx context.Context, arg DeleteParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteParticipant, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripActivities = `-- name: DeleteTripActivities :exec
+DELETE FROM activities
+WHERE trip_id = $1
+`
+
+func (q *Queries) DeleteTripActivities(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripActivities, tripID)
+	return err
+}
+
+const deleteTripActivitiesExcept = `-- name: DeleteTripActivitiesExcept :exec
+DELETE FROM activities
+WHERE trip_id = $1 AND NOT (id = ANY($2::uuid[]))
+`
+
+type DeleteTripActivitiesExceptParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) DeleteTripActivitiesExcept(ctx context.Context, arg DeleteTripActivitiesExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteTripActivitiesExcept, arg.TripID, arg.Ids)
+	return err
+}
+
+const deleteTripActivityParticipants = `-- name: DeleteTripActivityParticipants :exec
+DELETE FROM activity_participants
+USING activities
+WHERE activities.id = activity_participants.activity_id AND activities.trip_id = $1
+`
+
+func (q *Queries) DeleteTripActivityParticipants(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripActivityParticipants, tripID)
+	return err
+}
+
+const deleteTripByID = `-- name: DeleteTripByID :exec
+DELETE FROM trips
+WHERE id = $1
+`
+
+func (q *Queries) DeleteTripByID(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripByID, id)
+	return err
+}
+
+const deleteTripLinks = `-- name: DeleteTripLinks :exec
+DELETE FROM links
+WHERE trip_id = $1
+`
+
+func (q *Queries) DeleteTripLinks(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripLinks, tripID)
+	return err
+}
+
+const deleteTripParticipants = `-- name: DeleteTripParticipants :exec
+DELETE FROM participants
+WHERE trip_id = $1
+`
+
+func (q *Queries) DeleteTripParticipants(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripParticipants, tripID)
+	return err
+}
+
+const deleteTripParticipantsExcept = `-- name: DeleteTripParticipantsExcept :exec
+UPDATE participants
+SET deleted_at = now()
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT (id = ANY($2::uuid[]))
+`
+
+type DeleteTripParticipantsExceptParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) DeleteTripParticipantsExcept(ctx context.Context, arg DeleteTripParticipantsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteTripParticipantsExcept, arg.TripID, arg.Ids)
+	return err
+}
+
+const deleteWebhookSubscription = `-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND trip_id = $2
+`
+
+type DeleteWebhookSubscriptionParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) DeleteWebhookSubscription(ctx context.Context, arg DeleteWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteWebhookSubscription, arg.ID, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const enqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries
+    (subscription_id, event, payload)
+SELECT id, $1, $2
+FROM webhook_subscriptions
+WHERE trip_id = $3 AND $1::text = ANY(events)
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	Event   string    `db:"event" json:"event"`
+	Payload []byte    `db:"payload" json:"payload"`
+	TripID  uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, enqueueWebhookDeliveries, arg.Event, arg.Payload, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const estimateTripsCount = `-- name: EstimateTripsCount :one
+SELECT (CASE
+    WHEN reltuples < 0 THEN -1
+    ELSE reltuples * COALESCE((
+        SELECT CASE WHEN $1::boolean THEN mcv.freq ELSE 1 - mcv.freq END
+        FROM pg_stats, unnest(most_common_vals::text::text[], most_common_freqs) AS mcv(val, freq)
+        WHERE schemaname = current_schema() AND tablename = 'trips' AND attname = 'status' AND mcv.val = 'archived'
+    ), CASE WHEN $1::boolean THEN 0 ELSE 1 END)
+END)::bigint AS reltuples
+FROM pg_class
+WHERE oid = 'trips'::regclass
+`
+
+func (q *Queries) EstimateTripsCount(ctx context.Context, archived bool) (int64, error) {
+	row := q.db.QueryRow(ctx, estimateTripsCount, archived)
+	var reltuples int64
+	err := row.Scan(&reltuples)
+	return reltuples, err
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE id = $1
+`
+
+func (q *Queries) GetActivity(ctx context.Context, id uuid.UUID) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, id)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.RemindBeforeMinutes,
+		&i.ReminderSentAt,
+		&i.DurationMinutes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getActivityAttachment = `-- name: GetActivityAttachment :one
+SELECT
+    activity_attachments.id,
+    activity_attachments.activity_id,
+    activity_attachments.file_name,
+    activity_attachments.content_type,
+    activity_attachments.size,
+    activity_attachments.content,
+    activity_attachments.created_at
+FROM activity_attachments
+JOIN activities ON activities.id = activity_attachments.activity_id
+WHERE activity_attachments.id = $1
+    AND activity_attachments.activity_id = $2
+    AND activities.trip_id = $3
+`
+
+type GetActivityAttachmentParams struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	ActivityID uuid.UUID `db:"activity_id" json:"activity_id"`
+	TripID     uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) GetActivityAttachment(ctx context.Context, arg GetActivityAttachmentParams) (ActivityAttachment, error) {
+	row := q.db.QueryRow(ctx, getActivityAttachment, arg.ID, arg.ActivityID, arg.TripID)
+	var i ActivityAttachment
+	err := row.Scan(
+		&i.ID,
+		&i.ActivityID,
+		&i.FileName,
+		&i.ContentType,
+		&i.Size,
+		&i.Content,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDueActivityReminders = `-- name: GetDueActivityReminders :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE remind_before_minutes IS NOT NULL
+    AND reminder_sent_at IS NULL
+    AND occurs_at > $1
+    AND occurs_at - make_interval(mins => remind_before_minutes) <= $1
+ORDER BY occurs_at, id
+`
+
+func (q *Queries) GetDueActivityReminders(ctx context.Context, now pgtype.Timestamp) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getDueActivityReminders, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBeforeMinutes,
+			&i.ReminderSentAt,
+			&i.DurationMinutes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDueWebhookDeliveries = `-- name: GetDueWebhookDeliveries :many
+SELECT
+    webhook_deliveries.id,
+    webhook_deliveries.event,
+    webhook_deliveries.payload,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret
+FROM webhook_deliveries
+JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id
+WHERE webhook_deliveries.status = 'pending' AND webhook_deliveries.next_attempt_at <= $1
+ORDER BY webhook_deliveries.next_attempt_at, webhook_deliveries.id
+LIMIT $2
+`
+
+type GetDueWebhookDeliveriesParams struct {
+	Now           pgtype.Timestamp `db:"now" json:"now"`
+	MaxDeliveries int32            `db:"max_deliveries" json:"max_deliveries"`
+}
+
+type GetDueWebhookDeliveriesRow struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	Event    string    `db:"event" json:"event"`
+	Payload  []byte    `db:"payload" json:"payload"`
+	Attempts int32     `db:"attempts" json:"attempts"`
+	Url      string    `db:"url" json:"url"`
+	Secret   string    `db:"secret" json:"secret"`
+}
+
+func (q *Queries) GetDueWebhookDeliveries(ctx context.Context, arg GetDueWebhookDeliveriesParams) ([]GetDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, getDueWebhookDeliveries, arg.Now, arg.MaxDeliveries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i GetDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Event,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEagerParticipants = `-- name: GetEagerParticipants :many
+SELECT participants.id, participants.email, participants.name, trips.id AS trip_id, trips.destination
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE participants.is_confirmed AND participants.deleted_at IS NULL AND NOT trips.is_confirmed
+ORDER BY trips.starts_at, participants.email
+`
+
+type GetEagerParticipantsRow struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	Email       string      `db:"email" json:"email"`
+	Name        pgtype.Text `db:"name" json:"name"`
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Destination string      `db:"destination" json:"destination"`
+}
+
+func (q *Queries) GetEagerParticipants(ctx context.Context) ([]GetEagerParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getEagerParticipants)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEagerParticipantsRow
+	for rows.Next() {
+		var i GetEagerParticipantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.TripID,
+			&i.Destination,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLatestTripEmail = `-- name: GetLatestTripEmail :one
+SELECT
+    id, trip_id, participant_id, recipient, kind, status, error, created_at
+FROM email_log
+WHERE trip_id = $1 AND kind = $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetLatestTripEmailParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Kind   string    `db:"kind" json:"kind"`
+}
+
+func (q *Queries) GetLatestTripEmail(ctx context.Context, arg GetLatestTripEmailParams) (EmailLog, error) {
+	row := q.db.QueryRow(ctx, getLatestTripEmail, arg.TripID, arg.Kind)
+	var i EmailLog
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.Recipient,
+		&i.Kind,
+		&i.Status,
+		&i.Error,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getOutboxEmails = `-- name: GetOutboxEmails :many
+SELECT
+    id, recipient, message, created_at
+FROM email_outbox
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetOutboxEmails(ctx context.Context) ([]EmailOutbox, error) {
+	rows, err := q.db.Query(ctx, getOutboxEmails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EmailOutbox
+	for rows.Next() {
+		var i EmailOutbox
+		if err := rows.Scan(
+			&i.ID,
+			&i.Recipient,
+			&i.Message,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerActiveTrips = `-- name: GetOwnerActiveTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE lower(owner_email) = lower($1) AND status <> 'archived'
+ORDER BY starts_at
+`
+
+func (q *Queries) GetOwnerActiveTrips(ctx context.Context, ownerEmail string) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getOwnerActiveTrips, ownerEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.Version,
+			&i.PlaceName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerActivitiesBetween = `-- name: GetOwnerActivitiesBetween :many
+SELECT
+    activities.id,
+    activities.trip_id,
+    activities.title,
+    activities.occurs_at,
+    trips.destination
+FROM activities
+JOIN trips ON trips.id = activities.trip_id
+WHERE lower(trips.owner_email) = lower($1)
+    AND activities.occurs_at >= $2 AND activities.occurs_at < $3
+ORDER BY activities.occurs_at, activities.id
+`
+
+type GetOwnerActivitiesBetweenParams struct {
+	OwnerEmail string           `db:"owner_email" json:"owner_email"`
+	StartsAt   pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt     pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+type GetOwnerActivitiesBetweenRow struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title       string           `db:"title" json:"title"`
+	OccursAt    pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	Destination string           `db:"destination" json:"destination"`
+}
+
+func (q *Queries) GetOwnerActivitiesBetween(ctx context.Context, arg GetOwnerActivitiesBetweenParams) ([]GetOwnerActivitiesBetweenRow, error) {
+	rows, err := q.db.Query(ctx, getOwnerActivitiesBetween, arg.OwnerEmail, arg.StartsAt, arg.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOwnerActivitiesBetweenRow
+	for rows.Next() {
+		var i GetOwnerActivitiesBetweenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Destination,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerConfirmationCounts = `-- name: GetOwnerConfirmationCounts :one
+SELECT
+    count(*) AS invited,
+    count(*) FILTER (WHERE participants.is_confirmed) AS confirmed
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE lower(trips.owner_email) = lower($1) AND participants.deleted_at IS NULL
+`
+
+type GetOwnerConfirmationCountsRow struct {
+	Invited   int64 `db:"invited" json:"invited"`
+	Confirmed int64 `db:"confirmed" json:"confirmed"`
+}
+
+func (q *Queries) GetOwnerConfirmationCounts(ctx context.Context, ownerEmail string) (GetOwnerConfirmationCountsRow, error) {
+	row := q.db.QueryRow(ctx, getOwnerConfirmationCounts, ownerEmail)
+	var i GetOwnerConfirmationCountsRow
+	err := row.Scan(&i.Invited, &i.Confirmed)
+	return i, err
+}
+
+const getOwnerDestinations = `-- name: GetOwnerDestinations :many
+SELECT DISTINCT destination
+FROM trips
+WHERE lower(owner_email) = lower($1) AND status <> 'archived'
+ORDER BY destination
+`
+
+func (q *Queries) GetOwnerDestinations(ctx context.Context, ownerEmail string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getOwnerDestinations, ownerEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var destination string
+		if err := rows.Scan(&destination); err != nil {
+			return nil, err
+		}
+		items = append(items, destination)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerTrips = `-- name: GetOwnerTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE lower(owner_email) = lower($1)
+ORDER BY starts_at
+`
+
+func (q *Queries) GetOwnerTrips(ctx context.Context, ownerEmail string) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getOwnerTrips, ownerEmail)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.Version,
+			&i.PlaceName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.InviteExpiresAt,
+		&i.IsOrganizer,
+		&i.GroupName,
+		&i.Name,
+		&i.CreatedAt,
+		&i.ConfirmedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getParticipantWithTrip = `-- name: GetParticipantWithTrip :one
+SELECT participants.id, participants.trip_id, participants.email, participants.is_confirmed, participants.invite_expires_at, participants.is_organizer, participants.group_name, participants.name, participants.created_at, participants.confirmed_at, participants.deleted_at, trips.id, trips.destination, trips.owner_email, trips.owner_name, trips.is_confirmed, trips.starts_at, trips.ends_at, trips.created_at, trips.version, trips.place_name, trips.latitude, trips.longitude, trips.status
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE participants.id = $1 AND participants.deleted_at IS NULL
+`
+
+type GetParticipantWithTripRow struct {
+	Participant Participant `db:"participant" json:"participant"`
+	Trip        Trip        `db:"trip" json:"trip"`
+}
+
+func (q *Queries) GetParticipantWithTrip(ctx context.Context, id uuid.UUID) (GetParticipantWithTripRow, error) {
+	row := q.db.QueryRow(ctx, getParticipantWithTrip, id)
+	var i GetParticipantWithTripRow
+	err := row.Scan(
+		&i.Participant.ID,
+		&i.Participant.TripID,
+		&i.Participant.Email,
+		&i.Participant.IsConfirmed,
+		&i.Participant.InviteExpiresAt,
+		&i.Participant.IsOrganizer,
+		&i.Participant.GroupName,
+		&i.Participant.Name,
+		&i.Participant.CreatedAt,
+		&i.Participant.ConfirmedAt,
+		&i.Participant.DeletedAt,
+		&i.Trip.ID,
+		&i.Trip.Destination,
+		&i.Trip.OwnerEmail,
+		&i.Trip.OwnerName,
+		&i.Trip.IsConfirmed,
+		&i.Trip.StartsAt,
+		&i.Trip.EndsAt,
+		&i.Trip.CreatedAt,
+		&i.Trip.Version,
+		&i.Trip.PlaceName,
+		&i.Trip.Latitude,
+		&i.Trip.Longitude,
+		&i.Trip.Status,
+	)
+	return i, err
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.InviteExpiresAt,
+			&i.IsOrganizer,
+			&i.GroupName,
+			&i.Name,
+			&i.CreatedAt,
+			&i.ConfirmedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE id = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.IsConfirmed,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.Version,
+		&i.PlaceName,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1
+ORDER BY occurs_at, id
+`
+
+func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBeforeMinutes,
+			&i.ReminderSentAt,
+			&i.DurationMinutes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivitiesBetween = `-- name: GetTripActivitiesBetween :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND occurs_at >= $2 AND occurs_at < $3
+ORDER BY occurs_at, id
+`
+
+type GetTripActivitiesBetweenParams struct {
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt   pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) GetTripActivitiesBetween(ctx context.Context, arg GetTripActivitiesBetweenParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivitiesBetween, arg.TripID, arg.StartsAt, arg.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBeforeMinutes,
+			&i.ReminderSentAt,
+			&i.DurationMinutes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivitiesCreatedSince = `-- name: GetTripActivitiesCreatedSince :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND created_at > $2
+ORDER BY created_at DESC, id
+`
+
+type GetTripActivitiesCreatedSinceParams struct {
+	TripID uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Since  pgtype.Timestamp `db:"since" json:"since"`
+}
+
+func (q *Queries) GetTripActivitiesCreatedSince(ctx context.Context, arg GetTripActivitiesCreatedSinceParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivitiesCreatedSince, arg.TripID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBeforeMinutes,
+			&i.ReminderSentAt,
+			&i.DurationMinutes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivitiesPaginated = `-- name: GetTripActivitiesPaginated :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND (
+    $2::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM links WHERE links.activity_id = activities.id) = $2::boolean
+)
+ORDER BY
+    CASE WHEN $3::text = 'occurs_at' THEN occurs_at END,
+    CASE WHEN $3::text = '-occurs_at' THEN occurs_at END DESC,
+    CASE WHEN $3::text = 'title' THEN title END,
+    CASE WHEN $3::text = '-title' THEN title END DESC,
+    occurs_at, id
+LIMIT $4 OFFSET $5
+`
+
+type GetTripActivitiesPaginatedParams struct {
+	TripID   uuid.UUID   `db:"trip_id" json:"trip_id"`
+	HasLinks pgtype.Bool `db:"has_links" json:"has_links"`
+	Sort     string      `db:"sort" json:"sort"`
+	Limit    int32       `db:"limit" json:"limit"`
+	Offset   int32       `db:"offset" json:"offset"`
+}
+
+func (q *Queries) GetTripActivitiesPaginated(ctx context.Context, arg GetTripActivitiesPaginatedParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivitiesPaginated,
+		arg.TripID,
+		arg.HasLinks,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.RemindBeforeMinutes,
+			&i.ReminderSentAt,
+			&i.DurationMinutes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityAssignments = `-- name: GetTripActivityAssignments :many
+SELECT activity_participants.activity_id, activity_participants.participant_id, activity_participants.created_at
+FROM activity_participants
+JOIN activities ON activities.id = activity_participants.activity_id
+WHERE activities.trip_id = $1
+ORDER BY activity_participants.activity_id, activity_participants.participant_id
+`
+
+func (q *Queries) GetTripActivityAssignments(ctx context.Context, tripID uuid.UUID) ([]ActivityParticipant, error) {
+	rows, err := q.db.Query(ctx, getTripActivityAssignments, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ActivityParticipant
+	for rows.Next() {
+		var i ActivityParticipant
+		if err := rows.Scan(&i.ActivityID, &i.ParticipantID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityParticipants = `-- name: GetTripActivityParticipants :many
+SELECT activity_participants.activity_id, participants.id, participants.email, participants.name
+FROM activity_participants
+JOIN activities ON activities.id = activity_participants.activity_id
+JOIN participants ON participants.id = activity_participants.participant_id AND participants.deleted_at IS NULL
+WHERE activities.trip_id = $1
+ORDER BY participants.email
+`
+
+type GetTripActivityParticipantsRow struct {
+	ActivityID uuid.UUID   `db:"activity_id" json:"activity_id"`
+	ID         uuid.UUID   `db:"id" json:"id"`
+	Email      string      `db:"email" json:"email"`
+	Name       pgtype.Text `db:"name" json:"name"`
+}
+
+func (q *Queries) GetTripActivityParticipants(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivityParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripActivityParticipantsRow
+	for rows.Next() {
+		var i GetTripActivityParticipantsRow
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.ID,
+			&i.Email,
+			&i.Name,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripBusiestDay = `-- name: GetTripBusiestDay :one
+SELECT date_trunc('day', occurs_at)::timestamp AS day, count(*) AS activities
+FROM activities
+WHERE trip_id = $1
+GROUP BY day
+ORDER BY activities DESC, day
+LIMIT 1
+`
+
+type GetTripBusiestDayRow struct {
+	Day        pgtype.Timestamp `db:"day" json:"day"`
+	Activities int64            `db:"activities" json:"activities"`
+}
+
+func (q *Queries) GetTripBusiestDay(ctx context.Context, tripID uuid.UUID) (GetTripBusiestDayRow, error) {
+	row := q.db.QueryRow(ctx, getTripBusiestDay, tripID)
+	var i GetTripBusiestDayRow
+	err := row.Scan(
+		&i.Day,
+		&i.Activities,
+	)
+	return i, err
+}
+
+const getTripConflicts = `-- name: GetTripConflicts :many
+SELECT
+    activities.id AS activity_id,
+    activities.title,
+    activities.occurs_at,
+    participants.id AS participant_id,
+    participants.email
+FROM activities
+JOIN participants ON participants.trip_id = activities.trip_id AND participants.deleted_at IS NULL
+JOIN participant_unavailabilities ON participant_unavailabilities.participant_id = participants.id
+WHERE activities.trip_id = $1
+    AND activities.occurs_at BETWEEN participant_unavailabilities.starts_at AND participant_unavailabilities.ends_at
+ORDER BY activities.occurs_at, activities.id, participants.email
+`
+
+type GetTripConflictsRow struct {
+	ActivityID    uuid.UUID        `db:"activity_id" json:"activity_id"`
+	Title         string           `db:"title" json:"title"`
+	OccursAt      pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Email         string           `db:"email" json:"email"`
+}
+
+func (q *Queries) GetTripConflicts(ctx context.Context, tripID uuid.UUID) ([]GetTripConflictsRow, error) {
+	rows, err := q.db.Query(ctx, getTripConflicts, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripConflictsRow
+	for rows.Next() {
+		var i GetTripConflictsRow
+		if err := rows.Scan(
+			&i.ActivityID,
+			&i.Title,
+			&i.OccursAt,
+			&i.ParticipantID,
+			&i.Email,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripForUpdate = `-- name: GetTripForUpdate :one
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetTripForUpdate(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTripForUpdate, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.IsConfirmed,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.Version,
+		&i.PlaceName,
+		&i.Latitude,
+		&i.Longitude,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT id, trip_id, title, url, activity_id, created_at
+FROM links
+WHERE trip_id = $1
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.ActivityID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLinksCreatedBetween = `-- name: GetTripLinksCreatedBetween :many
+SELECT id, trip_id, title, url, activity_id, created_at
+FROM links
+WHERE trip_id = $1 AND created_at >= $2 AND created_at < $3
+ORDER BY created_at
+`
+
+type GetTripLinksCreatedBetweenParams struct {
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt   pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) GetTripLinksCreatedBetween(ctx context.Context, arg GetTripLinksCreatedBetweenParams) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinksCreatedBetween, arg.TripID, arg.StartsAt, arg.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.ActivityID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripPackingItems = `-- name: GetTripPackingItems :many
+SELECT id, trip_id, text, is_packed, assigned_to
+FROM packing_items
+WHERE trip_id = $1
+ORDER BY text
+`
+
+func (q *Queries) GetTripPackingItems(ctx context.Context, tripID uuid.UUID) ([]PackingItem, error) {
+	rows, err := q.db.Query(ctx, getTripPackingItems, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PackingItem
+	for rows.Next() {
+		var i PackingItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Text,
+			&i.IsPacked,
+			&i.AssignedTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantsNotEmailed = `-- name: GetTripParticipantsNotEmailed :many
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT EXISTS (
+    SELECT 1
+    FROM email_log
+    WHERE email_log.participant_id = participants.id AND email_log.kind = 'invite' AND email_log.status = 'sent'
+)
+ORDER BY email
+`
+
+func (q *Queries) GetTripParticipantsNotEmailed(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getTripParticipantsNotEmailed, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.InviteExpiresAt,
+			&i.IsOrganizer,
+			&i.GroupName,
+			&i.Name,
+			&i.CreatedAt,
+			&i.ConfirmedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantsToInvite = `-- name: GetTripParticipantsToInvite :many
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT is_confirmed AND NOT EXISTS (
+    SELECT 1
+    FROM email_log
+    WHERE email_log.participant_id = participants.id AND email_log.kind = 'invite' AND email_log.status IN ('sent', 'queued')
+)
+ORDER BY email
+`
+
+func (q *Queries) GetTripParticipantsToInvite(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getTripParticipantsToInvite, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.InviteExpiresAt,
+			&i.IsOrganizer,
+			&i.GroupName,
+			&i.Name,
+			&i.CreatedAt,
+			&i.ConfirmedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSnapshot = `-- name: GetTripSnapshot :one
+SELECT id, trip_id, data, created_at
+FROM trip_snapshots
+WHERE id = $1
+`
+
+func (q *Queries) GetTripSnapshot(ctx context.Context, id uuid.UUID) (TripSnapshot, error) {
+	row := q.db.QueryRow(ctx, getTripSnapshot, id)
+	var i TripSnapshot
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Data,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTripSnapshots = `-- name: GetTripSnapshots :many
+SELECT id, trip_id, created_at
+FROM trip_snapshots
+WHERE trip_id = $1
+ORDER BY created_at DESC
+`
+
+type GetTripSnapshotsRow struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) GetTripSnapshots(ctx context.Context, tripID uuid.UUID) ([]GetTripSnapshotsRow, error) {
+	rows, err := q.db.Query(ctx, getTripSnapshots, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripSnapshotsRow
+	for rows.Next() {
+		var i GetTripSnapshotsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripWebhookSubscriptions = `-- name: GetTripWebhookSubscriptions :many
+SELECT id, trip_id, url, events, secret, created_at
+FROM webhook_subscriptions
+WHERE trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripWebhookSubscriptions(ctx context.Context, tripID uuid.UUID) ([]WebhookSubscription, error) {
+	rows, err := q.db.Query(ctx, getTripWebhookSubscriptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookSubscription
+	for rows.Next() {
+		var i WebhookSubscription
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Url,
+			&i.Events,
+			&i.Secret,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsByParticipantEmail = `-- name: GetTripsByParticipantEmail :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE participants.trip_id = trips.id AND lower(participants.email) = lower($1) AND participants.deleted_at IS NULL
+)
+    AND (status = 'archived') = $2::boolean
+ORDER BY
+    CASE WHEN $3::text = 'starts_at' THEN starts_at END,
+    CASE WHEN $3::text = '-starts_at' THEN starts_at END DESC,
+    CASE WHEN $3::text = 'created_at' THEN created_at END,
+    CASE WHEN $3::text = '-created_at' THEN created_at END DESC,
+    CASE WHEN $3::text = 'destination' THEN destination END,
+    CASE WHEN $3::text = '-destination' THEN destination END DESC,
+    starts_at, id
+LIMIT $4 OFFSET $5
+`
+
+type GetTripsByParticipantEmailParams struct {
+	Email    string `db:"email" json:"email"`
+	Archived bool   `db:"archived" json:"archived"`
+	Sort     string `db:"sort" json:"sort"`
+	Limit    int32  `db:"limit" json:"limit"`
+	Offset   int32  `db:"offset" json:"offset"`
+}
+
+func (q *Queries) GetTripsByParticipantEmail(ctx context.Context, arg GetTripsByParticipantEmailParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, getTripsByParticipantEmail,
+		arg.Email,
+		arg.Archived,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Trip
+	for rows.Next() {
+		var i Trip
+		if err := rows.Scan(
+			&i.ID,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
+			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.Version,
+			&i.PlaceName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsCreatedPerDay = `-- name: GetTripsCreatedPerDay :many
+SELECT date_trunc('day', created_at)::timestamp AS day, count(*) AS trips
+FROM trips
+WHERE created_at >= $1 AND created_at < $2
+GROUP BY day
+ORDER BY day
+`
+
+type GetTripsCreatedPerDayParams struct {
+	CreatedFrom pgtype.Timestamp `db:"created_from" json:"created_from"`
+	CreatedTo   pgtype.Timestamp `db:"created_to" json:"created_to"`
+}
+
+type GetTripsCreatedPerDayRow struct {
+	Day   pgtype.Timestamp `db:"day" json:"day"`
+	Trips int64            `db:"trips" json:"trips"`
+}
+
+func (q *Queries) GetTripsCreatedPerDay(ctx context.Context, arg GetTripsCreatedPerDayParams) ([]GetTripsCreatedPerDayRow, error) {
+	rows, err := q.db.Query(ctx, getTripsCreatedPerDay, arg.CreatedFrom, arg.CreatedTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripsCreatedPerDayRow
+	for rows.Next() {
+		var i GetTripsCreatedPerDayRow
+		if err := rows.Scan(&i.Day, &i.Trips); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT
+    webhook_deliveries.id,
+    webhook_deliveries.event,
+    webhook_deliveries.payload,
+    webhook_deliveries.status,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret
+FROM webhook_deliveries
+JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id
+WHERE webhook_deliveries.id = $1
+`
+
+type GetWebhookDeliveryRow struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	Event    string    `db:"event" json:"event"`
+	Payload  []byte    `db:"payload" json:"payload"`
+	Status   string    `db:"status" json:"status"`
+	Attempts int32     `db:"attempts" json:"attempts"`
+	Url      string    `db:"url" json:"url"`
+	Secret   string    `db:"secret" json:"secret"`
+}
+
+func (q *Queries) GetWebhookDelivery(ctx context.Context, id uuid.UUID) (GetWebhookDeliveryRow, error) {
+	row := q.db.QueryRow(ctx, getWebhookDelivery, id)
+	var i GetWebhookDeliveryRow
+	err := row.Scan(
+		&i.ID,
+		&i.Event,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.Url,
+		&i.Secret,
+	)
+	return i, err
+}
+
+const insertEmailLog = `-- name: InsertEmailLog :exec
+INSERT INTO email_log
+    (trip_id, participant_id, recipient, kind, status, error) VALUES
+    ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertEmailLogParams struct {
+	TripID        uuid.UUID   `db:"trip_id" json:"trip_id"`
+	ParticipantID pgtype.UUID `db:"participant_id" json:"participant_id"`
+	Recipient     string      `db:"recipient" json:"recipient"`
+	Kind          string      `db:"kind" json:"kind"`
+	Status        string      `db:"status" json:"status"`
+	Error         pgtype.Text `db:"error" json:"error"`
+}
+
+func (q *Queries) InsertEmailLog(ctx context.Context, arg InsertEmailLogParams) error {
+	_, err := q.db.Exec(ctx, insertEmailLog,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.Recipient,
+		arg.Kind,
+		arg.Status,
+		arg.Error,
+	)
+	return err
+}
+
+const insertOutboxEmail = `-- name: InsertOutboxEmail :exec
+INSERT INTO email_outbox
+    (recipient, message) VALUES
+    ($1, $2)
+`
+
+type InsertOutboxEmailParams struct {
+	Recipient string `db:"recipient" json:"recipient"`
+	Message   []byte `db:"message" json:"message"`
+}
+
+func (q *Queries) InsertOutboxEmail(ctx context.Context, arg InsertOutboxEmailParams) error {
+	_, err := q.db.Exec(ctx, insertOutboxEmail, arg.Recipient, arg.Message)
+	return err
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT INTO trips
+    (destination, owner_email, owner_name, starts_at, ends_at) VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id
+`
+
+type InsertTripParams struct {
+	Destination string           `db:"destination" json:"destination"`
+	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
+	OwnerName   string           `db:"owner_name" json:"owner_name"`
+	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTripSnapshot = `-- name: InsertTripSnapshot :one
+INSERT INTO trip_snapshots
+    (trip_id, data) VALUES
+    ($1, $2)
+RETURNING id
+`
+
+type InsertTripSnapshotParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Data   []byte    `db:"data" json:"data"`
+}
+
+func (q *Queries) InsertTripSnapshot(ctx context.Context, arg InsertTripSnapshotParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTripSnapshot, arg.TripID, arg.Data)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
+INSERT INTO participants
+    (trip_id, email, invite_expires_at, name) VALUES
+    ($1, $2, $3, $4)
+RETURNING id
+`
+
+type InviteParticipantToTripParams struct {
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email           string           `db:"email" json:"email"`
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+	Name            pgtype.Text      `db:"name" json:"name"`
+}
+
+func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, inviteParticipantToTrip,
+		arg.TripID,
+		arg.Email,
+		arg.InviteExpiresAt,
+		arg.Name,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InviteParticipantsToTripParams struct {
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email           string           `db:"email" json:"email"`
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+}
+
+const isOrganizer = `-- name: IsOrganizer :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE trip_id = $1 AND lower(email) = lower($2) AND is_organizer AND deleted_at IS NULL
+)
+`
+
+type IsOrganizerParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) IsOrganizer(ctx context.Context, arg IsOrganizerParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isOrganizer, arg.TripID, arg.Email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const isOwner = `-- name: IsOwner :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trips
+    WHERE id = $1 AND lower(owner_email) = lower($2)
+)
+`
+
+type IsOwnerParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Email  string    `db:"email" json:"email"`
+}
+
+func (q *Queries) IsOwner(ctx context.Context, arg IsOwnerParams) (bool, error) {
+	row := q.db.QueryRow(ctx, isOwner, arg.TripID, arg.Email)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const markActivityReminderSent = `-- name: MarkActivityReminderSent :exec
+UPDATE activities
+SET reminder_sent_at = $1
+WHERE id = $2
+`
+
+type MarkActivityReminderSentParams struct {
+	ReminderSentAt pgtype.Timestamp `db:"reminder_sent_at" json:"reminder_sent_at"`
+	ID             uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkActivityReminderSent(ctx context.Context, arg MarkActivityReminderSentParams) error {
+	_, err := q.db.Exec(ctx, markActivityReminderSent, arg.ReminderSentAt, arg.ID)
+	return err
+}
+
+const markWebhookDeliveryDelivered = `-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET
+    "status" = 'delivered',
+    "attempts" = attempts + 1,
+    "last_status_code" = $1,
+    "last_error" = NULL,
+    "delivered_at" = $2
+WHERE id = $3
+`
+
+type MarkWebhookDeliveryDeliveredParams struct {
+	LastStatusCode pgtype.Int4      `db:"last_status_code" json:"last_status_code"`
+	DeliveredAt    pgtype.Timestamp `db:"delivered_at" json:"delivered_at"`
+	ID             uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkWebhookDeliveryDelivered(ctx context.Context, arg MarkWebhookDeliveryDeliveredParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryDelivered, arg.LastStatusCode, arg.DeliveredAt, arg.ID)
+	return err
+}
+
+const markWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    "status" = $1,
+    "attempts" = attempts + 1,
+    "last_status_code" = $2,
+    "last_error" = $3,
+    "next_attempt_at" = $4
+WHERE id = $5
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	Status         string           `db:"status" json:"status"`
+	LastStatusCode pgtype.Int4      `db:"last_status_code" json:"last_status_code"`
+	LastError      pgtype.Text      `db:"last_error" json:"last_error"`
+	NextAttemptAt  pgtype.Timestamp `db:"next_attempt_at" json:"next_attempt_at"`
+	ID             uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, markWebhookDeliveryFailed,
+		arg.Status,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const renewParticipantInvite = `-- name: RenewParticipantInvite :exec
+UPDATE participants
+SET invite_expires_at = $1
+WHERE id = $2
+`
+
+type RenewParticipantInviteParams struct {
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) RenewParticipantInvite(ctx context.Context, arg RenewParticipantInviteParams) error {
+	_, err := q.db.Exec(ctx, renewParticipantInvite, arg.InviteExpiresAt, arg.ID)
+	return err
+}
+
+type RestoreActivityParticipantsParams struct {
+	ActivityID    uuid.UUID        `db:"activity_id" json:"activity_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type RestoreLinksParams struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title      string           `db:"title" json:"title"`
+	Url        string           `db:"url" json:"url"`
+	ActivityID pgtype.UUID      `db:"activity_id" json:"activity_id"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+const resetParticipantConfirmations = `-- name: ResetParticipantConfirmations :exec
+UPDATE participants
+SET is_confirmed = false, confirmed_at = NULL, invite_expires_at = $2
+WHERE trip_id = $1 AND deleted_at IS NULL
 `
 
-func (q *Queries) ConfirmTrip(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmTrip, id)
+type ResetParticipantConfirmationsParams struct {
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+}
+
+func (q *Queries) ResetParticipantConfirmations(ctx context.Context, arg ResetParticipantConfirmationsParams) error {
+	_, err := q.db.Exec(ctx, resetParticipantConfirmations, arg.TripID, arg.InviteExpiresAt)
 	return err
 }
 
-const createActivity = `-- name: CreateActivity :one
+const restoreActivity = `-- name: RestoreActivity :exec
 INSERT INTO activities
-    (trip_id, title, occurs_at) VALUES
-    ($1, $2, $3)
-RETURNING id
+    (id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at) VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE
+SET
+    "title" = EXCLUDED.title,
+    "occurs_at" = EXCLUDED.occurs_at,
+    "remind_before_minutes" = EXCLUDED.remind_before_minutes,
+    "reminder_sent_at" = EXCLUDED.reminder_sent_at,
+    "duration_minutes" = EXCLUDED.duration_minutes,
+    "created_at" = EXCLUDED.created_at
+WHERE activities.trip_id = EXCLUDED.trip_id
 `
 
-type CreateActivityParams struct {
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+type RestoreActivityParams struct {
+	ID                  uuid.UUID        `db:"id" json:"id"`
+	TripID              uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title               string           `db:"title" json:"title"`
+	OccursAt            pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	RemindBeforeMinutes pgtype.Int4      `db:"remind_before_minutes" json:"remind_before_minutes"`
+	ReminderSentAt      pgtype.Timestamp `db:"reminder_sent_at" json:"reminder_sent_at"`
+	DurationMinutes     pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+	CreatedAt           pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
 
-func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) RestoreActivity(ctx context.Context, arg RestoreActivityParams) error {
+	_, err := q.db.Exec(ctx, restoreActivity,
+		arg.ID,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.RemindBeforeMinutes,
+		arg.ReminderSentAt,
+		arg.DurationMinutes,
+		arg.CreatedAt,
+	)
+	return err
 }
 
-const createTripLink = `-- name: CreateTripLink :one
-INSERT INTO links
-    (trip_id, title, url) VALUES
-    ($1, $2, $3)
-RETURNING id
+const restoreParticipant = `-- name: RestoreParticipant :execrows
+UPDATE participants
+SET deleted_at = NULL
+WHERE id = $1 AND trip_id = $2 AND deleted_at >= $3
 `
 
-type CreateTripLinkParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+type RestoreParticipantParams struct {
+	ID           uuid.UUID        `db:"id" json:"id"`
+	TripID       uuid.UUID        `db:"trip_id" json:"trip_id"`
+	DeletedAfter pgtype.Timestamp `db:"deleted_after" json:"deleted_after"`
 }
 
-func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) RestoreParticipant(ctx context.Context, arg RestoreParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, restoreParticipant, arg.ID, arg.TripID, arg.DeletedAfter)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT id, trip_id, email, is_confirmed
-FROM participants
-WHERE id = $1
+const restoreTripParticipant = `-- name: RestoreTripParticipant :exec
+INSERT INTO participants
+    (id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at) VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (id) DO UPDATE
+SET
+    "email" = EXCLUDED.email,
+    "is_confirmed" = EXCLUDED.is_confirmed,
+    "invite_expires_at" = EXCLUDED.invite_expires_at,
+    "is_organizer" = EXCLUDED.is_organizer,
+    "group_name" = EXCLUDED.group_name,
+    "name" = EXCLUDED.name,
+    "created_at" = EXCLUDED.created_at,
+    "confirmed_at" = EXCLUDED.confirmed_at,
+    "deleted_at" = NULL
+WHERE participants.trip_id = EXCLUDED.trip_id
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
+type RestoreTripParticipantParams struct {
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email           string           `db:"email" json:"email"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	InviteExpiresAt pgtype.Timestamp `db:"invite_expires_at" json:"invite_expires_at"`
+	IsOrganizer     bool             `db:"is_organizer" json:"is_organizer"`
+	GroupName       pgtype.Text      `db:"group_name" json:"group_name"`
+	Name            pgtype.Text      `db:"name" json:"name"`
+	CreatedAt       pgtype.Timestamp `db:"created_at" json:"created_at"`
+	ConfirmedAt     pgtype.Timestamp `db:"confirmed_at" json:"confirmed_at"`
+}
+
+func (q *Queries) RestoreTripParticipant(ctx context.Context, arg RestoreTripParticipantParams) error {
+	_, err := q.db.Exec(ctx, restoreTripParticipant,
+		arg.ID,
+		arg.TripID,
+		arg.Email,
+		arg.IsConfirmed,
+		arg.InviteExpiresAt,
+		arg.IsOrganizer,
+		arg.GroupName,
+		arg.Name,
+		arg.CreatedAt,
+		arg.ConfirmedAt,
 	)
-	return i, err
+	return err
 }
 
-const getParticipants = `-- name: GetParticipants :many
-SELECT id, trip_id, email, is_confirmed
-FROM participants
-WHERE trip_id = $1
+const searchTrips = `-- name: SearchTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE (NOT $1::boolean OR NOT EXISTS (
+        SELECT 1
+        FROM participants
+        WHERE participants.trip_id = trips.id AND participants.deleted_at IS NULL AND NOT participants.is_confirmed
+    ))
+    AND ($2::text IS NULL OR destination ILIKE '%' || $2::text || '%')
+    AND ($3::timestamp IS NULL OR starts_at >= $3::timestamp)
+    AND ($4::timestamp IS NULL OR ends_at <= $4::timestamp)
+    AND ($5::boolean IS NULL OR is_confirmed = $5::boolean)
+    AND (status = 'archived') = $6::boolean
+ORDER BY
+    CASE WHEN $7::text = 'starts_at' THEN starts_at END,
+    CASE WHEN $7::text = '-starts_at' THEN starts_at END DESC,
+    CASE WHEN $7::text = 'created_at' THEN created_at END,
+    CASE WHEN $7::text = '-created_at' THEN created_at END DESC,
+    CASE WHEN $7::text = 'destination' THEN destination END,
+    CASE WHEN $7::text = '-destination' THEN destination END DESC,
+    starts_at, id
+LIMIT $8 OFFSET $9
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+type SearchTripsParams struct {
+	AllConfirmed bool             `db:"all_confirmed" json:"all_confirmed"`
+	Destination  pgtype.Text      `db:"destination" json:"destination"`
+	StartsAfter  pgtype.Timestamp `db:"starts_after" json:"starts_after"`
+	EndsBefore   pgtype.Timestamp `db:"ends_before" json:"ends_before"`
+	IsConfirmed  pgtype.Bool      `db:"is_confirmed" json:"is_confirmed"`
+	Archived     bool             `db:"archived" json:"archived"`
+	Sort         string           `db:"sort" json:"sort"`
+	Limit        int32            `db:"limit" json:"limit"`
+	Offset       int32            `db:"offset" json:"offset"`
+}
+
+func (q *Queries) SearchTrips(ctx context.Context, arg SearchTripsParams) ([]Trip, error) {
+	rows, err := q.db.Query(ctx, searchTrips,
+		arg.AllConfirmed,
+		arg.Destination,
+		arg.StartsAfter,
+		arg.EndsBefore,
+		arg.IsConfirmed,
+		arg.Archived,
+		arg.Sort,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Participant
+	var items []Trip
 	for rows.Next() {
-		var i Participant
+		var i Trip
 		if err := rows.Scan(
 			&i.ID,
-			&i.TripID,
-			&i.Email,
+			&i.Destination,
+			&i.OwnerEmail,
+			&i.OwnerName,
 			&i.IsConfirmed,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.Version,
+			&i.PlaceName,
+			&i.Latitude,
+			&i.Longitude,
+			&i.Status,
 		); err != nil {
 			return nil, err
 		}
@@ -123,139 +2476,163 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 	return items, nil
 }
 
-const getTrip = `-- name: GetTrip :one
-SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at
-FROM trips
-WHERE id = $1
+const setParticipantGroup = `-- name: SetParticipantGroup :exec
+UPDATE participants
+SET group_name = $1
+WHERE id = $2
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
-	)
-	return i, err
+type SetParticipantGroupParams struct {
+	GroupName pgtype.Text `db:"group_name" json:"group_name"`
+	ID        uuid.UUID   `db:"id" json:"id"`
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT id, trip_id, title, occurs_at
-FROM activities
-WHERE trip_id = $1
+func (q *Queries) SetParticipantGroup(ctx context.Context, arg SetParticipantGroupParams) error {
+	_, err := q.db.Exec(ctx, setParticipantGroup, arg.GroupName, arg.ID)
+	return err
+}
+
+const setParticipantOrganizer = `-- name: SetParticipantOrganizer :exec
+UPDATE participants
+SET is_organizer = $1
+WHERE id = $2
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+type SetParticipantOrganizerParams struct {
+	IsOrganizer bool      `db:"is_organizer" json:"is_organizer"`
+	ID          uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) SetParticipantOrganizer(ctx context.Context, arg SetParticipantOrganizerParams) error {
+	_, err := q.db.Exec(ctx, setParticipantOrganizer, arg.IsOrganizer, arg.ID)
+	return err
+}
+
+const togglePackingItem = `-- name: TogglePackingItem :execrows
+UPDATE packing_items
+SET is_packed = NOT is_packed
+WHERE id = $1 AND trip_id = $2
+`
+
+type TogglePackingItemParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) TogglePackingItem(ctx context.Context, arg TogglePackingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, togglePackingItem, arg.ID, arg.TripID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Activity
-	for rows.Next() {
-		var i Activity
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT id, trip_id, title, url
-FROM links
-WHERE trip_id = $1
+const unassignActivityParticipant = `-- name: UnassignActivityParticipant :execrows
+DELETE FROM activity_participants
+USING activities
+WHERE activities.id = activity_participants.activity_id
+    AND activity_participants.activity_id = $1
+    AND activity_participants.participant_id = $2
+    AND activities.trip_id = $3
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+type UnassignActivityParticipantParams struct {
+	ActivityID    uuid.UUID `db:"activity_id" json:"activity_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	TripID        uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UnassignActivityParticipant(ctx context.Context, arg UnassignActivityParticipantParams) (int64, error) {
+	result, err := q.db.Exec(ctx, unassignActivityParticipant, arg.ActivityID, arg.ParticipantID, arg.TripID)
 	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []Link
-	for rows.Next() {
-		var i Link
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.Url,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
+		return 0, err
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+	return result.RowsAffected(), nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT INTO trips
-    (destination, owner_email, owner_name, starts_at, ends_at) VALUES
-    ($1, $2, $3, $4, $5)
-RETURNING id
+const updateActivity = `-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = $1,
+    "occurs_at" = $2,
+    "reminder_sent_at" = CASE WHEN occurs_at = $2 THEN reminder_sent_at END
+WHERE id = $3 AND trip_id = $4
 `
 
-type InsertTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+type UpdateActivityParams struct {
+	Title    string           `db:"title" json:"title"`
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID        `db:"id" json:"id"`
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
+func (q *Queries) UpdateActivity(ctx context.Context, arg UpdateActivityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateActivity,
+		arg.Title,
+		arg.OccursAt,
+		arg.ID,
+		arg.TripID,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const inviteParticipantToTrip = `-- name: InviteParticipantToTrip :one
-INSERT INTO participants
-    (trip_id, email) VALUES
-    ($1, $2)
-RETURNING id
+const updateLink = `-- name: UpdateLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2
+WHERE id = $3 AND trip_id = $4
 `
 
-type InviteParticipantToTripParams struct {
+type UpdateLinkParams struct {
+	Title  string    `db:"title" json:"title"`
+	Url    string    `db:"url" json:"url"`
+	ID     uuid.UUID `db:"id" json:"id"`
 	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
 }
 
-func (q *Queries) InviteParticipantToTrip(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, inviteParticipantToTrip, arg.TripID, arg.Email)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) UpdateLink(ctx context.Context, arg UpdateLinkParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateLink,
+		arg.Title,
+		arg.Url,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+const updatePackingItem = `-- name: UpdatePackingItem :execrows
+UPDATE packing_items
+SET
+    "text" = $1,
+    "assigned_to" = $2
+WHERE id = $3 AND trip_id = $4
+`
+
+type UpdatePackingItemParams struct {
+	Text       string      `db:"text" json:"text"`
+	AssignedTo pgtype.UUID `db:"assigned_to" json:"assigned_to"`
+	ID         uuid.UUID   `db:"id" json:"id"`
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdatePackingItem(ctx context.Context, arg UpdatePackingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updatePackingItem,
+		arg.Text,
+		arg.AssignedTo,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const updateTrip = `-- name: UpdateTrip :exec
@@ -264,7 +2641,8 @@ SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "version" = version + 1
 WHERE id = $5
 `
 
@@ -286,3 +2664,89 @@ func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
 	)
 	return err
 }
+
+const updateTripPlace = `-- name: UpdateTripPlace :exec
+UPDATE trips
+SET place_name = $2, latitude = $3, longitude = $4
+WHERE id = $1
+`
+
+type UpdateTripPlaceParams struct {
+	ID        uuid.UUID     `db:"id" json:"id"`
+	PlaceName pgtype.Text   `db:"place_name" json:"place_name"`
+	Latitude  pgtype.Float8 `db:"latitude" json:"latitude"`
+	Longitude pgtype.Float8 `db:"longitude" json:"longitude"`
+}
+
+func (q *Queries) UpdateTripPlace(ctx context.Context, arg UpdateTripPlaceParams) error {
+	_, err := q.db.Exec(ctx, updateTripPlace,
+		arg.ID,
+		arg.PlaceName,
+		arg.Latitude,
+		arg.Longitude,
+	)
+	return err
+}
+
+const updateTripVersioned = `-- name: UpdateTripVersioned :execrows
+UPDATE trips
+SET
+    "destination" = $1,
+    "ends_at" = $2,
+    "starts_at" = $3,
+    "is_confirmed" = $4,
+    "version" = version + 1
+WHERE id = $5 AND version = $6
+`
+
+type UpdateTripVersionedParams struct {
+	Destination     string           `db:"destination" json:"destination"`
+	EndsAt          pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	StartsAt        pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	IsConfirmed     bool             `db:"is_confirmed" json:"is_confirmed"`
+	ID              uuid.UUID        `db:"id" json:"id"`
+	ExpectedVersion int32            `db:"expected_version" json:"expected_version"`
+}
+
+func (q *Queries) UpdateTripVersioned(ctx context.Context, arg UpdateTripVersionedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateTripVersioned,
+		arg.Destination,
+		arg.EndsAt,
+		arg.StartsAt,
+		arg.IsConfirmed,
+		arg.ID,
+		arg.ExpectedVersion,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateWebhookSubscription = `-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET
+    "url" = $1,
+    "events" = $2
+WHERE id = $3 AND trip_id = $4
+`
+
+type UpdateWebhookSubscriptionParams struct {
+	Url    string    `db:"url" json:"url"`
+	Events []string  `db:"events" json:"events"`
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) UpdateWebhookSubscription(ctx context.Context, arg UpdateWebhookSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateWebhookSubscription,
+		arg.Url,
+		arg.Events,
+		arg.ID,
+		arg.TripID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
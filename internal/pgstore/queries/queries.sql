@@ -5,17 +5,24 @@ INSERT INTO trips
 RETURNING id;
 
 -- name: GetTrip :one
-SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
 FROM trips
 WHERE id = $1;
 
+-- name: GetTripForUpdate :one
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE id = $1
+FOR UPDATE;
+
 -- name: UpdateTrip :exec
 UPDATE trips
 SET
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "is_confirmed" = $4,
+    "version" = version + 1
 WHERE id = $5;
 
 -- name: ConfirmTrip :exec
@@ -24,41 +31,66 @@ SET is_confirmed = true
 WHERE id = $1;
 
 -- name: GetParticipant :one
-SELECT id, trip_id, email, is_confirmed
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
 FROM participants
-WHERE id = $1;
+WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: ConfirmParticipant :exec
 UPDATE participants
-SET is_confirmed = true
+SET is_confirmed = true, confirmed_at = now()
 WHERE id = $1;
 
 -- name: GetParticipants :many
-SELECT id, trip_id, email, is_confirmed
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
 FROM participants
-WHERE trip_id = $1;
+WHERE trip_id = $1 AND deleted_at IS NULL;
+
+-- name: RenewParticipantInvite :exec
+UPDATE participants
+SET invite_expires_at = $1
+WHERE id = $2;
 
 -- name: InviteParticipantToTrip :one
 INSERT INTO participants
-    (trip_id, email) VALUES
-    ($1, $2)
+    (trip_id, email, invite_expires_at, name) VALUES
+    ($1, $2, $3, $4)
 RETURNING id;
 
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    (trip_id, email) VALUES
-    ($1, $2);
+    (trip_id, email, invite_expires_at) VALUES
+    ($1, $2, $3);
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    (trip_id, title, occurs_at) VALUES
-    ($1, $2, $3)
+    (trip_id, title, occurs_at, remind_before_minutes, duration_minutes) VALUES
+    ($1, $2, $3, $4, $5)
 RETURNING id;
 
+-- name: GetActivity :one
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE id = $1;
+
 -- name: GetTripActivities :many
-SELECT id, trip_id, title, occurs_at
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
 FROM activities
-WHERE trip_id = $1;
+WHERE trip_id = $1
+ORDER BY occurs_at, id;
+
+-- name: GetDueActivityReminders :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE remind_before_minutes IS NOT NULL
+    AND reminder_sent_at IS NULL
+    AND occurs_at > sqlc.arg(now)
+    AND occurs_at - make_interval(mins => remind_before_minutes) <= sqlc.arg(now)
+ORDER BY occurs_at, id;
+
+-- name: MarkActivityReminderSent :exec
+UPDATE activities
+SET reminder_sent_at = $1
+WHERE id = $2;
 
 -- name: CreateTripLink :one
 INSERT INTO links
@@ -66,7 +98,637 @@ INSERT INTO links
     ($1, $2, $3)
 RETURNING id;
 
+-- name: CreateActivityLink :one
+INSERT INTO links
+    (trip_id, title, url, activity_id) VALUES
+    ($1, $2, $3, $4)
+RETURNING id;
+
 -- name: GetTripLinks :many
-SELECT id, trip_id, title, url
+SELECT id, trip_id, title, url, activity_id, created_at
 FROM links
-WHERE trip_id = $1;
\ No newline at end of file
+WHERE trip_id = $1;
+
+-- name: InsertTripSnapshot :one
+INSERT INTO trip_snapshots
+    (trip_id, data) VALUES
+    ($1, $2)
+RETURNING id;
+
+-- name: GetTripSnapshot :one
+SELECT id, trip_id, data, created_at
+FROM trip_snapshots
+WHERE id = $1;
+
+-- name: GetTripSnapshots :many
+SELECT id, trip_id, created_at
+FROM trip_snapshots
+WHERE trip_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteTripParticipants :exec
+DELETE FROM participants
+WHERE trip_id = $1;
+
+-- name: DeleteTripActivities :exec
+DELETE FROM activities
+WHERE trip_id = $1;
+
+-- name: DeleteTripLinks :exec
+DELETE FROM links
+WHERE trip_id = $1;
+
+-- name: DeleteTripParticipantsExcept :exec
+UPDATE participants
+SET deleted_at = now()
+WHERE trip_id = sqlc.arg(trip_id) AND deleted_at IS NULL AND NOT (id = ANY(sqlc.arg(ids)::uuid[]));
+
+-- name: RestoreTripParticipant :exec
+INSERT INTO participants
+    (id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at) VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (id) DO UPDATE
+SET
+    "email" = EXCLUDED.email,
+    "is_confirmed" = EXCLUDED.is_confirmed,
+    "invite_expires_at" = EXCLUDED.invite_expires_at,
+    "is_organizer" = EXCLUDED.is_organizer,
+    "group_name" = EXCLUDED.group_name,
+    "name" = EXCLUDED.name,
+    "created_at" = EXCLUDED.created_at,
+    "confirmed_at" = EXCLUDED.confirmed_at,
+    "deleted_at" = NULL
+WHERE participants.trip_id = EXCLUDED.trip_id;
+
+-- name: DeleteTripActivitiesExcept :exec
+DELETE FROM activities
+WHERE trip_id = sqlc.arg(trip_id) AND NOT (id = ANY(sqlc.arg(ids)::uuid[]));
+
+-- name: RestoreActivity :exec
+INSERT INTO activities
+    (id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at) VALUES
+    ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id) DO UPDATE
+SET
+    "title" = EXCLUDED.title,
+    "occurs_at" = EXCLUDED.occurs_at,
+    "remind_before_minutes" = EXCLUDED.remind_before_minutes,
+    "reminder_sent_at" = EXCLUDED.reminder_sent_at,
+    "duration_minutes" = EXCLUDED.duration_minutes,
+    "created_at" = EXCLUDED.created_at
+WHERE activities.trip_id = EXCLUDED.trip_id;
+
+-- name: GetTripActivityAssignments :many
+SELECT activity_participants.activity_id, activity_participants.participant_id, activity_participants.created_at
+FROM activity_participants
+JOIN activities ON activities.id = activity_participants.activity_id
+WHERE activities.trip_id = $1
+ORDER BY activity_participants.activity_id, activity_participants.participant_id;
+
+-- name: DeleteTripActivityParticipants :exec
+DELETE FROM activity_participants
+USING activities
+WHERE activities.id = activity_participants.activity_id AND activities.trip_id = $1;
+
+-- name: RestoreActivityParticipants :copyfrom
+INSERT INTO activity_participants
+    (activity_id, participant_id, created_at) VALUES
+    ($1, $2, $3);
+
+-- name: RestoreLinks :copyfrom
+INSERT INTO links
+    (id, trip_id, title, url, activity_id, created_at) VALUES
+    ($1, $2, $3, $4, $5, $6);
+
+-- name: IsOwner :one
+SELECT EXISTS (
+    SELECT 1
+    FROM trips
+    WHERE id = sqlc.arg(trip_id) AND lower(owner_email) = lower(sqlc.arg(email))
+);
+
+-- name: IsOrganizer :one
+SELECT EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE trip_id = sqlc.arg(trip_id) AND lower(email) = lower(sqlc.arg(email)) AND is_organizer AND deleted_at IS NULL
+);
+
+-- name: SetParticipantGroup :exec
+UPDATE participants
+SET group_name = $1
+WHERE id = $2;
+
+-- name: SetParticipantOrganizer :exec
+UPDATE participants
+SET is_organizer = $1
+WHERE id = $2;
+
+-- name: CountTripActivities :one
+SELECT COUNT(*)
+FROM activities
+WHERE trip_id = $1;
+
+-- name: CountTripLinks :one
+SELECT COUNT(*)
+FROM links
+WHERE trip_id = $1;
+
+-- name: CountTripParticipants :one
+SELECT COUNT(*)
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL;
+
+-- name: CreateParticipantUnavailability :one
+INSERT INTO participant_unavailabilities
+    (participant_id, starts_at, ends_at) VALUES
+    ($1, $2, $3)
+RETURNING id;
+
+-- name: GetTripConflicts :many
+SELECT
+    activities.id AS activity_id,
+    activities.title,
+    activities.occurs_at,
+    participants.id AS participant_id,
+    participants.email
+FROM activities
+JOIN participants ON participants.trip_id = activities.trip_id AND participants.deleted_at IS NULL
+JOIN participant_unavailabilities ON participant_unavailabilities.participant_id = participants.id
+WHERE activities.trip_id = $1
+    AND activities.occurs_at BETWEEN participant_unavailabilities.starts_at AND participant_unavailabilities.ends_at
+ORDER BY activities.occurs_at, activities.id, participants.email;
+
+-- name: CreatePackingItem :one
+INSERT INTO packing_items
+    (trip_id, text, assigned_to) VALUES
+    ($1, $2, $3)
+RETURNING id;
+
+-- name: GetTripPackingItems :many
+SELECT id, trip_id, text, is_packed, assigned_to
+FROM packing_items
+WHERE trip_id = $1
+ORDER BY text;
+
+-- name: UpdatePackingItem :execrows
+UPDATE packing_items
+SET
+    "text" = $1,
+    "assigned_to" = $2
+WHERE id = $3 AND trip_id = $4;
+
+-- name: TogglePackingItem :execrows
+UPDATE packing_items
+SET is_packed = NOT is_packed
+WHERE id = $1 AND trip_id = $2;
+
+-- name: DeletePackingItem :execrows
+DELETE FROM packing_items
+WHERE id = $1 AND trip_id = $2;
+
+-- name: GetTripActivitiesBetween :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND occurs_at >= sqlc.arg(starts_at) AND occurs_at < sqlc.arg(ends_at)
+ORDER BY occurs_at, id;
+
+-- name: GetTripLinksCreatedBetween :many
+SELECT id, trip_id, title, url, activity_id, created_at
+FROM links
+WHERE trip_id = $1 AND created_at >= sqlc.arg(starts_at) AND created_at < sqlc.arg(ends_at)
+ORDER BY created_at;
+
+-- name: GetTripsCreatedPerDay :many
+SELECT date_trunc('day', created_at)::timestamp AS day, count(*) AS trips
+FROM trips
+WHERE created_at >= sqlc.arg(created_from) AND created_at < sqlc.arg(created_to)
+GROUP BY day
+ORDER BY day;
+
+-- name: ActivityExists :one
+SELECT EXISTS (
+    SELECT 1
+    FROM activities
+    WHERE trip_id = $1 AND lower(btrim(title)) = lower(btrim($2)) AND occurs_at = $3
+);
+
+-- name: GetOwnerActivitiesBetween :many
+SELECT
+    activities.id,
+    activities.trip_id,
+    activities.title,
+    activities.occurs_at,
+    trips.destination
+FROM activities
+JOIN trips ON trips.id = activities.trip_id
+WHERE lower(trips.owner_email) = lower(sqlc.arg(owner_email))
+    AND activities.occurs_at >= sqlc.arg(starts_at) AND activities.occurs_at < sqlc.arg(ends_at)
+ORDER BY activities.occurs_at, activities.id;
+
+-- name: GetTripParticipantsNotEmailed :many
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT EXISTS (
+    SELECT 1
+    FROM email_log
+    WHERE email_log.participant_id = participants.id AND email_log.kind = 'invite' AND email_log.status = 'sent'
+)
+ORDER BY email;
+
+-- name: GetOwnerTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE lower(owner_email) = lower(sqlc.arg(owner_email))
+ORDER BY starts_at;
+
+-- name: GetOwnerActiveTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE lower(owner_email) = lower(sqlc.arg(owner_email)) AND status <> 'archived'
+ORDER BY starts_at;
+
+-- name: UpdateTripVersioned :execrows
+UPDATE trips
+SET
+    "destination" = $1,
+    "ends_at" = $2,
+    "starts_at" = $3,
+    "is_confirmed" = $4,
+    "version" = version + 1
+WHERE id = $5 AND version = sqlc.arg(expected_version);
+
+-- name: CreateActivityAttachment :one
+INSERT INTO activity_attachments
+    (activity_id, file_name, content_type, size, content) VALUES
+    ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetActivityAttachment :one
+SELECT
+    activity_attachments.id,
+    activity_attachments.activity_id,
+    activity_attachments.file_name,
+    activity_attachments.content_type,
+    activity_attachments.size,
+    activity_attachments.content,
+    activity_attachments.created_at
+FROM activity_attachments
+JOIN activities ON activities.id = activity_attachments.activity_id
+WHERE activity_attachments.id = $1
+    AND activity_attachments.activity_id = $2
+    AND activities.trip_id = sqlc.arg(trip_id);
+
+-- name: GetOwnerDestinations :many
+SELECT DISTINCT destination
+FROM trips
+WHERE lower(owner_email) = lower(sqlc.arg(owner_email)) AND status <> 'archived'
+ORDER BY destination;
+
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions
+    (trip_id, url, events, secret) VALUES
+    ($1, $2, $3, $4)
+RETURNING id;
+
+-- name: GetTripWebhookSubscriptions :many
+SELECT id, trip_id, url, events, secret, created_at
+FROM webhook_subscriptions
+WHERE trip_id = $1
+ORDER BY created_at, id;
+
+-- name: UpdateWebhookSubscription :execrows
+UPDATE webhook_subscriptions
+SET
+    "url" = $1,
+    "events" = $2
+WHERE id = $3 AND trip_id = $4;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE id = $1 AND trip_id = $2;
+
+-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries
+    (subscription_id, event, payload)
+SELECT id, sqlc.arg(event), sqlc.arg(payload)
+FROM webhook_subscriptions
+WHERE trip_id = sqlc.arg(trip_id) AND sqlc.arg(event)::text = ANY(events);
+
+-- name: GetDueWebhookDeliveries :many
+SELECT
+    webhook_deliveries.id,
+    webhook_deliveries.event,
+    webhook_deliveries.payload,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret
+FROM webhook_deliveries
+JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id
+WHERE webhook_deliveries.status = 'pending' AND webhook_deliveries.next_attempt_at <= sqlc.arg(now)
+ORDER BY webhook_deliveries.next_attempt_at, webhook_deliveries.id
+LIMIT sqlc.arg(max_deliveries);
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET
+    "status" = 'delivered',
+    "attempts" = attempts + 1,
+    "last_status_code" = $1,
+    "last_error" = NULL,
+    "delivered_at" = $2
+WHERE id = $3;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+    "status" = $1,
+    "attempts" = attempts + 1,
+    "last_status_code" = $2,
+    "last_error" = $3,
+    "next_attempt_at" = $4
+WHERE id = $5;
+
+-- name: GetWebhookDelivery :one
+SELECT
+    webhook_deliveries.id,
+    webhook_deliveries.event,
+    webhook_deliveries.payload,
+    webhook_deliveries.status,
+    webhook_deliveries.attempts,
+    webhook_subscriptions.url,
+    webhook_subscriptions.secret
+FROM webhook_deliveries
+JOIN webhook_subscriptions ON webhook_subscriptions.id = webhook_deliveries.subscription_id
+WHERE webhook_deliveries.id = $1;
+
+-- name: DeleteParticipant :execrows
+UPDATE participants
+SET deleted_at = now()
+WHERE id = $1 AND trip_id = $2 AND deleted_at IS NULL;
+
+-- name: RestoreParticipant :execrows
+UPDATE participants
+SET deleted_at = NULL
+WHERE id = $1 AND trip_id = $2 AND deleted_at >= sqlc.arg(deleted_after);
+
+-- name: DeleteTripByID :exec
+DELETE FROM trips
+WHERE id = $1;
+
+-- name: GetParticipantWithTrip :one
+SELECT sqlc.embed(participants), sqlc.embed(trips)
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE participants.id = $1 AND participants.deleted_at IS NULL;
+
+-- name: UpdateActivity :execrows
+UPDATE activities
+SET
+    "title" = sqlc.arg(title),
+    "occurs_at" = sqlc.arg(occurs_at),
+    "reminder_sent_at" = CASE WHEN occurs_at = sqlc.arg(occurs_at) THEN reminder_sent_at END
+WHERE id = sqlc.arg(id) AND trip_id = sqlc.arg(trip_id);
+
+-- name: ConfirmTripParticipants :execrows
+UPDATE participants
+SET is_confirmed = true, confirmed_at = now()
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT is_confirmed;
+
+-- name: DeleteActivity :execrows
+DELETE FROM activities
+WHERE id = $1 AND trip_id = $2;
+
+-- name: GetTripActivitiesPaginated :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND (
+    sqlc.narg(has_links)::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM links WHERE links.activity_id = activities.id) = sqlc.narg(has_links)::boolean
+)
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::text = 'occurs_at' THEN occurs_at END,
+    CASE WHEN sqlc.arg(sort)::text = '-occurs_at' THEN occurs_at END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'title' THEN title END,
+    CASE WHEN sqlc.arg(sort)::text = '-title' THEN title END DESC,
+    occurs_at, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountTripActivitiesByLinks :one
+SELECT COUNT(*)
+FROM activities
+WHERE trip_id = $1 AND (
+    sqlc.narg(has_links)::boolean IS NULL
+    OR EXISTS (SELECT 1 FROM links WHERE links.activity_id = activities.id) = sqlc.narg(has_links)::boolean
+);
+
+-- name: DeleteOwnerTrips :execrows
+DELETE FROM trips
+WHERE lower(owner_email) = lower(sqlc.arg(owner_email));
+
+-- name: AnonymizeParticipantsByEmail :execrows
+UPDATE participants
+SET email = 'deleted-' || id || '@invalid', name = NULL
+WHERE lower(email) = lower(sqlc.arg(email));
+
+-- name: AnonymizeEmailLogRecipient :exec
+UPDATE email_log
+SET recipient = 'deleted-' || id || '@invalid'
+WHERE lower(recipient) = lower(sqlc.arg(recipient));
+
+-- name: InsertEmailLog :exec
+INSERT INTO email_log
+    (trip_id, participant_id, recipient, kind, status, error) VALUES
+    ($1, $2, $3, $4, $5, $6);
+
+-- name: AssignActivityParticipant :exec
+INSERT INTO activity_participants
+    (activity_id, participant_id) VALUES
+    ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UnassignActivityParticipant :execrows
+DELETE FROM activity_participants
+USING activities
+WHERE activities.id = activity_participants.activity_id
+    AND activity_participants.activity_id = sqlc.arg(activity_id)
+    AND activity_participants.participant_id = sqlc.arg(participant_id)
+    AND activities.trip_id = sqlc.arg(trip_id);
+
+-- name: GetTripActivityParticipants :many
+SELECT activity_participants.activity_id, participants.id, participants.email, participants.name
+FROM activity_participants
+JOIN activities ON activities.id = activity_participants.activity_id
+JOIN participants ON participants.id = activity_participants.participant_id AND participants.deleted_at IS NULL
+WHERE activities.trip_id = $1
+ORDER BY participants.email;
+
+-- name: GetTripBusiestDay :one
+SELECT date_trunc('day', occurs_at)::timestamp AS day, count(*) AS activities
+FROM activities
+WHERE trip_id = $1
+GROUP BY day
+ORDER BY activities DESC, day
+LIMIT 1;
+
+-- name: UpdateLink :execrows
+UPDATE links
+SET
+    "title" = $1,
+    "url" = $2
+WHERE id = $3 AND trip_id = $4;
+
+-- name: DeleteLink :execrows
+DELETE FROM links
+WHERE id = $1 AND trip_id = $2;
+
+-- name: GetEagerParticipants :many
+SELECT participants.id, participants.email, participants.name, trips.id AS trip_id, trips.destination
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE participants.is_confirmed AND participants.deleted_at IS NULL AND NOT trips.is_confirmed
+ORDER BY trips.starts_at, participants.email;
+
+-- name: SearchTrips :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE (NOT sqlc.arg(all_confirmed)::boolean OR NOT EXISTS (
+        SELECT 1
+        FROM participants
+        WHERE participants.trip_id = trips.id AND participants.deleted_at IS NULL AND NOT participants.is_confirmed
+    ))
+    AND (sqlc.narg(destination)::text IS NULL OR destination ILIKE '%' || sqlc.narg(destination)::text || '%')
+    AND (sqlc.narg(starts_after)::timestamp IS NULL OR starts_at >= sqlc.narg(starts_after)::timestamp)
+    AND (sqlc.narg(ends_before)::timestamp IS NULL OR ends_at <= sqlc.narg(ends_before)::timestamp)
+    AND (sqlc.narg(is_confirmed)::boolean IS NULL OR is_confirmed = sqlc.narg(is_confirmed)::boolean)
+    AND (status = 'archived') = sqlc.arg(archived)::boolean
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::text = 'starts_at' THEN starts_at END,
+    CASE WHEN sqlc.arg(sort)::text = '-starts_at' THEN starts_at END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'created_at' THEN created_at END,
+    CASE WHEN sqlc.arg(sort)::text = '-created_at' THEN created_at END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'destination' THEN destination END,
+    CASE WHEN sqlc.arg(sort)::text = '-destination' THEN destination END DESC,
+    starts_at, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountSearchTrips :one
+SELECT COUNT(*)
+FROM trips
+WHERE (NOT sqlc.arg(all_confirmed)::boolean OR NOT EXISTS (
+        SELECT 1
+        FROM participants
+        WHERE participants.trip_id = trips.id AND participants.deleted_at IS NULL AND NOT participants.is_confirmed
+    ))
+    AND (sqlc.narg(destination)::text IS NULL OR destination ILIKE '%' || sqlc.narg(destination)::text || '%')
+    AND (sqlc.narg(starts_after)::timestamp IS NULL OR starts_at >= sqlc.narg(starts_after)::timestamp)
+    AND (sqlc.narg(ends_before)::timestamp IS NULL OR ends_at <= sqlc.narg(ends_before)::timestamp)
+    AND (sqlc.narg(is_confirmed)::boolean IS NULL OR is_confirmed = sqlc.narg(is_confirmed)::boolean)
+    AND (status = 'archived') = sqlc.arg(archived)::boolean;
+
+-- name: GetLatestTripEmail :one
+SELECT
+    *
+FROM email_log
+WHERE trip_id = $1 AND kind = $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: InsertOutboxEmail :exec
+INSERT INTO email_outbox
+    (recipient, message) VALUES
+    ($1, $2);
+
+-- name: DeleteOutboxEmailsByRecipient :exec
+DELETE FROM email_outbox
+WHERE lower(recipient) = lower(sqlc.arg(recipient));
+
+-- name: GetOutboxEmails :many
+SELECT
+    *
+FROM email_outbox
+ORDER BY created_at, id;
+
+-- name: DeleteOutboxEmail :exec
+DELETE FROM email_outbox
+WHERE id = $1;
+
+-- name: ResetParticipantConfirmations :exec
+UPDATE participants
+SET is_confirmed = false, confirmed_at = NULL, invite_expires_at = $2
+WHERE trip_id = $1 AND deleted_at IS NULL;
+
+-- name: EstimateTripsCount :one
+SELECT (CASE
+    WHEN reltuples < 0 THEN -1
+    ELSE reltuples * COALESCE((
+        SELECT CASE WHEN sqlc.arg(archived)::boolean THEN mcv.freq ELSE 1 - mcv.freq END
+        FROM pg_stats, unnest(most_common_vals::text::text[], most_common_freqs) AS mcv(val, freq)
+        WHERE schemaname = current_schema() AND tablename = 'trips' AND attname = 'status' AND mcv.val = 'archived'
+    ), CASE WHEN sqlc.arg(archived)::boolean THEN 0 ELSE 1 END)
+END)::bigint AS reltuples
+FROM pg_class
+WHERE oid = 'trips'::regclass;
+
+-- name: GetOwnerConfirmationCounts :one
+SELECT
+    count(*) AS invited,
+    count(*) FILTER (WHERE participants.is_confirmed) AS confirmed
+FROM participants
+JOIN trips ON trips.id = participants.trip_id
+WHERE lower(trips.owner_email) = lower(sqlc.arg(owner_email)) AND participants.deleted_at IS NULL;
+
+-- name: GetTripsByParticipantEmail :many
+SELECT id, destination, owner_email, owner_name, is_confirmed, starts_at, ends_at, created_at, version, place_name, latitude, longitude, status
+FROM trips
+WHERE EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE participants.trip_id = trips.id AND lower(participants.email) = lower(sqlc.arg(email)) AND participants.deleted_at IS NULL
+)
+    AND (status = 'archived') = sqlc.arg(archived)::boolean
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::text = 'starts_at' THEN starts_at END,
+    CASE WHEN sqlc.arg(sort)::text = '-starts_at' THEN starts_at END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'created_at' THEN created_at END,
+    CASE WHEN sqlc.arg(sort)::text = '-created_at' THEN created_at END DESC,
+    CASE WHEN sqlc.arg(sort)::text = 'destination' THEN destination END,
+    CASE WHEN sqlc.arg(sort)::text = '-destination' THEN destination END DESC,
+    starts_at, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountTripsByParticipantEmail :one
+SELECT COUNT(*)
+FROM trips
+WHERE EXISTS (
+    SELECT 1
+    FROM participants
+    WHERE participants.trip_id = trips.id AND lower(participants.email) = lower(sqlc.arg(email)) AND participants.deleted_at IS NULL
+)
+    AND (status = 'archived') = sqlc.arg(archived)::boolean;
+
+-- name: UpdateTripPlace :exec
+UPDATE trips
+SET place_name = $2, latitude = $3, longitude = $4
+WHERE id = $1;
+
+-- name: GetTripActivitiesCreatedSince :many
+SELECT id, trip_id, title, occurs_at, remind_before_minutes, reminder_sent_at, duration_minutes, created_at
+FROM activities
+WHERE trip_id = $1 AND created_at > sqlc.arg(since)
+ORDER BY created_at DESC, id;
+
+-- name: ArchiveTripsEndedBefore :execrows
+UPDATE trips
+SET status = 'archived'
+WHERE status = 'active' AND ends_at < sqlc.arg(ended_before);
+
+-- name: GetTripParticipantsToInvite :many
+SELECT id, trip_id, email, is_confirmed, invite_expires_at, is_organizer, group_name, name, created_at, confirmed_at, deleted_at
+FROM participants
+WHERE trip_id = $1 AND deleted_at IS NULL AND NOT is_confirmed AND NOT EXISTS (
+    SELECT 1
+    FROM email_log
+    WHERE email_log.participant_id = participants.id AND email_log.kind = 'invite' AND email_log.status IN ('sent', 'queued')
+)
+ORDER BY email;
\ No newline at end of file
@@ -0,0 +1,1047 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestIsOwner(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "Owner@Example.com")
+	otherTripID := insertTestTrip(t, q, "someone@example.com")
+
+	tests := []struct {
+		name   string
+		tripID uuid.UUID
+		email  string
+		want   bool
+	}{
+		{"owner", tripID, "Owner@Example.com", true},
+		{"owner in another case", tripID, "owner@example.COM", true},
+		{"someone else", tripID, "someone@example.com", false},
+		{"owner of another trip", otherTripID, "owner@example.com", false},
+		{"missing trip", uuid.New(), "owner@example.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := q.IsOwner(ctx, IsOwnerParams{TripID: tt.tripID, Email: tt.email})
+			if err != nil {
+				t.Fatalf("IsOwner: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsOwner = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchTripsAllConfirmed(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	confirmed := insertTestTrip(t, q, "owner@example.com")
+	if err := q.ConfirmParticipant(ctx, inviteTestParticipant(t, q, confirmed, "ana@example.com")); err != nil {
+		t.Fatalf("failed to confirm participant: %v", err)
+	}
+	removed := inviteTestParticipant(t, q, confirmed, "bia@example.com")
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: removed, TripID: confirmed}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+
+	pending := insertTestTrip(t, q, "owner@example.com")
+	inviteTestParticipant(t, q, pending, "caio@example.com")
+
+	empty := insertTestTrip(t, q, "owner@example.com")
+
+	trips, err := q.SearchTrips(ctx, SearchTripsParams{AllConfirmed: true, Sort: "starts_at", Limit: 10})
+	if err != nil {
+		t.Fatalf("SearchTrips: %v", err)
+	}
+	ids := make([]uuid.UUID, len(trips))
+	for i, trip := range trips {
+		ids[i] = trip.ID
+	}
+	if !sameIDs(ids, []uuid.UUID{confirmed, empty}) {
+		t.Errorf("trips = %v, want %s and %s", ids, confirmed, empty)
+	}
+
+	count, err := q.CountSearchTrips(ctx, CountSearchTripsParams{AllConfirmed: true})
+	if err != nil {
+		t.Fatalf("CountSearchTrips: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+
+	count, err = q.CountSearchTrips(ctx, CountSearchTripsParams{})
+	if err != nil {
+		t.Fatalf("CountSearchTrips: %v", err)
+	}
+	if count != 3 {
+		t.Errorf("count without the filter = %d, want 3", count)
+	}
+}
+
+func TestGetDueActivityReminders(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	now := testTime(11)
+	create := func(title string, occursIn time.Duration, remindBefore pgtype.Int4) uuid.UUID {
+		id, err := q.CreateActivity(ctx, CreateActivityParams{
+			TripID:              tripID,
+			Title:               title,
+			OccursAt:            pgtype.Timestamp{Valid: true, Time: now.Time.Add(occursIn)},
+			RemindBeforeMinutes: remindBefore,
+		})
+		if err != nil {
+			t.Fatalf("failed to create activity %s: %v", title, err)
+		}
+		return id
+	}
+	minutes := func(m int32) pgtype.Int4 { return pgtype.Int4{Valid: true, Int32: m} }
+
+	dueSoon := create("Passeio de barco", 30*time.Minute, minutes(60))
+	dueEarly := create("Voo", 20*time.Hour, minutes(24*60))
+	create("Jantar", 2*time.Hour, minutes(60))
+	create("Praia", 10*time.Minute, pgtype.Int4{})
+	create("Café", -time.Minute, minutes(60))
+	sent := create("Museu", 15*time.Minute, minutes(30))
+	if err := q.MarkActivityReminderSent(ctx, MarkActivityReminderSentParams{ReminderSentAt: now, ID: sent}); err != nil {
+		t.Fatalf("failed to mark reminder sent: %v", err)
+	}
+
+	due, err := q.GetDueActivityReminders(ctx, now)
+	if err != nil {
+		t.Fatalf("GetDueActivityReminders: %v", err)
+	}
+	if len(due) != 2 || due[0].ID != dueSoon || due[1].ID != dueEarly {
+		t.Errorf("due = %+v, want %s then %s", due, dueSoon, dueEarly)
+	}
+}
+
+func TestGetTripConflicts(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	busy := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	inviteTestParticipant(t, q, tripID, "bia@example.com")
+	removed := inviteTestParticipant(t, q, tripID, "caio@example.com")
+
+	clash := createTestActivity(t, q, tripID, "Passeio de barco", 11)
+	createTestActivity(t, q, tripID, "Jantar", 13)
+
+	// Both ends of an unavailability are part of it.
+	for _, id := range []uuid.UUID{busy, removed} {
+		_, err := q.CreateParticipantUnavailability(ctx, CreateParticipantUnavailabilityParams{
+			ParticipantID: id,
+			StartsAt:      testTime(11),
+			EndsAt:        testTime(12),
+		})
+		if err != nil {
+			t.Fatalf("failed to create unavailability: %v", err)
+		}
+	}
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: removed, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+
+	conflicts, err := q.GetTripConflicts(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTripConflicts: %v", err)
+	}
+	if len(conflicts) != 1 || conflicts[0].ActivityID != clash || conflicts[0].ParticipantID != busy {
+		t.Errorf("conflicts = %+v, want %s on %s", conflicts, busy, clash)
+	}
+}
+
+func TestGetTripActivitiesPaginatedHasLinks(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	linked, _, err := q.CreateActivityWithLink(ctx, pool,
+		CreateActivityParams{TripID: tripID, Title: "Show", OccursAt: testTime(11)},
+		CreateTripLinkParams{TripID: tripID, Title: "Ingresso", Url: "https://example.com/ingresso"},
+	)
+	if err != nil {
+		t.Fatalf("failed to create activity with link: %v", err)
+	}
+	unlinked := createTestActivity(t, q, tripID, "Jantar", 12)
+	// A link of the trip, not of an activity, links none of them.
+	if _, err := q.CreateTripLink(ctx, CreateTripLinkParams{TripID: tripID, Title: "Hotel", Url: "https://example.com/hotel"}); err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		hasLinks pgtype.Bool
+		want     []uuid.UUID
+	}{
+		{"with links", pgtype.Bool{Valid: true, Bool: true}, []uuid.UUID{linked}},
+		{"without links", pgtype.Bool{Valid: true, Bool: false}, []uuid.UUID{unlinked}},
+		{"either", pgtype.Bool{}, []uuid.UUID{linked, unlinked}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activities, err := q.GetTripActivitiesPaginated(ctx, GetTripActivitiesPaginatedParams{
+				TripID:   tripID,
+				HasLinks: tt.hasLinks,
+				Sort:     "occurs_at",
+				Limit:    10,
+			})
+			if err != nil {
+				t.Fatalf("GetTripActivitiesPaginated: %v", err)
+			}
+			ids := make([]uuid.UUID, len(activities))
+			for i, activity := range activities {
+				ids[i] = activity.ID
+			}
+			if !sameIDs(ids, tt.want) {
+				t.Errorf("activities = %v, want %v", ids, tt.want)
+			}
+
+			count, err := q.CountTripActivitiesByLinks(ctx, CountTripActivitiesByLinksParams{TripID: tripID, HasLinks: tt.hasLinks})
+			if err != nil {
+				t.Fatalf("CountTripActivitiesByLinks: %v", err)
+			}
+			if count != int64(len(tt.want)) {
+				t.Errorf("count = %d, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestGetTripsCreatedPerDay(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	createdOn := func(day int, hour time.Duration) {
+		tripID := insertTestTrip(t, q, "owner@example.com")
+		createdAt := testTime(day).Time.Add(hour)
+		if _, err := pool.Exec(ctx, "UPDATE trips SET created_at = $1 WHERE id = $2", createdAt, tripID); err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+	}
+	createdOn(1, 0)
+	createdOn(2, 8*time.Hour)
+	createdOn(2, 23*time.Hour)
+	createdOn(4, 12*time.Hour)
+	createdOn(5, 0)
+
+	days, err := q.GetTripsCreatedPerDay(ctx, GetTripsCreatedPerDayParams{CreatedFrom: testTime(2), CreatedTo: testTime(5)})
+	if err != nil {
+		t.Fatalf("GetTripsCreatedPerDay: %v", err)
+	}
+	want := []GetTripsCreatedPerDayRow{
+		{Day: testTime(2), Trips: 2},
+		{Day: testTime(4), Trips: 1},
+	}
+	if len(days) != len(want) {
+		t.Fatalf("days = %+v, want %+v", days, want)
+	}
+	for i := range want {
+		if !days[i].Day.Time.Equal(want[i].Day.Time) || days[i].Trips != want[i].Trips {
+			t.Errorf("day %d = %s %d, want %s %d", i, days[i].Day.Time, days[i].Trips, want[i].Day.Time, want[i].Trips)
+		}
+	}
+}
+
+func TestGetOwnerActivitiesBetween(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	beach := insertTestTrip(t, q, "owner@example.com")
+	city := insertTestTrip(t, q, "Owner@Example.com")
+	other := insertTestTrip(t, q, "someone@example.com")
+
+	boat := createTestActivity(t, q, beach, "Passeio de barco", 11)
+	museum := createTestActivity(t, q, city, "Museu", 11)
+	createTestActivity(t, q, beach, "Jantar", 12)
+	createTestActivity(t, q, other, "Show", 11)
+
+	activities, err := q.GetOwnerActivitiesBetween(ctx, GetOwnerActivitiesBetweenParams{
+		OwnerEmail: "owner@example.com",
+		StartsAt:   testTime(11),
+		EndsAt:     testTime(12),
+	})
+	if err != nil {
+		t.Fatalf("GetOwnerActivitiesBetween: %v", err)
+	}
+	ids := make([]uuid.UUID, len(activities))
+	for i, activity := range activities {
+		ids[i] = activity.ID
+		if activity.Destination != "Florianópolis" {
+			t.Errorf("activity %s destination = %q, want Florianópolis", activity.Title, activity.Destination)
+		}
+	}
+	if !sameIDs(ids, []uuid.UUID{boat, museum}) {
+		t.Errorf("activities = %v, want %s and %s", ids, boat, museum)
+	}
+}
+
+func TestGetTripParticipantsNotEmailed(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	emailed := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	failed := inviteTestParticipant(t, q, tripID, "bia@example.com")
+	reminded := inviteTestParticipant(t, q, tripID, "caio@example.com")
+	never := inviteTestParticipant(t, q, tripID, "duda@example.com")
+	removed := inviteTestParticipant(t, q, tripID, "edu@example.com")
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: removed, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+
+	logs := []struct {
+		participantID uuid.UUID
+		kind, status  string
+	}{
+		{emailed, "invite", "failed"},
+		{emailed, "invite", "sent"},
+		{failed, "invite", "failed"},
+		{reminded, "reminder", "sent"},
+	}
+	for _, l := range logs {
+		err := q.InsertEmailLog(ctx, InsertEmailLogParams{
+			TripID:        tripID,
+			ParticipantID: pgtype.UUID{Valid: true, Bytes: l.participantID},
+			Recipient:     "someone@example.com",
+			Kind:          l.kind,
+			Status:        l.status,
+		})
+		if err != nil {
+			t.Fatalf("failed to insert email log: %v", err)
+		}
+	}
+
+	participants, err := q.GetTripParticipantsNotEmailed(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTripParticipantsNotEmailed: %v", err)
+	}
+	ids := make([]uuid.UUID, len(participants))
+	for i, participant := range participants {
+		ids[i] = participant.ID
+	}
+	if !sameIDs(ids, []uuid.UUID{failed, reminded, never}) {
+		t.Errorf("participants = %v, want %s, %s and %s", ids, failed, reminded, never)
+	}
+}
+
+func TestUpdateTripVersioned(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	update := func(destination string, expected int32) int64 {
+		t.Helper()
+		rows, err := q.UpdateTripVersioned(ctx, UpdateTripVersionedParams{
+			Destination:     destination,
+			StartsAt:        trip.StartsAt,
+			EndsAt:          trip.EndsAt,
+			ID:              tripID,
+			ExpectedVersion: expected,
+		})
+		if err != nil {
+			t.Fatalf("UpdateTripVersioned: %v", err)
+		}
+		return rows
+	}
+
+	if rows := update("Salvador", trip.Version); rows != 1 {
+		t.Errorf("rows affected on the current version = %d, want 1", rows)
+	}
+	if rows := update("Recife", trip.Version); rows != 0 {
+		t.Errorf("rows affected on a stale version = %d, want 0", rows)
+	}
+
+	updated, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	if updated.Destination != "Salvador" || updated.Version != trip.Version+1 {
+		t.Errorf("trip = %q version %d, want Salvador version %d", updated.Destination, updated.Version, trip.Version+1)
+	}
+}
+
+func TestGetOwnerDestinations(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	trips := []struct{ destination, owner string }{
+		{"Salvador", "owner@example.com"},
+		{"Florianópolis", "Owner@Example.com"},
+		{"Salvador", "owner@example.com"},
+		{"Recife", "someone@example.com"},
+	}
+	for _, trip := range trips {
+		_, err := q.InsertTrip(ctx, InsertTripParams{
+			Destination: trip.destination,
+			OwnerEmail:  trip.owner,
+			OwnerName:   "Dono",
+			StartsAt:    testTime(10),
+			EndsAt:      testTime(15),
+		})
+		if err != nil {
+			t.Fatalf("failed to insert trip: %v", err)
+		}
+	}
+
+	destinations, err := q.GetOwnerDestinations(ctx, "owner@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerDestinations: %v", err)
+	}
+	if len(destinations) != 2 || destinations[0] != "Florianópolis" || destinations[1] != "Salvador" {
+		t.Errorf("destinations = %q, want Florianópolis and Salvador", destinations)
+	}
+}
+
+func TestGetTripActivitiesOrder(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	later := createTestActivity(t, q, tripID, "Jantar", 12)
+	var sameTime []uuid.UUID
+	for _, title := range []string{"Praia", "Museu", "Feira", "Café", "Trilha"} {
+		sameTime = append(sameTime, createTestActivity(t, q, tripID, title, 11))
+	}
+	sort.Slice(sameTime, func(i, j int) bool { return sameTime[i].String() < sameTime[j].String() })
+	want := append(sameTime, later)
+
+	for i := 0; i < 5; i++ {
+		activities, err := q.GetTripActivities(ctx, tripID)
+		if err != nil {
+			t.Fatalf("GetTripActivities: %v", err)
+		}
+		if len(activities) != len(want) {
+			t.Fatalf("got %d activities, want %d", len(activities), len(want))
+		}
+		for j, activity := range activities {
+			if activity.ID != want[j] {
+				t.Fatalf("call %d: activity %d = %s, want %s", i, j, activity.ID, want[j])
+			}
+		}
+	}
+}
+
+func TestGetParticipantWithTrip(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	participantID := inviteTestParticipant(t, q, tripID, "ana@example.com")
+
+	row, err := q.GetParticipantWithTrip(ctx, participantID)
+	if err != nil {
+		t.Fatalf("GetParticipantWithTrip: %v", err)
+	}
+	if row.Participant.ID != participantID || row.Participant.Email != "ana@example.com" {
+		t.Errorf("participant = %+v, want %s", row.Participant, participantID)
+	}
+	if row.Trip.ID != tripID || row.Trip.OwnerEmail != "owner@example.com" || row.Trip.Status != "active" {
+		t.Errorf("trip = %+v, want active trip %s", row.Trip, tripID)
+	}
+
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: participantID, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+	for _, id := range []uuid.UUID{participantID, uuid.New()} {
+		if _, err := q.GetParticipantWithTrip(ctx, id); !errors.Is(err, pgx.ErrNoRows) {
+			t.Errorf("GetParticipantWithTrip(%s): err = %v, want %v", id, err, pgx.ErrNoRows)
+		}
+	}
+}
+
+func TestGetTripParticipantsToInvite(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	invited := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	failed := inviteTestParticipant(t, q, tripID, "bia@example.com")
+	queued := inviteTestParticipant(t, q, tripID, "caio@example.com")
+	fresh := inviteTestParticipant(t, q, tripID, "duda@example.com")
+	confirmed := inviteTestParticipant(t, q, tripID, "edu@example.com")
+	if err := q.ConfirmParticipant(ctx, confirmed); err != nil {
+		t.Fatalf("failed to confirm participant: %v", err)
+	}
+	for participantID, status := range map[uuid.UUID]string{invited: "sent", failed: "failed", queued: "queued"} {
+		err := q.InsertEmailLog(ctx, InsertEmailLogParams{
+			TripID:        tripID,
+			ParticipantID: pgtype.UUID{Valid: true, Bytes: participantID},
+			Recipient:     "someone@example.com",
+			Kind:          "invite",
+			Status:        status,
+		})
+		if err != nil {
+			t.Fatalf("failed to log email: %v", err)
+		}
+	}
+
+	participants, err := q.GetTripParticipantsToInvite(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTripParticipantsToInvite: %v", err)
+	}
+	var got []uuid.UUID
+	for _, p := range participants {
+		got = append(got, p.ID)
+	}
+	// Ordered by email.
+	if want := []uuid.UUID{failed, fresh}; !slices.Equal(got, want) {
+		t.Errorf("participants to invite = %v, want %v", got, want)
+	}
+}
+
+func TestGetTripBusiestDay(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	if _, err := q.GetTripBusiestDay(ctx, tripID); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("trip without activities: err = %v, want %v", err, pgx.ErrNoRows)
+	}
+
+	// Days 12 and 13 tie with two activities each, ahead of day 11.
+	for _, day := range []int{13, 11, 12, 13, 12} {
+		createTestActivity(t, q, tripID, fmt.Sprintf("Atividade %d", day), day)
+	}
+	otherTripID := insertTestTrip(t, q, "owner@example.com")
+	for i := 0; i < 3; i++ {
+		createTestActivity(t, q, otherTripID, fmt.Sprintf("Atividade %d", i), 11)
+	}
+
+	busiest, err := q.GetTripBusiestDay(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTripBusiestDay: %v", err)
+	}
+	if want := testTime(12).Time; !busiest.Day.Time.Equal(want) || busiest.Activities != 2 {
+		t.Errorf("busiest day = %s with %d activities, want %s with 2", busiest.Day.Time, busiest.Activities, want)
+	}
+}
+
+func TestDeleteLink(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	otherTripID := insertTestTrip(t, q, "owner@example.com")
+	linkID, err := q.CreateTripLink(ctx, CreateTripLinkParams{TripID: tripID, Title: "Hotel", Url: "https://example.com/hotel"})
+	if err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+
+	if deleted, err := q.DeleteLink(ctx, DeleteLinkParams{ID: linkID, TripID: otherTripID}); err != nil || deleted != 0 {
+		t.Errorf("deleting through another trip = %d, %v, want 0", deleted, err)
+	}
+	if deleted, err := q.DeleteLink(ctx, DeleteLinkParams{ID: linkID, TripID: tripID}); err != nil || deleted != 1 {
+		t.Errorf("deleting = %d, %v, want 1", deleted, err)
+	}
+
+	links, err := q.GetTripLinks(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get links: %v", err)
+	}
+	if len(links) != 0 {
+		t.Errorf("links = %+v, want none", links)
+	}
+}
+
+func TestGetEagerParticipants(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	eager := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	inviteTestParticipant(t, q, tripID, "bia@example.com")
+	removed := inviteTestParticipant(t, q, tripID, "caio@example.com")
+	confirmedTripID := insertTestTrip(t, q, "owner@example.com")
+	onConfirmedTrip := inviteTestParticipant(t, q, confirmedTripID, "duda@example.com")
+
+	for _, id := range []uuid.UUID{eager, removed, onConfirmedTrip} {
+		if err := q.ConfirmParticipant(ctx, id); err != nil {
+			t.Fatalf("failed to confirm participant: %v", err)
+		}
+	}
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: removed, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+	if err := q.ConfirmTrip(ctx, confirmedTripID); err != nil {
+		t.Fatalf("failed to confirm trip: %v", err)
+	}
+
+	participants, err := q.GetEagerParticipants(ctx)
+	if err != nil {
+		t.Fatalf("GetEagerParticipants: %v", err)
+	}
+	if len(participants) != 1 || participants[0].ID != eager || participants[0].TripID != tripID || participants[0].Destination != "Florianópolis" {
+		t.Errorf("eager participants = %+v, want only %s", participants, eager)
+	}
+}
+
+func TestSearchTripsCombinedFilters(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	insert := func(destination string, startsIn, endsIn int, confirmed bool) uuid.UUID {
+		t.Helper()
+		id, err := q.InsertTrip(ctx, InsertTripParams{
+			Destination: destination,
+			OwnerEmail:  "owner@example.com",
+			OwnerName:   "Dono",
+			StartsAt:    testTime(startsIn),
+			EndsAt:      testTime(endsIn),
+		})
+		if err != nil {
+			t.Fatalf("failed to insert trip: %v", err)
+		}
+		if confirmed {
+			if err := q.ConfirmTrip(ctx, id); err != nil {
+				t.Fatalf("failed to confirm trip: %v", err)
+			}
+		}
+		return id
+	}
+
+	match := insert("Praia do Rosa", 10, 15, true)
+	alsoMatch := insert("ROSARIO", 12, 20, true)
+	insert("Praia do Rosa", 10, 15, false) // not confirmed
+	insert("Florianópolis", 10, 15, true)  // other destination
+	insert("Rosa Norte", 5, 15, true)      // starts too early
+	insert("Rosa", 10, 30, true)           // ends too late
+
+	trips, err := q.SearchTrips(ctx, SearchTripsParams{
+		Destination: pgtype.Text{Valid: true, String: "rosa"},
+		StartsAfter: testTime(8),
+		EndsBefore:  testTime(20),
+		IsConfirmed: pgtype.Bool{Valid: true, Bool: true},
+		Sort:        "starts_at",
+		Limit:       10,
+	})
+	if err != nil {
+		t.Fatalf("SearchTrips: %v", err)
+	}
+	ids := make([]uuid.UUID, len(trips))
+	for i, trip := range trips {
+		ids[i] = trip.ID
+	}
+	if len(ids) != 2 || ids[0] != match || ids[1] != alsoMatch {
+		t.Errorf("trips = %v, want %s then %s", ids, match, alsoMatch)
+	}
+
+	count, err := q.CountSearchTrips(ctx, CountSearchTripsParams{
+		Destination: pgtype.Text{Valid: true, String: "rosa"},
+		StartsAfter: testTime(8),
+		EndsBefore:  testTime(20),
+		IsConfirmed: pgtype.Bool{Valid: true, Bool: true},
+	})
+	if err != nil {
+		t.Fatalf("CountSearchTrips: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count = %d, want 2", count)
+	}
+
+	trips, err = q.SearchTrips(ctx, SearchTripsParams{
+		Destination: pgtype.Text{Valid: true, String: "rosa"},
+		IsConfirmed: pgtype.Bool{Valid: true, Bool: false},
+		Sort:        "starts_at",
+		Limit:       10,
+	})
+	if err != nil {
+		t.Fatalf("SearchTrips: %v", err)
+	}
+	if len(trips) != 1 || trips[0].IsConfirmed {
+		t.Errorf("unconfirmed trips = %+v, want the one unconfirmed Praia do Rosa", trips)
+	}
+}
+
+func TestGetLatestTripEmail(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	params := GetLatestTripEmailParams{TripID: tripID, Kind: "confirm_trip"}
+
+	if _, err := q.GetLatestTripEmail(ctx, params); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("before any attempt: err = %v, want %v", err, pgx.ErrNoRows)
+	}
+
+	for _, entry := range []InsertEmailLogParams{
+		{TripID: tripID, Recipient: "owner@example.com", Kind: "confirm_trip", Status: "failed", Error: pgtype.Text{Valid: true, String: "smtp down"}},
+		{TripID: tripID, Recipient: "owner@example.com", Kind: "confirm_trip", Status: "sent"},
+		{TripID: tripID, Recipient: "ana@example.com", Kind: "invite", Status: "failed"},
+	} {
+		if err := q.InsertEmailLog(ctx, entry); err != nil {
+			t.Fatalf("failed to insert email log: %v", err)
+		}
+	}
+
+	entry, err := q.GetLatestTripEmail(ctx, params)
+	if err != nil {
+		t.Fatalf("GetLatestTripEmail: %v", err)
+	}
+	if entry.Status != "sent" || entry.Recipient != "owner@example.com" || entry.Error.Valid {
+		t.Errorf("latest email = %+v, want the sent confirmation", entry)
+	}
+}
+
+func TestActivityExistsNormalizesTitles(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	createTestActivity(t, q, tripID, "Almoço", 11)
+
+	tests := []struct {
+		title string
+		day   int
+		want  bool
+	}{
+		{"Almoço", 11, true},
+		{"almoço ", 11, true},
+		{"  ALMOÇO", 11, true},
+		{"Almoço na praia", 11, false},
+		{"almoço", 12, false},
+	}
+	for _, tt := range tests {
+		exists, err := q.ActivityExists(ctx, ActivityExistsParams{TripID: tripID, Title: tt.title, OccursAt: testTime(tt.day)})
+		if err != nil {
+			t.Fatalf("ActivityExists: %v", err)
+		}
+		if exists != tt.want {
+			t.Errorf("ActivityExists(%q, day %d) = %v, want %v", tt.title, tt.day, exists, tt.want)
+		}
+	}
+}
+
+func TestResetParticipantConfirmations(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	otherTripID := insertTestTrip(t, q, "owner@example.com")
+	ana := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	inviteTestParticipant(t, q, tripID, "bia@example.com")
+	caio := inviteTestParticipant(t, q, otherTripID, "caio@example.com")
+	for _, id := range []uuid.UUID{ana, caio} {
+		if err := q.ConfirmParticipant(ctx, id); err != nil {
+			t.Fatalf("failed to confirm participant: %v", err)
+		}
+	}
+
+	expiresAt := testTime(7)
+	if err := q.ResetParticipantConfirmations(ctx, ResetParticipantConfirmationsParams{TripID: tripID, InviteExpiresAt: expiresAt}); err != nil {
+		t.Fatalf("ResetParticipantConfirmations: %v", err)
+	}
+
+	participants, err := q.GetParticipants(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetParticipants: %v", err)
+	}
+	if len(participants) != 2 {
+		t.Fatalf("got %d participants, want 2", len(participants))
+	}
+	for _, p := range participants {
+		if p.IsConfirmed || p.ConfirmedAt.Valid {
+			t.Errorf("%s is still confirmed", p.Email)
+		}
+		if !p.InviteExpiresAt.Time.Equal(expiresAt.Time) {
+			t.Errorf("%s invite expires at %s, want %s", p.Email, p.InviteExpiresAt.Time, expiresAt.Time)
+		}
+	}
+
+	other, err := q.GetParticipant(ctx, caio)
+	if err != nil {
+		t.Fatalf("GetParticipant: %v", err)
+	}
+	if !other.IsConfirmed {
+		t.Error("a participant of another trip was reset")
+	}
+}
+
+func TestEstimateTripsCount(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		insertTestTrip(t, q, "owner@example.com")
+	}
+	for i := 0; i < 2; i++ {
+		_, err := q.InsertTrip(ctx, InsertTripParams{
+			Destination: "Florianópolis",
+			OwnerEmail:  "owner@example.com",
+			OwnerName:   "Dono",
+			StartsAt:    testTime(-10),
+			EndsAt:      testTime(-5),
+		})
+		if err != nil {
+			t.Fatalf("failed to insert trip: %v", err)
+		}
+	}
+	if _, err := q.ArchiveTripsEndedBefore(ctx, testTime(0)); err != nil {
+		t.Fatalf("failed to archive trips: %v", err)
+	}
+	if _, err := pool.Exec(ctx, "ANALYZE trips"); err != nil {
+		t.Fatalf("failed to analyze trips: %v", err)
+	}
+
+	for archived, want := range map[bool]int64{false: 3, true: 2} {
+		estimate, err := q.EstimateTripsCount(ctx, archived)
+		if err != nil {
+			t.Fatalf("EstimateTripsCount: %v", err)
+		}
+		if estimate != want {
+			t.Errorf("EstimateTripsCount(archived = %v) = %d, want %d", archived, estimate, want)
+		}
+	}
+}
+
+func TestGetOwnerConfirmationCounts(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	first := insertTestTrip(t, q, "owner@example.com")
+	second := insertTestTrip(t, q, "Owner@Example.com")
+	other := insertTestTrip(t, q, "other@example.com")
+
+	confirm := func(tripID uuid.UUID, email string) uuid.UUID {
+		t.Helper()
+		id := inviteTestParticipant(t, q, tripID, email)
+		if err := q.ConfirmParticipant(ctx, id); err != nil {
+			t.Fatalf("failed to confirm participant: %v", err)
+		}
+		return id
+	}
+	confirm(first, "ana@example.com")
+	confirm(first, "bia@example.com")
+	inviteTestParticipant(t, q, first, "caio@example.com")
+	inviteTestParticipant(t, q, second, "duda@example.com")
+	removed := confirm(second, "edu@example.com")
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: removed, TripID: second}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+	confirm(other, "fabi@example.com")
+
+	counts, err := q.GetOwnerConfirmationCounts(ctx, "OWNER@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerConfirmationCounts: %v", err)
+	}
+	if want := (GetOwnerConfirmationCountsRow{Invited: 4, Confirmed: 2}); counts != want {
+		t.Errorf("counts = %+v, want %+v", counts, want)
+	}
+
+	counts, err = q.GetOwnerConfirmationCounts(ctx, "nobody@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerConfirmationCounts: %v", err)
+	}
+	if counts != (GetOwnerConfirmationCountsRow{}) {
+		t.Errorf("counts without trips = %+v, want zero", counts)
+	}
+}
+
+func TestUpdateTripPlace(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTrip: %v", err)
+	}
+	if trip.PlaceName.Valid || trip.Latitude.Valid || trip.Longitude.Valid {
+		t.Fatalf("new trip has a place: %+v", trip)
+	}
+
+	err = q.UpdateTripPlace(ctx, UpdateTripPlaceParams{
+		ID:        tripID,
+		PlaceName: pgtype.Text{Valid: true, String: "Florianópolis, Santa Catarina, Brasil"},
+		Latitude:  pgtype.Float8{Valid: true, Float64: -27.5954},
+		Longitude: pgtype.Float8{Valid: true, Float64: -48.548},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTripPlace: %v", err)
+	}
+
+	trip, err = q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("GetTrip: %v", err)
+	}
+	if trip.PlaceName.String != "Florianópolis, Santa Catarina, Brasil" || trip.Latitude.Float64 != -27.5954 || trip.Longitude.Float64 != -48.548 {
+		t.Errorf("trip place = %v (%v, %v), want the stored place", trip.PlaceName, trip.Latitude, trip.Longitude)
+	}
+	if trip.Destination != "Florianópolis" {
+		t.Errorf("destination = %q, want it unchanged", trip.Destination)
+	}
+}
+
+func TestGetTripActivitiesCreatedSince(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	otherTripID := insertTestTrip(t, q, "owner@example.com")
+	base := time.Date(2030, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	created := func(tripID uuid.UUID, title string, at time.Time) uuid.UUID {
+		t.Helper()
+		id := createTestActivity(t, q, tripID, title, 11)
+		if _, err := pool.Exec(ctx, "UPDATE activities SET created_at = $2 WHERE id = $1", id, at); err != nil {
+			t.Fatalf("failed to set created_at: %v", err)
+		}
+		return id
+	}
+	created(tripID, "Praia", base.Add(-time.Hour))
+	created(tripID, "Museu", base)
+	boat := created(tripID, "Passeio de barco", base.Add(time.Minute))
+	dinner := created(tripID, "Jantar", base.Add(2*time.Hour))
+	created(otherTripID, "Trilha", base.Add(3*time.Hour))
+
+	activities, err := q.GetTripActivitiesCreatedSince(ctx, GetTripActivitiesCreatedSinceParams{
+		TripID: tripID,
+		Since:  pgtype.Timestamp{Valid: true, Time: base},
+	})
+	if err != nil {
+		t.Fatalf("GetTripActivitiesCreatedSince: %v", err)
+	}
+	ids := make([]uuid.UUID, len(activities))
+	for i, a := range activities {
+		ids[i] = a.ID
+	}
+	if !slices.Equal(ids, []uuid.UUID{dinner, boat}) {
+		t.Errorf("activities = %v, want %s then %s", ids, dinner, boat)
+	}
+}
+
+func TestArchiveTripsEndedBefore(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	insert := func(destination string, endsIn int) uuid.UUID {
+		t.Helper()
+		id, err := q.InsertTrip(ctx, InsertTripParams{
+			Destination: destination,
+			OwnerEmail:  "owner@example.com",
+			OwnerName:   "Dono",
+			StartsAt:    testTime(endsIn - 5),
+			EndsAt:      testTime(endsIn),
+		})
+		if err != nil {
+			t.Fatalf("failed to insert trip: %v", err)
+		}
+		return id
+	}
+	old := insert("Recife", -40)
+	recent := insert("Salvador", -20)
+	upcoming := insert("Florianópolis", 15)
+
+	archived, err := q.ArchiveTripsEndedBefore(ctx, testTime(-30))
+	if err != nil {
+		t.Fatalf("ArchiveTripsEndedBefore: %v", err)
+	}
+	if archived != 1 {
+		t.Errorf("archived %d trips, want 1", archived)
+	}
+	// Archived trips are not archived again.
+	if archived, err := q.ArchiveTripsEndedBefore(ctx, testTime(-30)); err != nil || archived != 0 {
+		t.Errorf("archiving again = %d, %v, want 0", archived, err)
+	}
+
+	trip, err := q.GetTrip(ctx, old)
+	if err != nil {
+		t.Fatalf("GetTrip of an archived trip: %v", err)
+	}
+	if trip.Status != "archived" {
+		t.Errorf("old trip status = %s, want archived", trip.Status)
+	}
+
+	search := func(archived bool) []uuid.UUID {
+		t.Helper()
+		trips, err := q.SearchTrips(ctx, SearchTripsParams{Archived: archived, Sort: "starts_at", Limit: 10})
+		if err != nil {
+			t.Fatalf("SearchTrips: %v", err)
+		}
+		ids := make([]uuid.UUID, len(trips))
+		for i, trip := range trips {
+			ids[i] = trip.ID
+		}
+		return ids
+	}
+	if got := search(false); !slices.Equal(got, []uuid.UUID{recent, upcoming}) {
+		t.Errorf("active trips = %v, want %s and %s", got, recent, upcoming)
+	}
+	if got := search(true); !slices.Equal(got, []uuid.UUID{old}) {
+		t.Errorf("archived trips = %v, want %s", got, old)
+	}
+
+	active, err := q.GetOwnerActiveTrips(ctx, "owner@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerActiveTrips: %v", err)
+	}
+	if len(active) != 2 || active[0].ID != recent || active[1].ID != upcoming {
+		t.Errorf("owner active trips = %+v, want %s and %s", active, recent, upcoming)
+	}
+	all, err := q.GetOwnerTrips(ctx, "owner@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerTrips: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("owner trips = %d, want all 3", len(all))
+	}
+
+	destinations, err := q.GetOwnerDestinations(ctx, "owner@example.com")
+	if err != nil {
+		t.Fatalf("GetOwnerDestinations: %v", err)
+	}
+	if !slices.Equal(destinations, []string{"Florianópolis", "Salvador"}) {
+		t.Errorf("owner destinations = %q, want Florianópolis and Salvador", destinations)
+	}
+}
+
+func TestOutboxEmails(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	for _, recipient := range []string{"ana@example.com", "bia@example.com"} {
+		err := q.InsertOutboxEmail(ctx, InsertOutboxEmailParams{Recipient: recipient, Message: []byte("Subject: Convite\r\n")})
+		if err != nil {
+			t.Fatalf("failed to queue email: %v", err)
+		}
+	}
+
+	emails, err := q.GetOutboxEmails(ctx)
+	if err != nil {
+		t.Fatalf("GetOutboxEmails: %v", err)
+	}
+	if len(emails) != 2 || emails[0].Recipient != "ana@example.com" || emails[1].Recipient != "bia@example.com" {
+		t.Fatalf("outbox = %+v, want ana's email then bia's", emails)
+	}
+
+	if err := q.DeleteOutboxEmail(ctx, emails[0].ID); err != nil {
+		t.Fatalf("DeleteOutboxEmail: %v", err)
+	}
+	emails, err = q.GetOutboxEmails(ctx)
+	if err != nil {
+		t.Fatalf("GetOutboxEmails: %v", err)
+	}
+	if len(emails) != 1 || emails[0].Recipient != "bia@example.com" {
+		t.Errorf("outbox after deleting = %+v, want only bia's email", emails)
+	}
+}
@@ -2,14 +2,177 @@ package pgstore
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"journey/internal/api/spec"
+	"time"
 )
 
-func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
+// ErrTripAlreadyConfirmed is returned by ConfirmTripOnce when the trip was
+// already confirmed.
+var ErrTripAlreadyConfirmed = errors.New("pgstore: trip already confirmed")
+
+// ErrTripAlreadyFinalized is returned by FinalizeTrip when the trip and all of
+// its participants were already confirmed.
+var ErrTripAlreadyFinalized = errors.New("pgstore: trip already finalized")
+
+// ErrParticipantAlreadyInvited is returned when inviting an email that is
+// already invited to the trip, ignoring case.
+var ErrParticipantAlreadyInvited = errors.New("pgstore: participant already invited")
+
+// participantInviteError translates the unique violation of inserting a
+// participant whose email is already on the trip into
+// ErrParticipantAlreadyInvited.
+func participantInviteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "participants_trip_id_email_key" {
+		return ErrParticipantAlreadyInvited
+	}
+	return err
+}
+
+// ConfirmTripOnce confirms a trip while holding a lock on its row, so that of
+// concurrent confirmations only the first succeeds and the others get
+// ErrTripAlreadyConfirmed.
+func (q *Queries) ConfirmTripOnce(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for ConfirmTripOnce: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock trip for ConfirmTripOnce: %w", err)
+	}
+
+	if trip.IsConfirmed {
+		return ErrTripAlreadyConfirmed
+	}
+
+	if err := qtx.ConfirmTrip(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to confirm trip for ConfirmTripOnce: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ConfirmTripOnce: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteTrip deletes a trip together with its links, activities and
+// participants. It returns pgx.ErrNoRows, wrapped, if the trip does not exist,
+// so deleting a trip twice reports it as not found.
+func (q *Queries) DeleteTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for DeleteTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if _, err := qtx.GetTripForUpdate(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to lock trip for DeleteTrip: %w", err)
+	}
+
+	if err := qtx.DeleteTripLinks(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete links for DeleteTrip: %w", err)
+	}
+	if err := qtx.DeleteTripActivities(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete activities for DeleteTrip: %w", err)
+	}
+	if err := qtx.DeleteTripParticipants(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete participants for DeleteTrip: %w", err)
+	}
+	if err := qtx.DeleteTripByID(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trip for DeleteTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for DeleteTrip: %w", err)
+	}
+
+	return nil
+}
+
+// EraseOwner removes every trip owned by email, along with everything the
+// trips hold, and anonymizes the participant rows and email log entries left
+// with email on other trips. Emails to email still in the outbox are dropped.
+func (q *Queries) EraseOwner(ctx context.Context, pool *pgxpool.Pool, email string) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for EraseOwner: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	if _, err := qtx.DeleteOwnerTrips(ctx, email); err != nil {
+		return fmt.Errorf("pgstore: failed to delete trips for EraseOwner: %w", err)
+	}
+	if _, err := qtx.AnonymizeParticipantsByEmail(ctx, email); err != nil {
+		return fmt.Errorf("pgstore: failed to anonymize participants for EraseOwner: %w", err)
+	}
+	if err := qtx.AnonymizeEmailLogRecipient(ctx, email); err != nil {
+		return fmt.Errorf("pgstore: failed to anonymize email log for EraseOwner: %w", err)
+	}
+	if err := qtx.DeleteOutboxEmailsByRecipient(ctx, email); err != nil {
+		return fmt.Errorf("pgstore: failed to delete outbox emails for EraseOwner: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for EraseOwner: %w", err)
+	}
+
+	return nil
+}
+
+// FinalizeTrip confirms a trip together with all of its participants.
+func (q *Queries) FinalizeTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for FinalizeTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	trip, err := qtx.GetTripForUpdate(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to lock trip for FinalizeTrip: %w", err)
+	}
+
+	if err := qtx.ConfirmTrip(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to confirm trip for FinalizeTrip: %w", err)
+	}
+
+	confirmed, err := qtx.ConfirmTripParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to confirm participants for FinalizeTrip: %w", err)
+	}
+
+	if trip.IsConfirmed && confirmed == 0 {
+		return ErrTripAlreadyFinalized
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for FinalizeTrip: %w", err)
+	}
+
+	return nil
+}
+
+func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest, inviteExpiresAt time.Time) (uuid.UUID, error) {
 	tx, err := pool.Begin(ctx)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("pgstore: failed to begin trx for CreateTrip: %w", err)
@@ -33,13 +196,14 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
 	for i, email := range params.EmailsToInvite {
 		participants[i] = InviteParticipantsToTripParams{
-			TripID: tripID,
-			Email:  string(email),
+			TripID:          tripID,
+			Email:           string(email),
+			InviteExpiresAt: pgtype.Timestamp{Valid: true, Time: inviteExpiresAt},
 		}
 	}
 
 	if _, err := qtx.InviteParticipantsToTrip(ctx, participants); err != nil {
-		return uuid.Nil, fmt.Errorf("pgstore: failed to insert participants to trip for CreateTrip: %w", err)
+		return uuid.Nil, fmt.Errorf("pgstore: failed to insert participants to trip for CreateTrip: %w", participantInviteError(err))
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -48,3 +212,310 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 
 	return tripID, nil
 }
+
+// TripSnapshotData is the full state of a trip as stored in trip_snapshots.data.
+type TripSnapshotData struct {
+	Trip         Trip          `json:"trip"`
+	Participants []Participant `json:"participants"`
+	Activities   []Activity    `json:"activities"`
+	Links        []Link        `json:"links"`
+	// ActivityParticipants are the assignments of participants to activities.
+	ActivityParticipants []ActivityParticipant `json:"activity_participants,omitempty"`
+}
+
+func (q *Queries) CreateTripSnapshot(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) (uuid.UUID, error) {
+	tx, err := pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadWrite})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to begin trx for CreateTripSnapshot: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	var data TripSnapshotData
+	if data.Trip, err = qtx.GetTrip(ctx, tripID); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to get trip for CreateTripSnapshot: %w", err)
+	}
+	if data.Participants, err = qtx.GetParticipants(ctx, tripID); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to get participants for CreateTripSnapshot: %w", err)
+	}
+	if data.Activities, err = qtx.GetTripActivities(ctx, tripID); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to get activities for CreateTripSnapshot: %w", err)
+	}
+	if data.Links, err = qtx.GetTripLinks(ctx, tripID); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to get links for CreateTripSnapshot: %w", err)
+	}
+	if data.ActivityParticipants, err = qtx.GetTripActivityAssignments(ctx, tripID); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to get activity participants for CreateTripSnapshot: %w", err)
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to marshal snapshot for CreateTripSnapshot: %w", err)
+	}
+
+	snapshotID, err := qtx.InsertTripSnapshot(ctx, InsertTripSnapshotParams{TripID: tripID, Data: raw})
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to insert snapshot for CreateTripSnapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, fmt.Errorf("pgstore: failed to commit tx for CreateTripSnapshot: %w", err)
+	}
+
+	return snapshotID, nil
+}
+
+// RestoreTripSnapshot puts the trip back in the state recorded by snapshot,
+// replacing its current links and the assignments of participants to its
+// activities. Its participants and activities are restored
+// in place, keeping what hangs off them: participants added since are
+// removed, restorable as after DeleteParticipant, and activities added since
+// are deleted.
+func (q *Queries) RestoreTripSnapshot(ctx context.Context, pool *pgxpool.Pool, snapshot TripSnapshot) error {
+	var data TripSnapshotData
+	if err := json.Unmarshal(snapshot.Data, &data); err != nil {
+		return fmt.Errorf("pgstore: failed to unmarshal snapshot for RestoreTripSnapshot: %w", err)
+	}
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin trx for RestoreTripSnapshot: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	err = qtx.UpdateTrip(ctx, UpdateTripParams{
+		Destination: data.Trip.Destination,
+		EndsAt:      data.Trip.EndsAt,
+		StartsAt:    data.Trip.StartsAt,
+		IsConfirmed: data.Trip.IsConfirmed,
+		ID:          snapshot.TripID,
+	})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to update trip for RestoreTripSnapshot: %w", err)
+	}
+
+	if err := qtx.DeleteTripLinks(ctx, snapshot.TripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete links for RestoreTripSnapshot: %w", err)
+	}
+
+	// Participants missing from the snapshot are removed the way
+	// DeleteParticipant removes them, so they can still be restored, and
+	// before the recorded ones come back so that their emails are free again.
+	participantIDs := make([]uuid.UUID, len(data.Participants))
+	for i, p := range data.Participants {
+		participantIDs[i] = p.ID
+	}
+	err = qtx.DeleteTripParticipantsExcept(ctx, DeleteTripParticipantsExceptParams{TripID: snapshot.TripID, Ids: participantIDs})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to delete participants for RestoreTripSnapshot: %w", err)
+	}
+	for _, p := range data.Participants {
+		// Snapshots taken before participants had a creation date carry none.
+		createdAt := p.CreatedAt
+		if !createdAt.Valid {
+			createdAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+		}
+		err := qtx.RestoreTripParticipant(ctx, RestoreTripParticipantParams{
+			ID:              p.ID,
+			TripID:          snapshot.TripID,
+			Email:           p.Email,
+			IsConfirmed:     p.IsConfirmed,
+			InviteExpiresAt: p.InviteExpiresAt,
+			IsOrganizer:     p.IsOrganizer,
+			GroupName:       p.GroupName,
+			Name:            p.Name,
+			CreatedAt:       createdAt,
+			ConfirmedAt:     p.ConfirmedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to restore participant for RestoreTripSnapshot: %w", err)
+		}
+	}
+
+	// Activities are updated in place rather than deleted and inserted back,
+	// so that what hangs off the ones kept, like their attachments, survives
+	// the restore.
+	activityIDs := make([]uuid.UUID, len(data.Activities))
+	for i, a := range data.Activities {
+		activityIDs[i] = a.ID
+	}
+	err = qtx.DeleteTripActivitiesExcept(ctx, DeleteTripActivitiesExceptParams{TripID: snapshot.TripID, Ids: activityIDs})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to delete activities for RestoreTripSnapshot: %w", err)
+	}
+	for _, a := range data.Activities {
+		// Snapshots taken before activities had a creation date carry none.
+		createdAt := a.CreatedAt
+		if !createdAt.Valid {
+			createdAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+		}
+		err := qtx.RestoreActivity(ctx, RestoreActivityParams{
+			ID:                  a.ID,
+			TripID:              snapshot.TripID,
+			Title:               a.Title,
+			OccursAt:            a.OccursAt,
+			RemindBeforeMinutes: a.RemindBeforeMinutes,
+			ReminderSentAt:      a.ReminderSentAt,
+			DurationMinutes:     a.DurationMinutes,
+			CreatedAt:           createdAt,
+		})
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to restore activity for RestoreTripSnapshot: %w", err)
+		}
+	}
+
+	links := make([]RestoreLinksParams, len(data.Links))
+	for i, l := range data.Links {
+		// Snapshots taken before links had a creation date carry none.
+		createdAt := l.CreatedAt
+		if !createdAt.Valid {
+			createdAt = pgtype.Timestamp{Valid: true, Time: time.Now().UTC()}
+		}
+		links[i] = RestoreLinksParams{
+			ID:         l.ID,
+			TripID:     snapshot.TripID,
+			Title:      l.Title,
+			Url:        l.Url,
+			ActivityID: l.ActivityID,
+			CreatedAt:  createdAt,
+		}
+	}
+	if _, err := qtx.RestoreLinks(ctx, links); err != nil {
+		return fmt.Errorf("pgstore: failed to restore links for RestoreTripSnapshot: %w", err)
+	}
+
+	if err := qtx.DeleteTripActivityParticipants(ctx, snapshot.TripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete activity participants for RestoreTripSnapshot: %w", err)
+	}
+	assignments := make([]RestoreActivityParticipantsParams, len(data.ActivityParticipants))
+	for i, ap := range data.ActivityParticipants {
+		assignments[i] = RestoreActivityParticipantsParams{
+			ActivityID:    ap.ActivityID,
+			ParticipantID: ap.ParticipantID,
+			CreatedAt:     ap.CreatedAt,
+		}
+	}
+	if _, err := qtx.RestoreActivityParticipants(ctx, assignments); err != nil {
+		return fmt.Errorf("pgstore: failed to restore activity participants for RestoreTripSnapshot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for RestoreTripSnapshot: %w", err)
+	}
+
+	return nil
+}
+
+// CreateActivities inserts every activity or none of them, sending all the
+// inserts to the database in a single round trip.
+func (q *Queries) CreateActivities(ctx context.Context, pool *pgxpool.Pool, params []CreateActivityParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin trx for CreateActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	batch := &pgx.Batch{}
+	for _, p := range params {
+		batch.Queue(createActivity, p.TripID, p.Title, p.OccursAt, p.RemindBeforeMinutes, p.DurationMinutes)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	activityIDs := make([]uuid.UUID, len(params))
+	for i := range params {
+		if err := results.QueryRow().Scan(&activityIDs[i]); err != nil {
+			_ = results.Close()
+			return nil, fmt.Errorf("pgstore: failed to insert activity for CreateActivities: %w", err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to insert activities for CreateActivities: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for CreateActivities: %w", err)
+	}
+
+	return activityIDs, nil
+}
+
+// InviteParticipant invites someone to a trip, returning
+// ErrParticipantAlreadyInvited if their email already is.
+func (q *Queries) InviteParticipant(ctx context.Context, arg InviteParticipantToTripParams) (uuid.UUID, error) {
+	participantID, err := q.InviteParticipantToTrip(ctx, arg)
+	if err != nil {
+		return uuid.Nil, participantInviteError(err)
+	}
+	return participantID, nil
+}
+
+// RestoreRemovedParticipant undoes the removal of a participant, returning
+// ErrParticipantAlreadyInvited if their email was invited again since.
+func (q *Queries) RestoreRemovedParticipant(ctx context.Context, arg RestoreParticipantParams) (int64, error) {
+	restored, err := q.RestoreParticipant(ctx, arg)
+	if err != nil {
+		return 0, participantInviteError(err)
+	}
+	return restored, nil
+}
+
+// InviteParticipants inserts every participant or none of them, wrapping
+// ErrParticipantAlreadyInvited if one of their emails is already invited.
+func (q *Queries) InviteParticipants(ctx context.Context, pool *pgxpool.Pool, params []InviteParticipantToTripParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin trx for InviteParticipants: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	participantIDs := make([]uuid.UUID, len(params))
+	for i, p := range params {
+		if participantIDs[i], err = qtx.InviteParticipantToTrip(ctx, p); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to insert participant for InviteParticipants: %w", participantInviteError(err))
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for InviteParticipants: %w", err)
+	}
+
+	return participantIDs, nil
+}
+
+// CreateActivityWithLink inserts an activity and a link attached to it,
+// so that neither is kept if the other fails.
+func (q *Queries) CreateActivityWithLink(ctx context.Context, pool *pgxpool.Pool, activity CreateActivityParams, link CreateTripLinkParams) (uuid.UUID, uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("pgstore: failed to begin trx for CreateActivityWithLink: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.WithTx(tx)
+
+	activityID, err := qtx.CreateActivity(ctx, activity)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("pgstore: failed to insert activity for CreateActivityWithLink: %w", err)
+	}
+
+	linkID, err := qtx.CreateActivityLink(ctx, CreateActivityLinkParams{
+		TripID:     link.TripID,
+		Title:      link.Title,
+		Url:        link.Url,
+		ActivityID: pgtype.UUID{Bytes: activityID, Valid: true},
+	})
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("pgstore: failed to insert link for CreateActivityWithLink: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("pgstore: failed to commit tx for CreateActivityWithLink: %w", err)
+	}
+
+	return activityID, linkID, nil
+}
@@ -0,0 +1,322 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConfirmTripOnce(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+
+	const confirms = 10
+	errs := make([]error, confirms)
+	var wg sync.WaitGroup
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = q.ConfirmTripOnce(ctx, pool, tripID)
+		}(i)
+	}
+	wg.Wait()
+
+	var confirmed int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			confirmed++
+		case !errors.Is(err, ErrTripAlreadyConfirmed):
+			t.Errorf("ConfirmTripOnce: %v", err)
+		}
+	}
+	if confirmed != 1 {
+		t.Errorf("%d confirmations went through, want exactly 1", confirmed)
+	}
+
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	if !trip.IsConfirmed {
+		t.Error("trip is not confirmed")
+	}
+}
+
+func TestRestoreTripSnapshot(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	kept := inviteTestParticipant(t, q, tripID, "kept@example.com")
+	activityID := createTestActivity(t, q, tripID, "Passeio de barco", 11)
+	linkID, err := q.CreateTripLink(ctx, CreateTripLinkParams{TripID: tripID, Title: "Hotel", Url: "https://example.com/hotel"})
+	if err != nil {
+		t.Fatalf("failed to create link: %v", err)
+	}
+	err = q.AssignActivityParticipant(ctx, AssignActivityParticipantParams{ActivityID: activityID, ParticipantID: kept})
+	if err != nil {
+		t.Fatalf("failed to assign participant: %v", err)
+	}
+
+	snapshotID, err := q.CreateTripSnapshot(ctx, pool, tripID)
+	if err != nil {
+		t.Fatalf("CreateTripSnapshot: %v", err)
+	}
+
+	// Change everything the snapshot recorded.
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	err = q.UpdateTrip(ctx, UpdateTripParams{
+		Destination: "Salvador",
+		StartsAt:    trip.StartsAt,
+		EndsAt:      trip.EndsAt,
+		IsConfirmed: true,
+		ID:          tripID,
+	})
+	if err != nil {
+		t.Fatalf("failed to update trip: %v", err)
+	}
+	if _, err := q.UpdateActivity(ctx, UpdateActivityParams{Title: "Mergulho", OccursAt: testTime(12), ID: activityID, TripID: tripID}); err != nil {
+		t.Fatalf("failed to update activity: %v", err)
+	}
+	if _, err := q.DeleteLink(ctx, DeleteLinkParams{ID: linkID, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete link: %v", err)
+	}
+	if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: kept, TripID: tripID}); err != nil {
+		t.Fatalf("failed to delete participant: %v", err)
+	}
+	added := inviteTestParticipant(t, q, tripID, "added@example.com")
+	createTestActivity(t, q, tripID, "Jantar", 13)
+
+	snapshot, err := q.GetTripSnapshot(ctx, snapshotID)
+	if err != nil {
+		t.Fatalf("failed to get snapshot: %v", err)
+	}
+	if err := q.RestoreTripSnapshot(ctx, pool, snapshot); err != nil {
+		t.Fatalf("RestoreTripSnapshot: %v", err)
+	}
+
+	restored, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	if restored.Destination != "Florianópolis" || restored.IsConfirmed {
+		t.Errorf("trip = %q confirmed=%v, want Florianópolis unconfirmed", restored.Destination, restored.IsConfirmed)
+	}
+
+	if got := participantIDs(t, q, tripID); !sameIDs(got, []uuid.UUID{kept}) {
+		t.Errorf("participants = %v, want only %s", got, kept)
+	}
+	// The participant added since is removed, but can still be restored.
+	var removed bool
+	if err := pool.QueryRow(ctx, "SELECT deleted_at IS NOT NULL FROM participants WHERE id = $1", added).Scan(&removed); err != nil {
+		t.Fatalf("participant added after the snapshot was erased: %v", err)
+	}
+	if !removed {
+		t.Error("participant added after the snapshot is still live")
+	}
+
+	activities, err := q.GetTripActivities(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get activities: %v", err)
+	}
+	if len(activities) != 1 || activities[0].ID != activityID || activities[0].Title != "Passeio de barco" {
+		t.Errorf("activities = %+v, want only the recorded one", activities)
+	}
+
+	links, err := q.GetTripLinks(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get links: %v", err)
+	}
+	if len(links) != 1 || links[0].ID != linkID {
+		t.Errorf("links = %+v, want only %s", links, linkID)
+	}
+
+	assignments, err := q.GetTripActivityAssignments(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get assignments: %v", err)
+	}
+	if len(assignments) != 1 || assignments[0].ActivityID != activityID || assignments[0].ParticipantID != kept {
+		t.Errorf("assignments = %+v, want %s on %s", assignments, kept, activityID)
+	}
+}
+
+func TestCreateActivityWithLink(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+
+	activityID, linkID, err := q.CreateActivityWithLink(ctx, pool,
+		CreateActivityParams{TripID: tripID, Title: "Show", OccursAt: testTime(11)},
+		CreateTripLinkParams{TripID: tripID, Title: "Ingresso", Url: "https://example.com/ingresso"},
+	)
+	if err != nil {
+		t.Fatalf("CreateActivityWithLink: %v", err)
+	}
+
+	links, err := q.GetTripLinks(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get links: %v", err)
+	}
+	if len(links) != 1 || links[0].ID != linkID || links[0].ActivityID.Bytes != activityID {
+		t.Errorf("links = %+v, want %s attached to %s", links, linkID, activityID)
+	}
+
+	// A link the database refuses takes its activity down with it.
+	_, _, err = q.CreateActivityWithLink(ctx, pool,
+		CreateActivityParams{TripID: tripID, Title: "Museu", OccursAt: testTime(12)},
+		CreateTripLinkParams{TripID: uuid.New(), Title: "Ingresso", Url: "https://example.com/museu"},
+	)
+	if err == nil {
+		t.Fatal("CreateActivityWithLink succeeded with a link to a missing trip")
+	}
+
+	activities, err := q.GetTripActivities(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get activities: %v", err)
+	}
+	if len(activities) != 1 || activities[0].ID != activityID {
+		t.Errorf("activities = %+v, want only %s", activities, activityID)
+	}
+}
+
+func TestRestoreRemovedParticipant(t *testing.T) {
+	_, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	ana := inviteTestParticipant(t, q, tripID, "ana@example.com")
+	bia := inviteTestParticipant(t, q, tripID, "bia@example.com")
+	for _, id := range []uuid.UUID{ana, bia} {
+		if _, err := q.DeleteParticipant(ctx, DeleteParticipantParams{ID: id, TripID: tripID}); err != nil {
+			t.Fatalf("failed to delete participant: %v", err)
+		}
+	}
+	restore := func(id uuid.UUID, deletedAfter time.Time) (int64, error) {
+		return q.RestoreRemovedParticipant(ctx, RestoreParticipantParams{
+			ID:           id,
+			TripID:       tripID,
+			DeletedAfter: pgtype.Timestamp{Valid: true, Time: deletedAfter},
+		})
+	}
+
+	// Removed before the window started.
+	if restored, err := restore(ana, time.Now().UTC().Add(time.Hour)); err != nil || restored != 0 {
+		t.Errorf("restoring past the window = %d, %v, want 0", restored, err)
+	}
+
+	if restored, err := restore(ana, time.Now().UTC().Add(-time.Hour)); err != nil || restored != 1 {
+		t.Fatalf("restoring = %d, %v, want 1", restored, err)
+	}
+	if got := participantIDs(t, q, tripID); !sameIDs(got, []uuid.UUID{ana}) {
+		t.Errorf("participants = %v, want only %s", got, ana)
+	}
+
+	inviteTestParticipant(t, q, tripID, "bia@example.com")
+	if _, err := restore(bia, time.Now().UTC().Add(-time.Hour)); !errors.Is(err, ErrParticipantAlreadyInvited) {
+		t.Errorf("restoring a reinvited participant: err = %v, want %v", err, ErrParticipantAlreadyInvited)
+	}
+}
+
+func TestFinalizeTrip(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	tripID := insertTestTrip(t, q, "owner@example.com")
+	inviteTestParticipant(t, q, tripID, "ana@example.com")
+	inviteTestParticipant(t, q, tripID, "bia@example.com")
+	otherTripID := insertTestTrip(t, q, "owner@example.com")
+	other := inviteTestParticipant(t, q, otherTripID, "caio@example.com")
+
+	if err := q.FinalizeTrip(ctx, pool, tripID); err != nil {
+		t.Fatalf("FinalizeTrip: %v", err)
+	}
+
+	trip, err := q.GetTrip(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get trip: %v", err)
+	}
+	if !trip.IsConfirmed {
+		t.Error("trip is not confirmed")
+	}
+	participants, err := q.GetParticipants(ctx, tripID)
+	if err != nil {
+		t.Fatalf("failed to get participants: %v", err)
+	}
+	for _, p := range participants {
+		if !p.IsConfirmed {
+			t.Errorf("%s is not confirmed", p.Email)
+		}
+	}
+	if p, err := q.GetParticipant(ctx, other); err != nil || p.IsConfirmed {
+		t.Errorf("participant of another trip confirmed = %v, %v, want false", p.IsConfirmed, err)
+	}
+
+	if err := q.FinalizeTrip(ctx, pool, tripID); !errors.Is(err, ErrTripAlreadyFinalized) {
+		t.Errorf("finalizing again: err = %v, want %v", err, ErrTripAlreadyFinalized)
+	}
+}
+
+func TestEraseOwner(t *testing.T) {
+	pool, q := newTestStore(t)
+	ctx := context.Background()
+
+	ownTripID := insertTestTrip(t, q, "Ana@Example.com")
+	inviteTestParticipant(t, q, ownTripID, "bia@example.com")
+	otherTripID := insertTestTrip(t, q, "caio@example.com")
+	asParticipant := inviteTestParticipant(t, q, otherTripID, "ana@example.com")
+	kept := inviteTestParticipant(t, q, otherTripID, "bia@example.com")
+	err := q.InsertEmailLog(ctx, InsertEmailLogParams{
+		TripID:        otherTripID,
+		ParticipantID: pgtype.UUID{Valid: true, Bytes: asParticipant},
+		Recipient:     "ana@example.com",
+		Kind:          "invite",
+		Status:        "sent",
+	})
+	if err != nil {
+		t.Fatalf("failed to log email: %v", err)
+	}
+
+	if err := q.EraseOwner(ctx, pool, "ana@example.com"); err != nil {
+		t.Fatalf("EraseOwner: %v", err)
+	}
+
+	if _, err := q.GetTrip(ctx, ownTripID); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("owner trip: err = %v, want %v", err, pgx.ErrNoRows)
+	}
+	if _, err := q.GetTrip(ctx, otherTripID); err != nil {
+		t.Errorf("trip of someone else: %v", err)
+	}
+
+	participant, err := q.GetParticipant(ctx, asParticipant)
+	if err != nil {
+		t.Fatalf("failed to get participant: %v", err)
+	}
+	if !strings.HasPrefix(participant.Email, "deleted-") || !strings.HasSuffix(participant.Email, "@invalid") || participant.Name.Valid {
+		t.Errorf("participant = %q (%v), want it anonymized", participant.Email, participant.Name)
+	}
+	if participant, err := q.GetParticipant(ctx, kept); err != nil || participant.Email != "bia@example.com" {
+		t.Errorf("other participant = %q, %v, want it untouched", participant.Email, err)
+	}
+
+	var logged int
+	if err := pool.QueryRow(ctx, "SELECT count(*) FROM email_log WHERE lower(recipient) = 'ana@example.com'").Scan(&logged); err != nil {
+		t.Fatalf("failed to count email log: %v", err)
+	}
+	if logged != 0 {
+		t.Errorf("email log still has %d emails to the owner", logged)
+	}
+}
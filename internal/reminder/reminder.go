@@ -0,0 +1,94 @@
+// Package reminder emails trip participants ahead of activities that asked to
+// be reminded.
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"time"
+)
+
+type store interface {
+	GetDueActivityReminders(context.Context, pgtype.Timestamp) ([]pgstore.Activity, error)
+	MarkActivityReminderSent(context.Context, pgstore.MarkActivityReminderSentParams) error
+}
+
+type mailer interface {
+	SendActivityReminderEmail(uuid.UUID) error
+}
+
+// Scheduler periodically looks for activities whose reminder is due and
+// emails their participants. An activity is marked once its reminder is sent,
+// so it is never reminded twice.
+type Scheduler struct {
+	store    store
+	mailer   mailer
+	logger   *zap.Logger
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewScheduler(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer, interval time.Duration) Scheduler {
+	return Scheduler{
+		store:    pgstore.New(pool),
+		mailer:   mailer,
+		logger:   logger,
+		interval: interval,
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// Run sends due reminders every interval until ctx is done.
+func (s Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.SendDueReminders(ctx); err != nil {
+			s.logger.Error("failed to send activity reminders", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendDueReminders emails the participants of every activity whose reminder is
+// due at the scheduler's current time. A failed email is logged and retried on
+// the next run.
+func (s Scheduler) SendDueReminders(ctx context.Context) error {
+	now := s.now()
+	activities, err := s.store.GetDueActivityReminders(ctx, pgtype.Timestamp{Valid: true, Time: now})
+	if err != nil {
+		return fmt.Errorf("reminder: failed to get due reminders for SendDueReminders: %w", err)
+	}
+
+	for _, activity := range activities {
+		if err := s.mailer.SendActivityReminderEmail(activity.ID); err != nil {
+			s.logger.Error(
+				"failed to send activity reminder",
+				zap.Error(err),
+				zap.String("activity_id", activity.ID.String()),
+			)
+			continue
+		}
+
+		err := s.store.MarkActivityReminderSent(ctx, pgstore.MarkActivityReminderSentParams{
+			ReminderSentAt: pgtype.Timestamp{Valid: true, Time: now},
+			ID:             activity.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("reminder: failed to mark reminder sent for SendDueReminders: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"testing"
+	"time"
+)
+
+// fakeStore picks due reminders the way GetDueActivityReminders does.
+type fakeStore struct {
+	activities []pgstore.Activity
+}
+
+func (s *fakeStore) GetDueActivityReminders(_ context.Context, now pgtype.Timestamp) ([]pgstore.Activity, error) {
+	var due []pgstore.Activity
+	for _, a := range s.activities {
+		if !a.RemindBeforeMinutes.Valid || a.ReminderSentAt.Valid || !a.OccursAt.Time.After(now.Time) {
+			continue
+		}
+		lead := time.Duration(a.RemindBeforeMinutes.Int32) * time.Minute
+		if !a.OccursAt.Time.Add(-lead).After(now.Time) {
+			due = append(due, a)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeStore) MarkActivityReminderSent(_ context.Context, arg pgstore.MarkActivityReminderSentParams) error {
+	for i := range s.activities {
+		if s.activities[i].ID == arg.ID {
+			s.activities[i].ReminderSentAt = arg.ReminderSentAt
+		}
+	}
+	return nil
+}
+
+type fakeMailer struct {
+	sent  []uuid.UUID
+	fails map[uuid.UUID]bool
+}
+
+func (m *fakeMailer) SendActivityReminderEmail(id uuid.UUID) error {
+	if m.fails[id] {
+		return errors.New("smtp down")
+	}
+	m.sent = append(m.sent, id)
+	return nil
+}
+
+func TestSendDueReminders(t *testing.T) {
+	now := time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC)
+	activity := func(title string, occursIn time.Duration, remindBefore int32) pgstore.Activity {
+		a := pgstore.Activity{
+			ID:       uuid.New(),
+			Title:    title,
+			OccursAt: pgtype.Timestamp{Valid: true, Time: now.Add(occursIn)},
+		}
+		if remindBefore > 0 {
+			a.RemindBeforeMinutes = pgtype.Int4{Valid: true, Int32: remindBefore}
+		}
+		return a
+	}
+
+	dueSoon := activity("Passeio de barco", 30*time.Minute, 60)
+	dueEarly := activity("Voo", 20*time.Hour, 24*60)
+	notYet := activity("Jantar", 2*time.Hour, 60)
+	noReminder := activity("Praia", 10*time.Minute, 0)
+	past := activity("Café", -time.Minute, 60)
+	failing := activity("Museu", 15*time.Minute, 30)
+
+	s := &fakeStore{activities: []pgstore.Activity{dueSoon, dueEarly, notYet, noReminder, past, failing}}
+	m := &fakeMailer{fails: map[uuid.UUID]bool{failing.ID: true}}
+	scheduler := Scheduler{store: s, mailer: m, logger: zap.NewNop(), now: func() time.Time { return now }}
+
+	if err := scheduler.SendDueReminders(context.Background()); err != nil {
+		t.Fatalf("SendDueReminders: %v", err)
+	}
+
+	if len(m.sent) != 2 || m.sent[0] != dueSoon.ID || m.sent[1] != dueEarly.ID {
+		t.Errorf("reminded %v, want %s and %s", m.sent, dueSoon.ID, dueEarly.ID)
+	}
+	for _, a := range s.activities {
+		wantSent := a.ID == dueSoon.ID || a.ID == dueEarly.ID
+		if a.ReminderSentAt.Valid != wantSent {
+			t.Errorf("%s marked sent = %v, want %v", a.Title, a.ReminderSentAt.Valid, wantSent)
+		}
+	}
+
+	// Sent reminders are not sent again, and the failed one is retried.
+	delete(m.fails, failing.ID)
+	m.sent = nil
+	if err := scheduler.SendDueReminders(context.Background()); err != nil {
+		t.Fatalf("SendDueReminders: %v", err)
+	}
+	if len(m.sent) != 1 || m.sent[0] != failing.ID {
+		t.Errorf("second run reminded %v, want only %s", m.sent, failing.ID)
+	}
+}
@@ -0,0 +1,367 @@
+// Package webhook notifies the URLs subscribed to a trip of the events that
+// happen to it, retrying deliveries that fail.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+	"journey/internal/pgstore"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	EventTripConfirmed        = "trip.confirmed"
+	EventParticipantConfirmed = "participant.confirmed"
+	EventActivityCreated      = "activity.created"
+)
+
+const (
+	// EventHeader names the event a delivery is about.
+	EventHeader = "X-Journey-Event"
+	// SignatureHeader carries "sha256=" followed by Sign of the delivery body.
+	SignatureHeader = "X-Journey-Signature"
+)
+
+// Delivery statuses. A pending delivery is retried with backoff until it
+// succeeds or runs out of attempts, when it is dead-lettered.
+const (
+	deliveryPending   = "pending"
+	deliveryDelivered = "delivered"
+	deliveryDead      = "dead"
+)
+
+const (
+	// maxDeliveriesPerRun caps the deliveries attempted by DeliverDue.
+	maxDeliveriesPerRun = 100
+	baseRetryBackoff    = 30 * time.Second
+	maxRetryBackoff     = time.Hour
+)
+
+type store interface {
+	EnqueueWebhookDeliveries(context.Context, pgstore.EnqueueWebhookDeliveriesParams) (int64, error)
+	GetDueWebhookDeliveries(context.Context, pgstore.GetDueWebhookDeliveriesParams) ([]pgstore.GetDueWebhookDeliveriesRow, error)
+	MarkWebhookDeliveryDelivered(context.Context, pgstore.MarkWebhookDeliveryDeliveredParams) error
+	MarkWebhookDeliveryFailed(context.Context, pgstore.MarkWebhookDeliveryFailedParams) error
+	GetWebhookDelivery(context.Context, uuid.UUID) (pgstore.GetWebhookDeliveryRow, error)
+}
+
+// Dispatcher records a delivery for every subscription asking for an event and
+// periodically posts the deliveries that are due, retrying failed ones.
+type Dispatcher struct {
+	store       store
+	client      *http.Client
+	logger      *zap.Logger
+	interval    time.Duration
+	maxAttempts int32
+	now         func() time.Time
+}
+
+func NewDispatcher(pool *pgxpool.Pool, logger *zap.Logger, interval time.Duration, maxAttempts int) Dispatcher {
+	// Subscribers pick the URLs we post to, so deliveries must not be able to
+	// reach the network the app runs in. The check is made on the address
+	// actually dialed, which also covers hosts resolving, or redirecting, to
+	// private addresses.
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: dialPublicOnly}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Going through a proxy would hide the subscriber address from the check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return Dispatcher{
+		store:       pgstore.New(pool),
+		client:      &http.Client{Timeout: 10 * time.Second, Transport: transport},
+		logger:      logger,
+		interval:    interval,
+		maxAttempts: int32(maxAttempts),
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// payload is the JSON body of every delivery.
+type payload struct {
+	Event      string    `json:"event"`
+	TripID     string    `json:"trip_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// Dispatch queues event, with data, for every subscriber of the trip that asked
+// for it. It does not wait for the deliveries to be queued; failures are only
+// logged.
+func (d Dispatcher) Dispatch(tripID uuid.UUID, event string, data any) {
+	go func() {
+		if err := d.enqueue(context.Background(), tripID, event, data); err != nil {
+			d.logger.Error(
+				"failed to dispatch webhook event",
+				zap.Error(err),
+				zap.String("trip_id", tripID.String()),
+				zap.String("event", event),
+			)
+		}
+	}()
+}
+
+func (d Dispatcher) enqueue(ctx context.Context, tripID uuid.UUID, event string, data any) error {
+	body, err := json.Marshal(payload{
+		Event:      event,
+		TripID:     tripID.String(),
+		OccurredAt: d.now(),
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("webhook: failed to encode payload for Dispatch: %w", err)
+	}
+
+	_, err = d.store.EnqueueWebhookDeliveries(ctx, pgstore.EnqueueWebhookDeliveriesParams{
+		Event:   event,
+		Payload: body,
+		TripID:  tripID,
+	})
+	if err != nil {
+		return fmt.Errorf("webhook: failed to enqueue deliveries for Dispatch: %w", err)
+	}
+
+	return nil
+}
+
+// Run delivers due webhooks every interval until ctx is done.
+func (d Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DeliverDue(ctx); err != nil {
+			d.logger.Error("failed to deliver webhooks", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverDue attempts every pending delivery whose next attempt is due.
+func (d Dispatcher) DeliverDue(ctx context.Context) error {
+	now := d.now()
+	deliveries, err := d.store.GetDueWebhookDeliveries(ctx, pgstore.GetDueWebhookDeliveriesParams{
+		Now:           pgtype.Timestamp{Valid: true, Time: now},
+		MaxDeliveries: maxDeliveriesPerRun,
+	})
+	if err != nil {
+		return fmt.Errorf("webhook: failed to get due deliveries for DeliverDue: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		_, err := d.attempt(ctx, delivery.ID, delivery.Attempts, delivery.Url, delivery.Secret, delivery.Event, delivery.Payload)
+		if err != nil {
+			return fmt.Errorf("webhook: failed to record attempt for DeliverDue: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Attempt is the outcome of a delivery attempt.
+type Attempt struct {
+	Status     string
+	Attempts   int32
+	StatusCode int
+	Error      string
+}
+
+// ErrAlreadyDelivered is returned when replaying a delivery that succeeded.
+var ErrAlreadyDelivered = errors.New("webhook: delivery already delivered")
+
+// Replay attempts the delivery right away, whatever its status, unless it was
+// already delivered. The attempt is recorded like any other, so a dead-lettered
+// delivery that fails again stays dead.
+func (d Dispatcher) Replay(ctx context.Context, deliveryID uuid.UUID) (Attempt, error) {
+	delivery, err := d.store.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return Attempt{}, fmt.Errorf("webhook: failed to get delivery for Replay: %w", err)
+	}
+	if delivery.Status == deliveryDelivered {
+		return Attempt{}, ErrAlreadyDelivered
+	}
+
+	attempt, err := d.attempt(ctx, delivery.ID, delivery.Attempts, delivery.Url, delivery.Secret, delivery.Event, delivery.Payload)
+	if err != nil {
+		return Attempt{}, fmt.Errorf("webhook: failed to record attempt for Replay: %w", err)
+	}
+
+	return attempt, nil
+}
+
+// attempt posts a delivery that was already tried attempts times and records
+// the outcome. A failure is retried with exponential backoff, and dead-lettered
+// once the delivery has failed maxAttempts times.
+func (d Dispatcher) attempt(ctx context.Context, id uuid.UUID, attempts int32, url, secret, event string, payload []byte) (Attempt, error) {
+	now := d.now()
+	statusCode, postErr := d.post(ctx, url, secret, event, payload)
+	code := pgtype.Int4{Valid: statusCode != 0, Int32: int32(statusCode)}
+	result := Attempt{Attempts: attempts + 1, StatusCode: statusCode}
+
+	if postErr == nil {
+		result.Status = deliveryDelivered
+		return result, d.store.MarkWebhookDeliveryDelivered(ctx, pgstore.MarkWebhookDeliveryDeliveredParams{
+			LastStatusCode: code,
+			DeliveredAt:    pgtype.Timestamp{Valid: true, Time: now},
+			ID:             id,
+		})
+	}
+
+	result.Status = deliveryPending
+	if result.Attempts >= d.maxAttempts {
+		result.Status = deliveryDead
+	}
+	result.Error = postErr.Error()
+	d.logger.Warn(
+		"failed to deliver webhook",
+		zap.Error(postErr),
+		zap.String("delivery_id", id.String()),
+		zap.Int32("attempts", result.Attempts),
+		zap.String("status", result.Status),
+	)
+
+	return result, d.store.MarkWebhookDeliveryFailed(ctx, pgstore.MarkWebhookDeliveryFailedParams{
+		Status:         result.Status,
+		LastStatusCode: code,
+		LastError:      pgtype.Text{Valid: true, String: result.Error},
+		NextAttemptAt:  pgtype.Timestamp{Valid: true, Time: now.Add(retryBackoff(result.Attempts))},
+		ID:             id,
+	})
+}
+
+// retryBackoff is how long to wait before retrying a delivery that failed
+// attempts times: it doubles from baseRetryBackoff up to maxRetryBackoff.
+func retryBackoff(attempts int32) time.Duration {
+	backoff := baseRetryBackoff
+	for i := int32(1); i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxRetryBackoff)
+}
+
+// post sends the payload of an event to url, returning the response status
+// code, or 0 if no response arrived.
+func (d Dispatcher) post(ctx context.Context, url, secret, event string, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// ErrPrivateAddress is returned when a delivery would reach an address that
+// is not publicly routable, like the loopback or a private network.
+var ErrPrivateAddress = errors.New("webhook: private address")
+
+// isPublicIP reports whether deliveries may be sent to ip.
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast()
+}
+
+// dialPublicOnly is a net.Dialer Control refusing to connect to addresses
+// that are not public.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	return nil
+}
+
+// CheckURL reports whether rawURL may be subscribed to: it must be an http or
+// https URL and must not name localhost or a private address. Hosts that only
+// resolve to a private address are refused later, when delivering.
+func CheckURL(rawURL string) error {
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhook: unsupported scheme %q", u.Scheme)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("webhook: missing host")
+	}
+	if strings.EqualFold(host, "localhost") || strings.HasSuffix(strings.ToLower(host), ".localhost") {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	if ip := net.ParseIP(host); ip != nil && !isPublicIP(ip) {
+		return fmt.Errorf("%w: %s", ErrPrivateAddress, host)
+	}
+	return nil
+}
+
+// Sign is the hex encoded HMAC-SHA256 of body keyed by secret, which lets
+// subscribers check a delivery came from us.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates the random secret a new subscription signs with.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ParticipantConfirmed is the data of an EventParticipantConfirmed delivery.
+type ParticipantConfirmed struct {
+	ParticipantID string `json:"participant_id"`
+	Email         string `json:"email"`
+}
+
+// ActivityCreated is the data of an EventActivityCreated delivery.
+type ActivityCreated struct {
+	ActivityID string    `json:"activity_id"`
+	Title      string    `json:"title"`
+	OccursAt   time.Time `json:"occurs_at"`
+}
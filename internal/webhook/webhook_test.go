@@ -0,0 +1,368 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+	"io"
+	"journey/internal/pgstore"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// delivery is a webhook delivery as the fake store keeps it.
+type delivery struct {
+	id             uuid.UUID
+	url, secret    string
+	event          string
+	payload        []byte
+	status         string
+	attempts       int32
+	lastStatusCode int32
+	nextAttemptAt  time.Time
+}
+
+// fakeStore keeps deliveries in memory, picking and updating them the way the
+// webhook delivery queries do.
+type fakeStore struct {
+	deliveries []*delivery
+	enqueued   []pgstore.EnqueueWebhookDeliveriesParams
+}
+
+func (s *fakeStore) EnqueueWebhookDeliveries(_ context.Context, arg pgstore.EnqueueWebhookDeliveriesParams) (int64, error) {
+	s.enqueued = append(s.enqueued, arg)
+	return 1, nil
+}
+
+func (s *fakeStore) GetDueWebhookDeliveries(_ context.Context, arg pgstore.GetDueWebhookDeliveriesParams) ([]pgstore.GetDueWebhookDeliveriesRow, error) {
+	var rows []pgstore.GetDueWebhookDeliveriesRow
+	for _, d := range s.deliveries {
+		if d.status == deliveryPending && !d.nextAttemptAt.After(arg.Now.Time) && len(rows) < int(arg.MaxDeliveries) {
+			rows = append(rows, pgstore.GetDueWebhookDeliveriesRow{
+				ID:       d.id,
+				Event:    d.event,
+				Payload:  d.payload,
+				Attempts: d.attempts,
+				Url:      d.url,
+				Secret:   d.secret,
+			})
+		}
+	}
+	return rows, nil
+}
+
+func (s *fakeStore) delivery(id uuid.UUID) *delivery {
+	for _, d := range s.deliveries {
+		if d.id == id {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *fakeStore) MarkWebhookDeliveryDelivered(_ context.Context, arg pgstore.MarkWebhookDeliveryDeliveredParams) error {
+	d := s.delivery(arg.ID)
+	d.status = deliveryDelivered
+	d.attempts++
+	d.lastStatusCode = arg.LastStatusCode.Int32
+	return nil
+}
+
+func (s *fakeStore) MarkWebhookDeliveryFailed(_ context.Context, arg pgstore.MarkWebhookDeliveryFailedParams) error {
+	d := s.delivery(arg.ID)
+	d.status = arg.Status
+	d.attempts++
+	d.lastStatusCode = arg.LastStatusCode.Int32
+	d.nextAttemptAt = arg.NextAttemptAt.Time
+	return nil
+}
+
+func (s *fakeStore) GetWebhookDelivery(_ context.Context, id uuid.UUID) (pgstore.GetWebhookDeliveryRow, error) {
+	d := s.delivery(id)
+	if d == nil {
+		return pgstore.GetWebhookDeliveryRow{}, pgx.ErrNoRows
+	}
+	return pgstore.GetWebhookDeliveryRow{
+		ID:       d.id,
+		Event:    d.event,
+		Payload:  d.payload,
+		Status:   d.status,
+		Attempts: d.attempts,
+		Url:      d.url,
+		Secret:   d.secret,
+	}, nil
+}
+
+// endpoint is a subscriber answering every delivery with its current status
+// code, and counting the deliveries it got.
+type endpoint struct {
+	mu       sync.Mutex
+	status   int
+	received int
+}
+
+func (e *endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.received++
+	w.WriteHeader(e.status)
+}
+
+func (e *endpoint) setStatus(status int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status = status
+}
+
+func (e *endpoint) count() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.received
+}
+
+// newTestDispatcher builds a Dispatcher over s whose clock is at *now. Unlike
+// NewDispatcher's, its client may reach the test servers on the loopback.
+func newTestDispatcher(s *fakeStore, now *time.Time) Dispatcher {
+	return Dispatcher{
+		store:       s,
+		client:      &http.Client{Timeout: time.Second},
+		logger:      zap.NewNop(),
+		maxAttempts: 3,
+		now:         func() time.Time { return *now },
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	now := time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC)
+	s := &fakeStore{}
+	dispatcher := newTestDispatcher(s, &now)
+	tripID := uuid.New()
+
+	data := ParticipantConfirmed{ParticipantID: uuid.NewString(), Email: "ana@example.com"}
+	if err := dispatcher.enqueue(context.Background(), tripID, EventParticipantConfirmed, data); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if len(s.enqueued) != 1 || s.enqueued[0].TripID != tripID || s.enqueued[0].Event != EventParticipantConfirmed {
+		t.Fatalf("enqueued = %+v, want one %s for %s", s.enqueued, EventParticipantConfirmed, tripID)
+	}
+
+	var got struct {
+		Event      string               `json:"event"`
+		TripID     string               `json:"trip_id"`
+		OccurredAt time.Time            `json:"occurred_at"`
+		Data       ParticipantConfirmed `json:"data"`
+	}
+	if err := json.Unmarshal(s.enqueued[0].Payload, &got); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if got.Event != EventParticipantConfirmed || got.TripID != tripID.String() || !got.OccurredAt.Equal(now) || got.Data != data {
+		t.Errorf("payload = %+v", got)
+	}
+}
+
+func TestDeliverDueDeadLetters(t *testing.T) {
+	e := &endpoint{status: http.StatusInternalServerError}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	now := time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC)
+	d := &delivery{
+		id:            uuid.New(),
+		url:           server.URL,
+		secret:        "segredo",
+		event:         EventTripConfirmed,
+		payload:       []byte(`{"event":"trip.confirmed"}`),
+		status:        deliveryPending,
+		nextAttemptAt: now,
+	}
+	s := &fakeStore{deliveries: []*delivery{d}}
+	dispatcher := newTestDispatcher(s, &now)
+
+	for attempt := 1; attempt <= 3; attempt++ {
+		if err := dispatcher.DeliverDue(context.Background()); err != nil {
+			t.Fatalf("DeliverDue: %v", err)
+		}
+		if e.count() != attempt || d.attempts != int32(attempt) {
+			t.Fatalf("after attempt %d: endpoint got %d deliveries, %d attempts recorded", attempt, e.count(), d.attempts)
+		}
+		if d.lastStatusCode != http.StatusInternalServerError {
+			t.Errorf("last status code = %d, want 500", d.lastStatusCode)
+		}
+
+		// A failed delivery is not retried before its backoff elapses.
+		if attempt < 3 {
+			if err := dispatcher.DeliverDue(context.Background()); err != nil {
+				t.Fatalf("DeliverDue: %v", err)
+			}
+			if e.count() != attempt {
+				t.Fatalf("delivery retried before its backoff, %d deliveries", e.count())
+			}
+		}
+		now = d.nextAttemptAt
+	}
+
+	if d.status != deliveryDead {
+		t.Fatalf("status after %d attempts = %s, want %s", d.attempts, d.status, deliveryDead)
+	}
+
+	// Dead deliveries are not retried any more.
+	now = now.Add(24 * time.Hour)
+	if err := dispatcher.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue: %v", err)
+	}
+	if e.count() != 3 {
+		t.Errorf("dead delivery was retried, %d deliveries", e.count())
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{40, time.Hour},
+	}
+	for _, tt := range tests {
+		if got := retryBackoff(tt.attempts); got != tt.want {
+			t.Errorf("retryBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestPostSigns(t *testing.T) {
+	var event, signature string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, signature = r.Header.Get(EventHeader), r.Header.Get(SignatureHeader)
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	now := time.Now()
+	dispatcher := newTestDispatcher(&fakeStore{}, &now)
+	payload := []byte(`{"event":"activity.created"}`)
+	if _, err := dispatcher.post(context.Background(), server.URL, "segredo", EventActivityCreated, payload); err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	if event != EventActivityCreated || string(body) != string(payload) {
+		t.Errorf("got event %q with body %s", event, body)
+	}
+	if want := "sha256=" + Sign("segredo", payload); signature != want {
+		t.Errorf("signature = %q, want %q", signature, want)
+	}
+}
+
+func TestNewDispatcherRefusesPrivateAddresses(t *testing.T) {
+	e := &endpoint{status: http.StatusNoContent}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	dispatcher := NewDispatcher(nil, zap.NewNop(), time.Minute, 3)
+	_, err := dispatcher.post(context.Background(), server.URL, "segredo", EventTripConfirmed, []byte(`{}`))
+	if !errors.Is(err, ErrPrivateAddress) {
+		t.Errorf("posting to the loopback: err = %v, want %v", err, ErrPrivateAddress)
+	}
+	if e.count() != 0 {
+		t.Errorf("endpoint on the loopback got %d deliveries", e.count())
+	}
+}
+
+func TestCheckURL(t *testing.T) {
+	valid := []string{
+		"https://example.com/hooks",
+		"http://203.0.113.7:8080/hooks",
+	}
+	for _, rawURL := range valid {
+		if err := CheckURL(rawURL); err != nil {
+			t.Errorf("CheckURL(%q) = %v, want nil", rawURL, err)
+		}
+	}
+
+	private := []string{
+		"http://localhost/hooks",
+		"http://api.localhost/hooks",
+		"http://127.0.0.1:8080/hooks",
+		"http://10.0.0.5/hooks",
+		"http://192.168.0.10/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hooks",
+		"http://0.0.0.0/hooks",
+	}
+	for _, rawURL := range private {
+		if err := CheckURL(rawURL); !errors.Is(err, ErrPrivateAddress) {
+			t.Errorf("CheckURL(%q) = %v, want %v", rawURL, err, ErrPrivateAddress)
+		}
+	}
+
+	for _, rawURL := range []string{"ftp://example.com/hooks", "example.com/hooks", "https:///hooks"} {
+		if err := CheckURL(rawURL); err == nil {
+			t.Errorf("CheckURL(%q) succeeded, want an error", rawURL)
+		}
+	}
+}
+
+func TestReplay(t *testing.T) {
+	e := &endpoint{status: http.StatusBadGateway}
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	now := time.Date(2030, 6, 11, 8, 0, 0, 0, time.UTC)
+	d := &delivery{
+		id:       uuid.New(),
+		url:      server.URL,
+		event:    EventTripConfirmed,
+		payload:  []byte(`{}`),
+		status:   deliveryDead,
+		attempts: 3,
+	}
+	s := &fakeStore{deliveries: []*delivery{d}}
+	dispatcher := newTestDispatcher(s, &now)
+
+	// Replaying against an endpoint that still fails keeps the delivery dead.
+	attempt, err := dispatcher.Replay(context.Background(), d.id)
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if attempt.Status != deliveryDead || attempt.Attempts != 4 || attempt.StatusCode != http.StatusBadGateway || attempt.Error == "" {
+		t.Errorf("attempt = %+v, want dead on attempt 4 with 502", attempt)
+	}
+	if d.status != deliveryDead {
+		t.Errorf("delivery status = %s, want %s", d.status, deliveryDead)
+	}
+
+	e.setStatus(http.StatusNoContent)
+	attempt, err = dispatcher.Replay(context.Background(), d.id)
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	if attempt.Status != deliveryDelivered || attempt.Attempts != 5 || attempt.StatusCode != http.StatusNoContent {
+		t.Errorf("attempt = %+v, want delivered on attempt 5 with 204", attempt)
+	}
+	if d.status != deliveryDelivered || e.count() != 2 {
+		t.Errorf("delivery %s after %d deliveries, want delivered after 2", d.status, e.count())
+	}
+
+	if _, err := dispatcher.Replay(context.Background(), d.id); !errors.Is(err, ErrAlreadyDelivered) {
+		t.Errorf("replaying a delivered delivery: err = %v, want %v", err, ErrAlreadyDelivered)
+	}
+	if _, err := dispatcher.Replay(context.Background(), uuid.New()); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("replaying a missing delivery: err = %v, want %v", err, pgx.ErrNoRows)
+	}
+	if e.count() != 2 {
+		t.Errorf("endpoint got %d deliveries, want 2", e.count())
+	}
+}